@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -41,6 +42,8 @@ type AdGroup struct {
 	RotationMode                 string                         `xml:"adGroupAdRotationMode>adRotationMode"`
 }
 
+// AdGroupOperations maps operators to the ad groups they apply to, sent by
+// Mutate in the order given in the package doc.
 type AdGroupOperations map[string][]AdGroup
 
 type AdGroupLabel struct {
@@ -48,6 +51,8 @@ type AdGroupLabel struct {
 	LabelId   int64 `xml:"labelId"`
 }
 
+// AdGroupLabelOperations maps operators to the ad group labels they apply
+// to, sent by MutateLabel in the order given in the package doc.
 type AdGroupLabelOperations map[string][]AdGroupLabel
 
 // Get returns an array of ad group's and the total number of ad group's matching
@@ -83,13 +88,23 @@ type AdGroupLabelOperations map[string][]AdGroupLabel
 //   "Id", "CampaignId", "CampaignName", "Name", "Status", "Labels"
 //   "ContentBidCriterionTypeGroup", "TrackingUrlTemplate"
 //
+// AdGroupFields holds the fields of v201809, which NewSelectorBuilder checks
+// selectors against.
+//
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupService#get
 //
 func (s *AdGroupService) Get(selector Selector) (adGroups []AdGroup, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *AdGroupService) GetContext(ctx context.Context, selector Selector) (adGroups []AdGroup, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		adGroupServiceUrl,
 		"get",
 		struct {
@@ -156,24 +171,45 @@ func (s *AdGroupService) Get(selector Selector) (adGroups []AdGroup, totalCount
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupService#mutate
 //
 func (s *AdGroupService) Mutate(adGroupOperations AdGroupOperations) (adGroups []AdGroup, err error) {
-	type adGroupOperation struct {
-		Action  string  `xml:"operator"`
-		AdGroup AdGroup `xml:"operand"`
-	}
-	operations := []adGroupOperation{}
-	for action, adGroups := range adGroupOperations {
-		for _, adGroup := range adGroups {
+	return s.MutateContext(context.Background(), adGroupOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *AdGroupService) MutateContext(ctx context.Context, adGroupOperations AdGroupOperations) (adGroups []AdGroup, err error) {
+	operations := []AdGroupOperation{}
+	for _, action := range sortedActions(adGroupOperations) {
+		for _, adGroup := range adGroupOperations[action] {
 			operations = append(operations,
-				adGroupOperation{
+				AdGroupOperation{
 					Action:  action,
 					AdGroup: adGroup,
 				},
 			)
 		}
 	}
+	return s.MutateOperationsContext(ctx, operations)
+}
+
+// AdGroupOperation is an operation of MutateOperations, for instance the
+// "ADD" of one ad group.
+type AdGroupOperation struct {
+	Action  string  `xml:"operator"`
+	AdGroup AdGroup `xml:"operand"`
+}
+
+// MutateOperations is like Mutate but sends the operations in the order
+// given.
+func (s *AdGroupService) MutateOperations(operations []AdGroupOperation) (adGroups []AdGroup, err error) {
+	return s.MutateOperationsContext(context.Background(), operations)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *AdGroupService) MutateOperationsContext(ctx context.Context, operations []AdGroupOperation) (adGroups []AdGroup, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []adGroupOperation `xml:"operations"`
+		Ops     []AdGroupOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseUrl,
@@ -181,19 +217,20 @@ func (s *AdGroupService) Mutate(adGroupOperations AdGroupOperations) (adGroups [
 		},
 		Ops: operations,
 	}
-	respBody, err := s.Auth.request(adGroupServiceUrl, "mutate", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, adGroupServiceUrl, "mutate", mutation)
 	if err != nil {
 		return adGroups, err
 	}
 	mutateResp := struct {
-		AdGroups []AdGroup `xml:"rval>value"`
+		AdGroups             []AdGroup `xml:"rval>value"`
+		PartialFailureErrors apiErrors `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return adGroups, err
 	}
 
-	return mutateResp.AdGroups, err
+	return mutateResp.AdGroups, partialFailure(meta.RequestId, operations, mutateResp.AdGroups, mutateResp.PartialFailureErrors)
 }
 
 // MutateLabel allows you to add and removes labels from ad groups.
@@ -216,43 +253,65 @@ func (s *AdGroupService) Mutate(adGroupOperations AdGroupOperations) (adGroups [
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupService#mutateLabel
 //
 func (s *AdGroupService) MutateLabel(adGroupLabelOperations AdGroupLabelOperations) (adGroupLabels []AdGroupLabel, err error) {
-	type adGroupLabelOperation struct {
-		Action       string       `xml:"operator"`
-		AdGroupLabel AdGroupLabel `xml:"operand"`
-	}
-	operations := []adGroupLabelOperation{}
-	for action, adGroupLabels := range adGroupLabelOperations {
-		for _, adGroupLabel := range adGroupLabels {
+	return s.MutateLabelContext(context.Background(), adGroupLabelOperations)
+}
+
+// MutateLabelContext is like MutateLabel but takes a context that controls the
+// request lifetime.
+func (s *AdGroupService) MutateLabelContext(ctx context.Context, adGroupLabelOperations AdGroupLabelOperations) (adGroupLabels []AdGroupLabel, err error) {
+	operations := []AdGroupLabelOperation{}
+	for _, action := range sortedActions(adGroupLabelOperations) {
+		for _, adGroupLabel := range adGroupLabelOperations[action] {
 			operations = append(operations,
-				adGroupLabelOperation{
+				AdGroupLabelOperation{
 					Action:       action,
 					AdGroupLabel: adGroupLabel,
 				},
 			)
 		}
 	}
+	return s.MutateLabelOperationsContext(ctx, operations)
+}
+
+// AdGroupLabelOperation is an operation of MutateLabelOperations, for
+// instance the "ADD" of one ad group label.
+type AdGroupLabelOperation struct {
+	Action       string       `xml:"operator"`
+	AdGroupLabel AdGroupLabel `xml:"operand"`
+}
+
+// MutateLabelOperations is like MutateLabel but sends the operations in
+// the order given.
+func (s *AdGroupService) MutateLabelOperations(operations []AdGroupLabelOperation) (adGroupLabels []AdGroupLabel, err error) {
+	return s.MutateLabelOperationsContext(context.Background(), operations)
+}
+
+// MutateLabelOperationsContext is like MutateLabelOperations but takes a context that controls the
+// request lifetime.
+func (s *AdGroupService) MutateLabelOperationsContext(ctx context.Context, operations []AdGroupLabelOperation) (adGroupLabels []AdGroupLabel, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []adGroupLabelOperation `xml:"operations"`
+		Ops     []AdGroupLabelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseUrl,
 			Local: "mutateLabel",
 		},
 		Ops: operations}
-	respBody, err := s.Auth.request(adGroupServiceUrl, "mutateLabel", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, adGroupServiceUrl, "mutateLabel", mutation)
 	if err != nil {
 		return adGroupLabels, err
 	}
 	mutateResp := struct {
-		AdGroupLabels []AdGroupLabel `xml:"rval>value"`
+		AdGroupLabels        []AdGroupLabel `xml:"rval>value"`
+		PartialFailureErrors apiErrors      `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return adGroupLabels, err
 	}
 
-	return mutateResp.AdGroupLabels, err
+	return mutateResp.AdGroupLabels, partialFailure(meta.RequestId, operations, mutateResp.AdGroupLabels, mutateResp.PartialFailureErrors)
 }
 
 // Relevant documentation
@@ -260,8 +319,15 @@ func (s *AdGroupService) MutateLabel(adGroupLabelOperations AdGroupLabelOperatio
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupService#query
 //
 func (s *AdGroupService) Query(query string) (adGroups []AdGroup, totalCount int64, err error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *AdGroupService) QueryContext(ctx context.Context, query string) (adGroups []AdGroup, totalCount int64, err error) {
 
 	respBody, err := s.Auth.request(
+		ctx,
 		adGroupServiceUrl,
 		"query",
 		AWQLQuery{
@@ -289,3 +355,21 @@ func (s *AdGroupService) Query(query string) (adGroups []AdGroup, totalCount int
 	return getResp.AdGroups, getResp.Size, err
 
 }
+
+// AdGroupPages iterates over pages of ad groups, see Pager.
+type AdGroupPages struct {
+	*Pager
+}
+
+// AdGroups returns the current page.
+func (p AdGroupPages) AdGroups() []AdGroup {
+	return p.Page().([]AdGroup)
+}
+
+// Pages returns an iterator over the ad groups matching selector.
+func (s *AdGroupService) Pages(ctx context.Context, selector Selector, opts *PageOptions) AdGroupPages {
+	return AdGroupPages{newPager(ctx, selector, opts, "Id", "Id", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
+}
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -691,6 +692,8 @@ type DeprecatedAd struct {
 
 //
 
+// AdGroupAdOperations maps operators to the ads they apply to, sent by
+// Mutate in the order given in the package doc.
 type AdGroupAdOperations map[string]AdGroupAds
 
 func NewAdGroupAdService(auth *Auth) *AdGroupAdService {
@@ -714,6 +717,8 @@ type AdGroupAdLabel struct {
 	LabelId     int64 `xml:"labelId"`
 }
 
+// AdGroupAdLabelOperations maps operators to the ad labels they apply to,
+// sent by MutateLabel in the order given in the package doc.
 type AdGroupAdLabelOperations map[string][]AdGroupAdLabel
 
 type AdUrlUpgrade struct {
@@ -778,8 +783,15 @@ type AdGroupAdPolicySummary struct {
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupAdService#get
 //
 func (s AdGroupAdService) Get(selector Selector) (adGroupAds AdGroupAds, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s AdGroupAdService) GetContext(ctx context.Context, selector Selector) (adGroupAds AdGroupAds, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		adGroupAdServiceUrl,
 		"get",
 		struct {
@@ -839,25 +851,57 @@ func (s AdGroupAdService) Get(selector Selector) (adGroupAds AdGroupAds, totalCo
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupAdService#mutate
 //
 func (s *AdGroupAdService) Mutate(adGroupAdOperations AdGroupAdOperations) (adGroupAds AdGroupAds, err error) {
-	type adGroupAdOperation struct {
-		Action    string     `xml:"operator"`
-		AdGroupAd AdGroupAds `xml:"operand"`
-	}
-	operations := []adGroupAdOperation{}
-	for action, adGroupAds := range adGroupAdOperations {
-		for _, adGroupAd := range adGroupAds {
-			ad := []interface{}{adGroupAd}
+	return s.MutateContext(context.Background(), adGroupAdOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *AdGroupAdService) MutateContext(ctx context.Context, adGroupAdOperations AdGroupAdOperations) (adGroupAds AdGroupAds, err error) {
+	operations := []AdGroupAdOperation{}
+	for _, action := range sortedActions(adGroupAdOperations) {
+		for _, adGroupAd := range adGroupAdOperations[action] {
 			operations = append(operations,
-				adGroupAdOperation{
+				AdGroupAdOperation{
 					Action:    action,
-					AdGroupAd: ad,
+					AdGroupAd: adGroupAd,
 				},
 			)
 		}
 	}
+	return s.MutateOperationsContext(ctx, operations)
+}
+
+// AdGroupAdOperation is an operation of MutateOperations, for instance the
+// "ADD" of one ad.
+type AdGroupAdOperation struct {
+	Action string `xml:"operator"`
+	// AdGroupAd is a TextAd, ExpandedTextAd or Ad.
+	AdGroupAd interface{} `xml:"operand"`
+	// ExemptionRequests ask for exemptions from the policy violations
+	// of an earlier attempt, see ExemptionRequests.
+	ExemptionRequests []ExemptionRequest `xml:"exemptionRequests,omitempty"`
+}
+
+func (op AdGroupAdOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(struct {
+		Action            string             `xml:"operator"`
+		AdGroupAd         AdGroupAds         `xml:"operand"`
+		ExemptionRequests []ExemptionRequest `xml:"exemptionRequests,omitempty"`
+	}{op.Action, AdGroupAds{op.AdGroupAd}, op.ExemptionRequests}, start)
+}
+
+// MutateOperations is like Mutate but sends the operations in the order
+// given.
+func (s *AdGroupAdService) MutateOperations(operations []AdGroupAdOperation) (adGroupAds AdGroupAds, err error) {
+	return s.MutateOperationsContext(context.Background(), operations)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *AdGroupAdService) MutateOperationsContext(ctx context.Context, operations []AdGroupAdOperation) (adGroupAds AdGroupAds, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []adGroupAdOperation `xml:"operations"`
+		Ops     []AdGroupAdOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseUrl,
@@ -866,18 +910,19 @@ func (s *AdGroupAdService) Mutate(adGroupAdOperations AdGroupAdOperations) (adGr
 		Ops: operations,
 	}
 
-	respBody, err := s.Auth.request(adGroupAdServiceUrl, "mutate", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, adGroupAdServiceUrl, "mutate", mutation)
 	if err != nil {
 		return adGroupAds, err
 	}
 	mutateResp := struct {
-		AdGroupAds AdGroupAds `xml:"rval>value"`
+		AdGroupAds           AdGroupAds `xml:"rval>value"`
+		PartialFailureErrors apiErrors  `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return adGroupAds, err
 	}
-	return mutateResp.AdGroupAds, err
+	return mutateResp.AdGroupAds, partialFailure(meta.RequestId, operations, mutateResp.AdGroupAds, mutateResp.PartialFailureErrors)
 }
 
 // MutateLabel allows you to add and removes labels from ads.
@@ -900,43 +945,65 @@ func (s *AdGroupAdService) Mutate(adGroupAdOperations AdGroupAdOperations) (adGr
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupAdService#mutateLabel
 //
 func (s *AdGroupAdService) MutateLabel(adGroupAdLabelOperations AdGroupAdLabelOperations) (adGroupAdLabels []AdGroupAdLabel, err error) {
-	type adGroupAdLabelOperation struct {
-		Action         string         `xml:"operator"`
-		AdGroupAdLabel AdGroupAdLabel `xml:"operand"`
-	}
-	operations := []adGroupAdLabelOperation{}
-	for action, adGroupAdLabels := range adGroupAdLabelOperations {
-		for _, adGroupAdLabel := range adGroupAdLabels {
+	return s.MutateLabelContext(context.Background(), adGroupAdLabelOperations)
+}
+
+// MutateLabelContext is like MutateLabel but takes a context that controls the
+// request lifetime.
+func (s *AdGroupAdService) MutateLabelContext(ctx context.Context, adGroupAdLabelOperations AdGroupAdLabelOperations) (adGroupAdLabels []AdGroupAdLabel, err error) {
+	operations := []AdGroupAdLabelOperation{}
+	for _, action := range sortedActions(adGroupAdLabelOperations) {
+		for _, adGroupAdLabel := range adGroupAdLabelOperations[action] {
 			operations = append(operations,
-				adGroupAdLabelOperation{
+				AdGroupAdLabelOperation{
 					Action:         action,
 					AdGroupAdLabel: adGroupAdLabel,
 				},
 			)
 		}
 	}
+	return s.MutateLabelOperationsContext(ctx, operations)
+}
+
+// AdGroupAdLabelOperation is an operation of MutateLabelOperations, for
+// instance the "ADD" of one ad label.
+type AdGroupAdLabelOperation struct {
+	Action         string         `xml:"operator"`
+	AdGroupAdLabel AdGroupAdLabel `xml:"operand"`
+}
+
+// MutateLabelOperations is like MutateLabel but sends the operations in
+// the order given.
+func (s *AdGroupAdService) MutateLabelOperations(operations []AdGroupAdLabelOperation) (adGroupAdLabels []AdGroupAdLabel, err error) {
+	return s.MutateLabelOperationsContext(context.Background(), operations)
+}
+
+// MutateLabelOperationsContext is like MutateLabelOperations but takes a context that controls the
+// request lifetime.
+func (s *AdGroupAdService) MutateLabelOperationsContext(ctx context.Context, operations []AdGroupAdLabelOperation) (adGroupAdLabels []AdGroupAdLabel, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []adGroupAdLabelOperation `xml:"operations"`
+		Ops     []AdGroupAdLabelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseUrl,
 			Local: "mutateLabel",
 		},
 		Ops: operations}
-	respBody, err := s.Auth.request(adGroupAdServiceUrl, "mutateLabel", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, adGroupAdServiceUrl, "mutateLabel", mutation)
 	if err != nil {
 		return adGroupAdLabels, err
 	}
 	mutateResp := struct {
-		AdGroupAdLabels []AdGroupAdLabel `xml:"rval>value"`
+		AdGroupAdLabels      []AdGroupAdLabel `xml:"rval>value"`
+		PartialFailureErrors apiErrors        `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return adGroupAdLabels, err
 	}
 
-	return mutateResp.AdGroupAdLabels, err
+	return mutateResp.AdGroupAdLabels, partialFailure(meta.RequestId, operations, mutateResp.AdGroupAdLabels, mutateResp.PartialFailureErrors)
 }
 
 // Query is not yet implemented
@@ -946,8 +1013,15 @@ func (s *AdGroupAdService) MutateLabel(adGroupAdLabelOperations AdGroupAdLabelOp
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupAdService#query
 //
 func (s *AdGroupAdService) Query(query string) (adGroupAds AdGroupAds, totalCount int64, err error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *AdGroupAdService) QueryContext(ctx context.Context, query string) (adGroupAds AdGroupAds, totalCount int64, err error) {
 
 	respBody, err := s.Auth.request(
+		ctx,
 		adGroupAdServiceUrl,
 		"query",
 		AWQLQuery{
@@ -986,3 +1060,21 @@ func (s *AdGroupAdService) Query(query string) (adGroupAds AdGroupAds, totalCoun
 func (s *AdGroupAdService) UpgradeUrl(adUrlUpgrades []AdUrlUpgrade) (adGroupAds AdGroupAds, err error) {
 	return adGroupAds, ERROR_NOT_YET_IMPLEMENTED
 }
+
+// AdGroupAdPages iterates over pages of ads, see Pager.
+type AdGroupAdPages struct {
+	*Pager
+}
+
+// AdGroupAds returns the current page.
+func (p AdGroupAdPages) AdGroupAds() AdGroupAds {
+	return p.Page().(AdGroupAds)
+}
+
+// Pages returns an iterator over the ads matching selector.
+func (s AdGroupAdService) Pages(ctx context.Context, selector Selector, opts *PageOptions) AdGroupAdPages {
+	return AdGroupAdPages{newPager(ctx, selector, opts, "Id", "Id", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
+}
@@ -139,6 +139,7 @@ func (aga *AdGroupAds) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) er
 					if err != nil {
 						return err
 					}
+					ad = a
 				case "ProductAd":
 					a := ProductAd{AdGroupId: adGroupId}
 					err := dec.DecodeElement(&a, &start)
@@ -392,6 +393,9 @@ func (aga *AdGroupAds) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) er
 		a.BaseAdGroupId = baseAdGroupId
 		a.AdStrengthInfo = adStrengthInfo
 		*aga = append(*aga, a)
+	case nil:
+		// failed operations leave an empty value in partial failure mode
+		*aga = append(*aga, nil)
 	}
 
 	return nil
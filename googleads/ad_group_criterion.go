@@ -1,8 +1,10 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
+	"io"
 )
 
 type AdGroupCriterionService struct {
@@ -33,13 +35,24 @@ type AdGroupCriterionLabel struct {
 	LabelId     int64 `xml:"labelId"`
 }
 
+// AdGroupCriterionLabelOperations maps operators to the ad group criterion
+// labels they apply to, sent by MutateLabel in the order given in the
+// package doc.
 type AdGroupCriterionLabelOperations map[string][]AdGroupCriterionLabel
 
 func (agcs *AdGroupCriterions) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
 	adGroupCriterionType, err := findAttr(start.Attr, xml.Name{
 		Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"})
 	if err != nil {
-		return err
+		// failed operations leave an empty value in partial failure mode
+		*agcs = append(*agcs, nil)
+		for {
+			if _, err := dec.Token(); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
 	}
 	switch adGroupCriterionType {
 	case "BiddableAdGroupCriterion":
@@ -62,6 +75,8 @@ func (agcs *AdGroupCriterions) UnmarshalXML(dec *xml.Decoder, start xml.StartEle
 	return nil
 }
 
+// AdGroupCriterionOperations maps operators to the ad group criteria they
+// apply to, sent by Mutate in the order given in the package doc.
 type AdGroupCriterionOperations map[string]AdGroupCriterions
 
 // Get returns an array of AdGroupCriterion's and the total number of AdGroupCriterion's matching
@@ -141,8 +156,15 @@ type AdGroupCriterionOperations map[string]AdGroupCriterions
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupCriterionService#get
 //
 func (s AdGroupCriterionService) Get(selector Selector) (adGroupCriterions AdGroupCriterions, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s AdGroupCriterionService) GetContext(ctx context.Context, selector Selector) (adGroupCriterions AdGroupCriterions, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		adGroupCriterionServiceUrl,
 		"get",
 		struct {
@@ -205,9 +227,18 @@ func (s AdGroupCriterionService) Get(selector Selector) (adGroupCriterions AdGro
 type AdGroupCriterionOperation struct {
 	Action           string      `xml:"operator"`
 	AdGroupCriterion interface{} `xml:"operand"`
+	// ExemptionRequests ask for exemptions from the policy violations
+	// of an earlier attempt, see ExemptionRequests.
+	ExemptionRequests []ExemptionRequest `xml:"exemptionRequests,omitempty"`
 }
 
 func (s *AdGroupCriterionService) MutateOperations(operations []AdGroupCriterionOperation) (adGroupCriterions AdGroupCriterions, err error) {
+	return s.MutateOperationsContext(context.Background(), operations)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *AdGroupCriterionService) MutateOperationsContext(ctx context.Context, operations []AdGroupCriterionOperation) (adGroupCriterions AdGroupCriterions, err error) {
 
 	mutation := struct {
 		XMLName xml.Name
@@ -219,25 +250,32 @@ func (s *AdGroupCriterionService) MutateOperations(operations []AdGroupCriterion
 		},
 		Ops: operations,
 	}
-	respBody, err := s.Auth.request(adGroupCriterionServiceUrl, "mutate", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, adGroupCriterionServiceUrl, "mutate", mutation)
 	if err != nil {
 		return adGroupCriterions, err
 	}
 	mutateResp := struct {
-		AdGroupCriterions AdGroupCriterions `xml:"rval>value"`
+		AdGroupCriterions    AdGroupCriterions `xml:"rval>value"`
+		PartialFailureErrors apiErrors         `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return adGroupCriterions, err
 	}
 
-	return mutateResp.AdGroupCriterions, err
+	return mutateResp.AdGroupCriterions, partialFailure(meta.RequestId, operations, mutateResp.AdGroupCriterions, mutateResp.PartialFailureErrors)
 }
 
 func (s *AdGroupCriterionService) Mutate(adGroupCriterionOperations AdGroupCriterionOperations) (adGroupCriterions AdGroupCriterions, err error) {
+	return s.MutateContext(context.Background(), adGroupCriterionOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *AdGroupCriterionService) MutateContext(ctx context.Context, adGroupCriterionOperations AdGroupCriterionOperations) (adGroupCriterions AdGroupCriterions, err error) {
 	operations := []AdGroupCriterionOperation{}
-	for action, adGroupCriterions := range adGroupCriterionOperations {
-		for _, adGroupCriterion := range adGroupCriterions {
+	for _, action := range sortedActions(adGroupCriterionOperations) {
+		for _, adGroupCriterion := range adGroupCriterionOperations[action] {
 			operations = append(operations,
 				AdGroupCriterionOperation{
 					Action:           action,
@@ -246,7 +284,7 @@ func (s *AdGroupCriterionService) Mutate(adGroupCriterionOperations AdGroupCrite
 			)
 		}
 	}
-	return s.MutateOperations(operations)
+	return s.MutateOperationsContext(ctx, operations)
 }
 
 // MutateLabel allows you to add and removes labels from ad groups.
@@ -270,43 +308,65 @@ func (s *AdGroupCriterionService) Mutate(adGroupCriterionOperations AdGroupCrite
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupCriterionService#mutateLabel
 //
 func (s *AdGroupCriterionService) MutateLabel(adGroupCriterionLabelOperations AdGroupCriterionLabelOperations) (adGroupCriterionLabels []AdGroupCriterionLabel, err error) {
-	type adGroupCriterionLabelOperation struct {
-		Action                string                `xml:"operator"`
-		AdGroupCriterionLabel AdGroupCriterionLabel `xml:"operand"`
-	}
-	operations := []adGroupCriterionLabelOperation{}
-	for action, adGroupCriterionLabels := range adGroupCriterionLabelOperations {
-		for _, adGroupCriterionLabel := range adGroupCriterionLabels {
+	return s.MutateLabelContext(context.Background(), adGroupCriterionLabelOperations)
+}
+
+// MutateLabelContext is like MutateLabel but takes a context that controls the
+// request lifetime.
+func (s *AdGroupCriterionService) MutateLabelContext(ctx context.Context, adGroupCriterionLabelOperations AdGroupCriterionLabelOperations) (adGroupCriterionLabels []AdGroupCriterionLabel, err error) {
+	operations := []AdGroupCriterionLabelOperation{}
+	for _, action := range sortedActions(adGroupCriterionLabelOperations) {
+		for _, adGroupCriterionLabel := range adGroupCriterionLabelOperations[action] {
 			operations = append(operations,
-				adGroupCriterionLabelOperation{
+				AdGroupCriterionLabelOperation{
 					Action:                action,
 					AdGroupCriterionLabel: adGroupCriterionLabel,
 				},
 			)
 		}
 	}
+	return s.MutateLabelOperationsContext(ctx, operations)
+}
+
+// AdGroupCriterionLabelOperation is an operation of MutateLabelOperations,
+// for instance the "ADD" of one ad group criterion label.
+type AdGroupCriterionLabelOperation struct {
+	Action                string                `xml:"operator"`
+	AdGroupCriterionLabel AdGroupCriterionLabel `xml:"operand"`
+}
+
+// MutateLabelOperations is like MutateLabel but sends the operations in
+// the order given.
+func (s *AdGroupCriterionService) MutateLabelOperations(operations []AdGroupCriterionLabelOperation) (adGroupCriterionLabels []AdGroupCriterionLabel, err error) {
+	return s.MutateLabelOperationsContext(context.Background(), operations)
+}
+
+// MutateLabelOperationsContext is like MutateLabelOperations but takes a context that controls the
+// request lifetime.
+func (s *AdGroupCriterionService) MutateLabelOperationsContext(ctx context.Context, operations []AdGroupCriterionLabelOperation) (adGroupCriterionLabels []AdGroupCriterionLabel, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []adGroupCriterionLabelOperation `xml:"operations"`
+		Ops     []AdGroupCriterionLabelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseUrl,
 			Local: "mutateLabel",
 		},
 		Ops: operations}
-	respBody, err := s.Auth.request(adGroupCriterionServiceUrl, "mutateLabel", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, adGroupCriterionServiceUrl, "mutateLabel", mutation)
 	if err != nil {
 		return adGroupCriterionLabels, err
 	}
 	mutateResp := struct {
 		AdGroupCriterionLabels []AdGroupCriterionLabel `xml:"rval>value"`
+		PartialFailureErrors   apiErrors               `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return adGroupCriterionLabels, err
 	}
 
-	return mutateResp.AdGroupCriterionLabels, err
+	return mutateResp.AdGroupCriterionLabels, partialFailure(meta.RequestId, operations, mutateResp.AdGroupCriterionLabels, mutateResp.PartialFailureErrors)
 }
 
 // Query is not yet implemented
@@ -316,8 +376,15 @@ func (s *AdGroupCriterionService) MutateLabel(adGroupCriterionLabelOperations Ad
 //     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupCriterionService#query
 //
 func (s *AdGroupCriterionService) Query(query string) (adGroupCriterions AdGroupCriterions, totalCount int64, err error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *AdGroupCriterionService) QueryContext(ctx context.Context, query string) (adGroupCriterions AdGroupCriterions, totalCount int64, err error) {
 
 	respBody, err := s.Auth.request(
+		ctx,
 		adGroupCriterionServiceUrl,
 		"query",
 		AWQLQuery{
@@ -345,3 +412,22 @@ func (s *AdGroupCriterionService) Query(query string) (adGroupCriterions AdGroup
 	return getResp.AdGroupCriterions, getResp.Size, err
 
 }
+
+// AdGroupCriterionPages iterates over pages of ad group criteria, see Pager.
+type AdGroupCriterionPages struct {
+	*Pager
+}
+
+// AdGroupCriterions returns the current page.
+func (p AdGroupCriterionPages) AdGroupCriterions() AdGroupCriterions {
+	return p.Page().(AdGroupCriterions)
+}
+
+// Pages returns an iterator over the ad group criteria matching selector.  Criterion ids are not
+// unique, so paging stops with ErrOffsetLimit at MaxOffset.
+func (s AdGroupCriterionService) Pages(ctx context.Context, selector Selector, opts *PageOptions) AdGroupCriterionPages {
+	return AdGroupCriterionPages{newPager(ctx, selector, opts, "", "", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
+}
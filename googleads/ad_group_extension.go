@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -21,11 +22,22 @@ type AdGroupExtensionSetting struct {
 	ExtensionSetting ExtensionSetting `xml:"https://adwords.google.com/api/adwords/cm/v201809 extensionSetting,omitempty"`
 }
 
+// AdGroupExtensionSettingOperations maps operators to the extension
+// settings they apply to.  Mutate sends the "ADD" operations first, then
+// "SET", then "REMOVE", each in slice order; use MutateOperations for any
+// other order.
 type AdGroupExtensionSettingOperations map[string][]AdGroupExtensionSetting
 
 // https://developers.google.com/adwords/api/docs/reference/v201809/AdGroupExtensionSettingService#query
 func (s *AdGroupExtensionSettingService) Query(query string) (settings []AdGroupExtensionSetting, totalCount int64, err error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *AdGroupExtensionSettingService) QueryContext(ctx context.Context, query string) (settings []AdGroupExtensionSetting, totalCount int64, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		adGroupExtensionSettingServiceUrl,
 		"query",
 		AWQLQuery{
@@ -54,24 +66,45 @@ func (s *AdGroupExtensionSettingService) Query(query string) (settings []AdGroup
 
 // https://developers.google.com/adwords/api/docs/reference/v201809/AdGroupExtensionSettingService#mutate
 func (s *AdGroupExtensionSettingService) Mutate(settingsOperations AdGroupExtensionSettingOperations) (settings []AdGroupExtensionSetting, err error) {
-	type settingOperations struct {
-		Action  string                  `xml:"operator"`
-		Setting AdGroupExtensionSetting `xml:"operand"`
-	}
-	operations := []settingOperations{}
-	for action, settings := range settingsOperations {
-		for _, setting := range settings {
+	return s.MutateContext(context.Background(), settingsOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *AdGroupExtensionSettingService) MutateContext(ctx context.Context, settingsOperations AdGroupExtensionSettingOperations) (settings []AdGroupExtensionSetting, err error) {
+	operations := []AdGroupExtensionSettingOperation{}
+	for _, action := range sortedActions(settingsOperations) {
+		for _, setting := range settingsOperations[action] {
 			operations = append(operations,
-				settingOperations{
+				AdGroupExtensionSettingOperation{
 					Action:  action,
 					Setting: setting,
 				},
 			)
 		}
 	}
+	return s.MutateOperationsContext(ctx, operations)
+}
+
+// AdGroupExtensionSettingOperation is an operation of MutateOperations,
+// for instance the "ADD" of one ad group extension setting.
+type AdGroupExtensionSettingOperation struct {
+	Action  string                  `xml:"operator"`
+	Setting AdGroupExtensionSetting `xml:"operand"`
+}
+
+// MutateOperations is like Mutate but sends the operations in the order
+// given.
+func (s *AdGroupExtensionSettingService) MutateOperations(operations []AdGroupExtensionSettingOperation) (settings []AdGroupExtensionSetting, err error) {
+	return s.MutateOperationsContext(context.Background(), operations)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *AdGroupExtensionSettingService) MutateOperationsContext(ctx context.Context, operations []AdGroupExtensionSettingOperation) (settings []AdGroupExtensionSetting, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []settingOperations `xml:"operations"`
+		Ops     []AdGroupExtensionSettingOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseUrl,
@@ -80,17 +113,18 @@ func (s *AdGroupExtensionSettingService) Mutate(settingsOperations AdGroupExtens
 		Ops: operations,
 	}
 
-	respBody, err := s.Auth.request(adGroupExtensionSettingServiceUrl, "mutate", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, adGroupExtensionSettingServiceUrl, "mutate", mutation)
 	if err != nil {
 		return settings, err
 	}
 	mutateResp := struct {
-		Settings []AdGroupExtensionSetting `xml:"rval>value"`
+		Settings             []AdGroupExtensionSetting `xml:"rval>value"`
+		PartialFailureErrors apiErrors                 `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal(respBody, &mutateResp)
 	if err != nil {
 		return settings, err
 	}
 
-	return mutateResp.Settings, err
+	return mutateResp.Settings, partialFailure(meta.RequestId, operations, mutateResp.Settings, mutateResp.PartialFailureErrors)
 }
@@ -1,45 +1,386 @@
+// Code generated by wsdlgen; DO NOT EDIT.
+
 package v201809
 
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// AdGroupFeedStatus is the status of an AdGroupFeed.
+type AdGroupFeedStatus string
+
+const (
+	AdGroupFeedStatusEnabled AdGroupFeedStatus = "ENABLED"
+	AdGroupFeedStatusRemoved AdGroupFeedStatus = "REMOVED"
+	AdGroupFeedStatusUnknown AdGroupFeedStatus = "UNKNOWN"
+)
+
+// ConstantOperandConstantType is the types of constant operands.
+type ConstantOperandConstantType string
+
+const (
+	ConstantOperandConstantTypeDouble  ConstantOperandConstantType = "DOUBLE"
+	ConstantOperandConstantTypeLong    ConstantOperandConstantType = "LONG"
+	ConstantOperandConstantTypeBoolean ConstantOperandConstantType = "BOOLEAN"
+	ConstantOperandConstantTypeString  ConstantOperandConstantType = "STRING"
+	ConstantOperandConstantTypeUnknown ConstantOperandConstantType = "UNKNOWN"
+)
+
+// ConstantOperandUnit is the units of constant operands, if applicable.
+type ConstantOperandUnit string
+
+const (
+	ConstantOperandUnitMeters   ConstantOperandUnit = "METERS"
+	ConstantOperandUnitMiles    ConstantOperandUnit = "MILES"
+	ConstantOperandUnitMinutes  ConstantOperandUnit = "MINUTES"
+	ConstantOperandUnitMicros   ConstantOperandUnit = "MICROS"
+	ConstantOperandUnitUnitless ConstantOperandUnit = "UNITLESS"
+	ConstantOperandUnitUnknown  ConstantOperandUnit = "UNKNOWN"
+)
+
+// FunctionOperator is the operators that can be used in functions.
+type FunctionOperator string
+
+const (
+	FunctionOperatorIn          FunctionOperator = "IN"
+	FunctionOperatorIdentity    FunctionOperator = "IDENTITY"
+	FunctionOperatorEquals      FunctionOperator = "EQUALS"
+	FunctionOperatorAnd         FunctionOperator = "AND"
+	FunctionOperatorContainsAny FunctionOperator = "CONTAINS_ANY"
+	FunctionOperatorUnknown     FunctionOperator = "UNKNOWN"
+)
+
+// RequestContextOperandContextType is the types of request context.
+type RequestContextOperandContextType string
+
+const (
+	RequestContextOperandContextTypeFeedItemId         RequestContextOperandContextType = "FEED_ITEM_ID"
+	RequestContextOperandContextTypeDevicePlatform     RequestContextOperandContextType = "DEVICE_PLATFORM"
+	RequestContextOperandContextTypeFeedItemIdOptional RequestContextOperandContextType = "FEED_ITEM_ID_OPTIONAL"
+	RequestContextOperandContextTypeUnknown            RequestContextOperandContextType = "UNKNOWN"
+)
+
+// AdGroupFeed links a feed to an adgroup using a matching function,
+// making the feed's feed items available in the adgroup's ads for
+// substitution.
+type AdGroupFeed struct {
+	// Id of the Feed associated with the AdGroupFeed.
+	FeedId int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 feedId,omitempty"`
+	// Id of the AdGroup associated with the AdGroupFeed.
+	AdGroupId int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 adGroupId,omitempty"`
+	// Matching function associated with the AdGroupFeed. The matching
+	// function will return true/false indicating which feed items may
+	// serve.
+	MatchingFunction *Function `xml:"https://adwords.google.com/api/adwords/cm/v201809 matchingFunction,omitempty"`
+	// Indicates which placeholder types the feed may populate under the
+	// connected AdGroup. Required.
+	PlaceholderTypes []int `xml:"https://adwords.google.com/api/adwords/cm/v201809 placeholderTypes,omitempty"`
+	// Status of the AdGroupFeed.
+	Status AdGroupFeedStatus `xml:"https://adwords.google.com/api/adwords/cm/v201809 status,omitempty"`
+	// ID of the base campaign from which this draft/trial adgroup feed was
+	// created.
+	BaseCampaignId int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 baseCampaignId,omitempty"`
+	// ID of the base adgroup from which this draft/trial adgroup feed was
+	// created.
+	BaseAdGroupId int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 baseAdGroupId,omitempty"`
+}
+
+// AdGroupFeedOperation is the operation used to create or mutate an
+// AdGroupFeed.
+type AdGroupFeedOperation struct {
+	// Operator.
+	Operator Operator `xml:"https://adwords.google.com/api/adwords/cm/v201809 operator,omitempty"`
+	// Indicates that this instance is a subtype of Operation.
+	OperationType string `xml:"https://adwords.google.com/api/adwords/cm/v201809 Operation.Type,omitempty"`
+	// The AdGroupFeed to operate on.
+	Operand *AdGroupFeed `xml:"https://adwords.google.com/api/adwords/cm/v201809 operand,omitempty"`
+}
+
+// MarshalXML encodes v with its xsi:type.
+func (v AdGroupFeedOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"}, Value: "AdGroupFeedOperation"})
+	type adGroupFeedOperation AdGroupFeedOperation
+	return e.EncodeElement(adGroupFeedOperation(v), start)
+}
+
+// AdGroupFeedPage is the result of a call to AdGroupFeedService#get.
+// Contains a list of associations between ad groups and feeds.
+type AdGroupFeedPage struct {
+	// Total number of entries in the result that this page is a part of.
+	TotalNumEntries int `xml:"https://adwords.google.com/api/adwords/cm/v201809 totalNumEntries,omitempty"`
+	// Indicates that this instance is a subtype of Page.
+	PageType string        `xml:"https://adwords.google.com/api/adwords/cm/v201809 Page.Type,omitempty"`
+	Entries  []AdGroupFeed `xml:"https://adwords.google.com/api/adwords/cm/v201809 entries,omitempty"`
+}
+
+// MarshalXML encodes v with its xsi:type.
+func (v AdGroupFeedPage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"}, Value: "AdGroupFeedPage"})
+	type adGroupFeedPage AdGroupFeedPage
+	return e.EncodeElement(adGroupFeedPage(v), start)
+}
+
+// AdGroupFeedReturnValue is the result of a call to
+// AdGroupFeedService#mutate.
+type AdGroupFeedReturnValue struct {
+	// Indicates that this instance is a subtype of ListReturnValue.
+	ListReturnValueType string        `xml:"https://adwords.google.com/api/adwords/cm/v201809 ListReturnValue.Type,omitempty"`
+	Value               []AdGroupFeed `xml:"https://adwords.google.com/api/adwords/cm/v201809 value,omitempty"`
+}
+
+// MarshalXML encodes v with its xsi:type.
+func (v AdGroupFeedReturnValue) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"}, Value: "AdGroupFeedReturnValue"})
+	type adGroupFeedReturnValue AdGroupFeedReturnValue
+	return e.EncodeElement(adGroupFeedReturnValue(v), start)
+}
+
+// ConstantOperand is a constant operand in a matching function.
+type ConstantOperand struct {
+	// Indicates that this instance is a subtype of FunctionArgumentOperand.
+	FunctionArgumentOperandType string `xml:"https://adwords.google.com/api/adwords/cm/v201809 FunctionArgumentOperand.Type,omitempty"`
+	// The type of the constant operand.
+	Type ConstantOperandConstantType `xml:"https://adwords.google.com/api/adwords/cm/v201809 type,omitempty"`
+	// The units of the constant operand.
+	Unit ConstantOperandUnit `xml:"https://adwords.google.com/api/adwords/cm/v201809 unit,omitempty"`
+	// Long value to be used when the type is LONG.
+	LongValue int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 longValue,omitempty"`
+	// Boolean value to be used when the type is BOOLEAN.
+	BooleanValue bool `xml:"https://adwords.google.com/api/adwords/cm/v201809 booleanValue,omitempty"`
+	// Double value to be used when the type is DOUBLE.
+	DoubleValue float64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 doubleValue,omitempty"`
+	// String value to be used when the type is STRING.
+	StringValue string `xml:"https://adwords.google.com/api/adwords/cm/v201809 stringValue,omitempty"`
+}
+
+// MarshalXML encodes v with its xsi:type.
+func (v ConstantOperand) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"}, Value: "ConstantOperand"})
+	type constantOperand ConstantOperand
+	return e.EncodeElement(constantOperand(v), start)
+}
+
+// FeedAttributeOperand represents a feed attribute reference to use in
+// a function.
+type FeedAttributeOperand struct {
+	// Indicates that this instance is a subtype of FunctionArgumentOperand.
+	FunctionArgumentOperandType string `xml:"https://adwords.google.com/api/adwords/cm/v201809 FunctionArgumentOperand.Type,omitempty"`
+	// The id of the feed to which the attribute belongs.
+	FeedId int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 feedId,omitempty"`
+	// The id of the feed attribute.
+	FeedAttributeId int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 feedAttributeId,omitempty"`
+}
+
+// MarshalXML encodes v with its xsi:type.
+func (v FeedAttributeOperand) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"}, Value: "FeedAttributeOperand"})
+	type feedAttributeOperand FeedAttributeOperand
+	return e.EncodeElement(feedAttributeOperand(v), start)
+}
+
+// Function represents a function where its operator is applied to its
+// argument operands resulting in a return value. It has the form
+// (Operand... Operator Operand...). The type of the return value
+// depends on the operator being applied and the type of the operands.
+type Function struct {
+	// Operator for a function.
+	Operator FunctionOperator `xml:"https://adwords.google.com/api/adwords/cm/v201809 operator,omitempty"`
+	// LhsOperand is one of ConstantOperand, FeedAttributeOperand, FunctionOperand, RequestContextOperand.
+	LhsOperand []interface{} `xml:"https://adwords.google.com/api/adwords/cm/v201809 lhsOperand,omitempty"`
+	// RhsOperand is one of ConstantOperand, FeedAttributeOperand, FunctionOperand, RequestContextOperand.
+	RhsOperand []interface{} `xml:"https://adwords.google.com/api/adwords/cm/v201809 rhsOperand,omitempty"`
+	// String representation of the Function.
+	FunctionString string `xml:"https://adwords.google.com/api/adwords/cm/v201809 functionString,omitempty"`
+}
+
+// UnmarshalXML decodes v, with the types of its polymorphic fields taken
+// from their xsi:type.
+func (v *Function) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "operator":
+				err = dec.DecodeElement(&v.Operator, &t)
+			case "lhsOperand":
+				var value interface{}
+				if value, err = unmarshalFunctionArgumentOperand(dec, t); err == nil {
+					v.LhsOperand = append(v.LhsOperand, value)
+				}
+			case "rhsOperand":
+				var value interface{}
+				if value, err = unmarshalFunctionArgumentOperand(dec, t); err == nil {
+					v.RhsOperand = append(v.RhsOperand, value)
+				}
+			case "functionString":
+				err = dec.DecodeElement(&v.FunctionString, &t)
+			default:
+				err = dec.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// FunctionOperand is a function operand in a matching function. Used to
+// represent nested functions.
+type FunctionOperand struct {
+	// Indicates that this instance is a subtype of FunctionArgumentOperand.
+	FunctionArgumentOperandType string `xml:"https://adwords.google.com/api/adwords/cm/v201809 FunctionArgumentOperand.Type,omitempty"`
+	// The function to use as an operand.
+	Value *Function `xml:"https://adwords.google.com/api/adwords/cm/v201809 value,omitempty"`
+}
+
+// MarshalXML encodes v with its xsi:type.
+func (v FunctionOperand) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"}, Value: "FunctionOperand"})
+	type functionOperand FunctionOperand
+	return e.EncodeElement(functionOperand(v), start)
+}
+
+// RequestContextOperand is an operand in a function referring to a
+// value in the request context.
+type RequestContextOperand struct {
+	// Indicates that this instance is a subtype of FunctionArgumentOperand.
+	FunctionArgumentOperandType string `xml:"https://adwords.google.com/api/adwords/cm/v201809 FunctionArgumentOperand.Type,omitempty"`
+	// The type of context field to use.
+	ContextType RequestContextOperandContextType `xml:"https://adwords.google.com/api/adwords/cm/v201809 contextType,omitempty"`
+}
+
+// MarshalXML encodes v with its xsi:type.
+func (v RequestContextOperand) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"}, Value: "RequestContextOperand"})
+	type requestContextOperand RequestContextOperand
+	return e.EncodeElement(requestContextOperand(v), start)
+}
+
+// unmarshalFunctionArgumentOperand decodes start into the type named by its xsi:type.
+func unmarshalFunctionArgumentOperand(dec *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	var typ string
+	for _, a := range start.Attr {
+		if a.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" && a.Name.Local == "type" {
+			typ = a.Value[strings.LastIndex(a.Value, ":")+1:]
+		}
+	}
+	switch typ {
+	case "ConstantOperand":
+		var value ConstantOperand
+		err := dec.DecodeElement(&value, &start)
+		return value, err
+	case "FeedAttributeOperand":
+		var value FeedAttributeOperand
+		err := dec.DecodeElement(&value, &start)
+		return value, err
+	case "FunctionOperand":
+		var value FunctionOperand
+		err := dec.DecodeElement(&value, &start)
+		return value, err
+	case "RequestContextOperand":
+		var value RequestContextOperand
+		err := dec.DecodeElement(&value, &start)
+		return value, err
+	}
+	return nil, fmt.Errorf("unknown FunctionArgumentOperand type %q", typ)
+}
+
+// AdGroupFeedService is a client of AdGroupFeedService.
 type AdGroupFeedService struct {
 	Auth
 }
 
+// NewAdGroupFeedService returns a client of AdGroupFeedService.
 func NewAdGroupFeedService(auth *Auth) *AdGroupFeedService {
 	return &AdGroupFeedService{Auth: *auth}
 }
 
-type AdGroupFeedOperations struct {
+// Get calls get of AdGroupFeedService.
+//
+// Returns a list of AdGroupFeeds that meet the selector criteria.
+func (s *AdGroupFeedService) Get(selector Selector) (AdGroupFeedPage, error) {
+	return s.GetContext(context.Background(), selector)
 }
 
-type AdGroupFeed struct {
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *AdGroupFeedService) GetContext(ctx context.Context, selector Selector) (rval AdGroupFeedPage, err error) {
+	respBody, err := s.Auth.request(ctx, adGroupFeedServiceUrl, "get", struct {
+		XMLName  xml.Name
+		Selector Selector `xml:"https://adwords.google.com/api/adwords/cm/v201809 selector"`
+	}{
+		XMLName:  xml.Name{Space: baseUrl, Local: "get"},
+		Selector: selector,
+	})
+	if err != nil {
+		return rval, err
+	}
+	resp := struct {
+		Rval AdGroupFeedPage `xml:"rval"`
+	}{}
+	err = xml.Unmarshal(respBody, &resp)
+	return resp.Rval, err
 }
 
-// Get is not yet implemented
-//
-// Relevant documentation
-//
-//     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupFeedService#get
+// Mutate calls mutate of AdGroupFeedService.
 //
-func (s AdGroupFeedService) Get(selector Selector) (adGroupFeeds []AdGroupFeed, err error) {
-	return adGroupFeeds, ERROR_NOT_YET_IMPLEMENTED
+// Adds, updates or removes AdGroupFeeds.
+func (s *AdGroupFeedService) Mutate(operations []AdGroupFeedOperation) (AdGroupFeedReturnValue, error) {
+	return s.MutateContext(context.Background(), operations)
 }
 
-// Mutate is not yet implemented
-//
-// Relevant documentation
-//
-//     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupFeedService#mutate
-//
-func (s *AdGroupFeedService) Mutate(adGroupFeedOperations AdGroupFeedOperations) (adGroupFeeds []AdGroupFeed, err error) {
-	return adGroupFeeds, ERROR_NOT_YET_IMPLEMENTED
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *AdGroupFeedService) MutateContext(ctx context.Context, operations []AdGroupFeedOperation) (rval AdGroupFeedReturnValue, err error) {
+	respBody, err := s.Auth.request(ctx, adGroupFeedServiceUrl, "mutate", struct {
+		XMLName    xml.Name
+		Operations []AdGroupFeedOperation `xml:"https://adwords.google.com/api/adwords/cm/v201809 operations"`
+	}{
+		XMLName:    xml.Name{Space: baseUrl, Local: "mutate"},
+		Operations: operations,
+	})
+	if err != nil {
+		return rval, err
+	}
+	resp := struct {
+		Rval AdGroupFeedReturnValue `xml:"rval"`
+	}{}
+	err = xml.Unmarshal(respBody, &resp)
+	return resp.Rval, err
 }
 
-// Query is not yet implemented
-//
-// Relevant documentation
+// Query calls query of AdGroupFeedService.
 //
-//     https://developers.google.com/adwords/api/docs/reference/v201409/AdGroupFeedService#query
-//
-func (s *AdGroupFeedService) Query(query string) (adGroupFeeds []AdGroupFeed, err error) {
-	return adGroupFeeds, ERROR_NOT_YET_IMPLEMENTED
+// Returns the list of AdGroupFeeds that match the query.
+func (s *AdGroupFeedService) Query(query string) (AdGroupFeedPage, error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *AdGroupFeedService) QueryContext(ctx context.Context, query string) (rval AdGroupFeedPage, err error) {
+	respBody, err := s.Auth.request(ctx, adGroupFeedServiceUrl, "query", struct {
+		XMLName xml.Name
+		Query   string `xml:"https://adwords.google.com/api/adwords/cm/v201809 query"`
+	}{
+		XMLName: xml.Name{Space: baseUrl, Local: "query"},
+		Query:   query,
+	})
+	if err != nil {
+		return rval, err
+	}
+	resp := struct {
+		Rval AdGroupFeedPage `xml:"rval"`
+	}{}
+	err = xml.Unmarshal(respBody, &resp)
+	return resp.Rval, err
 }
@@ -1,25 +1,133 @@
+// Code generated by wsdlgen; DO NOT EDIT.
+
 package v201809
 
-//	"encoding/xml"
-//	"fmt"
+import (
+	"context"
+	"encoding/xml"
+)
 
-type AdParamService struct {
-	Auth
-}
+// Operator is the operator.
+type Operator string
+
+const (
+	OperatorAdd    Operator = "ADD"
+	OperatorRemove Operator = "REMOVE"
+	OperatorSet    Operator = "SET"
+)
 
+// AdParam represents an ad parameter. Use ad parameters to update
+// numeric values (such as prices or inventory levels) in any line of a
+// text ad, including the headline and description lines.
 type AdParam struct {
+	// ID of the associated ad group.
+	AdGroupId int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 adGroupId,omitempty"`
+	// ID of the associated keyword.
+	CriterionId int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 criterionId,omitempty"`
+	// Numeric value or currency value (eg. 15, $99.99) to insert into the
+	// ad text.
+	InsertionText string `xml:"https://adwords.google.com/api/adwords/cm/v201809 insertionText,omitempty"`
+	// Defines which parameterized snippet of ad text to replace.
+	ParamIndex int `xml:"https://adwords.google.com/api/adwords/cm/v201809 paramIndex,omitempty"`
+}
+
+// AdParamOperation represents an operation on an AdParam. The supported
+// operators are SET and REMOVE.
+type AdParamOperation struct {
+	// Operator.
+	Operator Operator `xml:"https://adwords.google.com/api/adwords/cm/v201809 operator,omitempty"`
+	// Indicates that this instance is a subtype of Operation.
+	OperationType string `xml:"https://adwords.google.com/api/adwords/cm/v201809 Operation.Type,omitempty"`
+	// The ad parameter to operate on.
+	Operand *AdParam `xml:"https://adwords.google.com/api/adwords/cm/v201809 operand,omitempty"`
+}
+
+// MarshalXML encodes v with its xsi:type.
+func (v AdParamOperation) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"}, Value: "AdParamOperation"})
+	type adParamOperation AdParamOperation
+	return e.EncodeElement(adParamOperation(v), start)
+}
+
+// AdParamPage represents a page of AdParams returned by the
+// AdParamService.
+type AdParamPage struct {
+	// Total number of entries in the result that this page is a part of.
+	TotalNumEntries int `xml:"https://adwords.google.com/api/adwords/cm/v201809 totalNumEntries,omitempty"`
+	// Indicates that this instance is a subtype of Page.
+	PageType string    `xml:"https://adwords.google.com/api/adwords/cm/v201809 Page.Type,omitempty"`
+	Entries  []AdParam `xml:"https://adwords.google.com/api/adwords/cm/v201809 entries,omitempty"`
+}
+
+// MarshalXML encodes v with its xsi:type.
+func (v AdParamPage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"}, Value: "AdParamPage"})
+	type adParamPage AdParamPage
+	return e.EncodeElement(adParamPage(v), start)
+}
+
+// AdParamService is a client of AdParamService.
+type AdParamService struct {
+	Auth
 }
 
+// NewAdParamService returns a client of AdParamService.
 func NewAdParamService(auth *Auth) *AdParamService {
 	return &AdParamService{Auth: *auth}
 }
 
-// Query is not yet implemented
-//
-// Relevant documentation
+// Get calls get of AdParamService.
 //
-//     https://developers.google.com/adwords/api/docs/reference/v201409/AdParamService#get
+// Returns the ad parameters that match the criteria specified in the
+// selector.
+func (s *AdParamService) Get(serviceSelector Selector) (AdParamPage, error) {
+	return s.GetContext(context.Background(), serviceSelector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *AdParamService) GetContext(ctx context.Context, serviceSelector Selector) (rval AdParamPage, err error) {
+	respBody, err := s.Auth.request(ctx, adParamServiceUrl, "get", struct {
+		XMLName         xml.Name
+		ServiceSelector Selector `xml:"https://adwords.google.com/api/adwords/cm/v201809 serviceSelector"`
+	}{
+		XMLName:         xml.Name{Space: baseUrl, Local: "get"},
+		ServiceSelector: serviceSelector,
+	})
+	if err != nil {
+		return rval, err
+	}
+	resp := struct {
+		Rval AdParamPage `xml:"rval"`
+	}{}
+	err = xml.Unmarshal(respBody, &resp)
+	return resp.Rval, err
+}
+
+// Mutate calls mutate of AdParamService.
 //
-func (s AdParamService) Get(selector Selector) (adParams []AdParam, err error) {
-	return adParams, ERROR_NOT_YET_IMPLEMENTED
+// Sets and removes ad parameters. Note: ADD is not supported. Use SET
+// for new ad parameters.
+func (s *AdParamService) Mutate(operations []AdParamOperation) ([]AdParam, error) {
+	return s.MutateContext(context.Background(), operations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *AdParamService) MutateContext(ctx context.Context, operations []AdParamOperation) (rval []AdParam, err error) {
+	respBody, err := s.Auth.request(ctx, adParamServiceUrl, "mutate", struct {
+		XMLName    xml.Name
+		Operations []AdParamOperation `xml:"https://adwords.google.com/api/adwords/cm/v201809 operations"`
+	}{
+		XMLName:    xml.Name{Space: baseUrl, Local: "mutate"},
+		Operations: operations,
+	})
+	if err != nil {
+		return rval, err
+	}
+	resp := struct {
+		Rval []AdParam `xml:"rval"`
+	}{}
+	err = xml.Unmarshal(respBody, &resp)
+	return resp.Rval, err
 }
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	sha256 "crypto/sha256"
 	"encoding/xml"
 	"fmt"
@@ -237,8 +238,15 @@ func NewMutateMembersOperand() *MutateMembersOperand {
 //     https://developers.google.com/adwords/api/docs/reference/v201809/AdwordsUserListService#get
 //
 func (s AdwordsUserListService) Get(selector Selector) (userLists []UserList, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s AdwordsUserListService) GetContext(ctx context.Context, selector Selector) (userLists []UserList, err error) {
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		adwordsUserListServiceUrl,
 		"get",
 		struct {
@@ -259,7 +267,6 @@ func (s AdwordsUserListService) Get(selector Selector) (userLists []UserList, er
 		Size      int64      `xml:"rval>totalNumEntries"`
 		UserLists []UserList `xml:"rval>entries"`
 	}{}
-	fmt.Printf("%s\n", respBody)
 	err = xml.Unmarshal([]byte(respBody), &getResp)
 	if err != nil {
 		return userLists, err
@@ -290,25 +297,32 @@ func (s AdwordsUserListService) Get(selector Selector) (userLists []UserList, er
 //     https://developers.google.com/adwords/api/docs/reference/v201809/AdwordsUserListService#mutate
 //
 func (s *AdwordsUserListService) Mutate(userListOperations UserListOperations) (adwordsUserLists []UserList, err error) {
+	return s.MutateContext(context.Background(), userListOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *AdwordsUserListService) MutateContext(ctx context.Context, userListOperations UserListOperations) (adwordsUserLists []UserList, err error) {
 
 	userListOperations.XMLName = xml.Name{
 		Space: baseRemarketingUrl,
 		Local: "mutate",
 	}
 
-	respBody, err := s.Auth.request(adwordsUserListServiceUrl, "mutate", userListOperations)
+	respBody, meta, err := s.Auth.requestMeta(ctx, adwordsUserListServiceUrl, "mutate", userListOperations)
 	if err != nil {
 		return adwordsUserLists, err
 	}
 	mutateResp := struct {
-		AdwordsUserLists []UserList `xml:"rval>value"`
+		AdwordsUserLists     []UserList `xml:"rval>value"`
+		PartialFailureErrors apiErrors  `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return adwordsUserLists, err
 	}
 
-	return mutateResp.AdwordsUserLists, err
+	return mutateResp.AdwordsUserLists, partialFailure(meta.RequestId, userListOperations.Operations, mutateResp.AdwordsUserLists, mutateResp.PartialFailureErrors)
 }
 
 // Mutate adds/removes members/emails to specified user list.
@@ -340,12 +354,18 @@ func (s *AdwordsUserListService) Mutate(userListOperations UserListOperations) (
 //     https://developers.google.com/adwords/api/docs/reference/v201809/AdwordsUserListService#mutateMembers
 //
 func (s *AdwordsUserListService) MutateMembers(mutateMembersOperations MutateMembersOperations) (adwordsUserLists []UserList, err error) {
+	return s.MutateMembersContext(context.Background(), mutateMembersOperations)
+}
+
+// MutateMembersContext is like MutateMembers but takes a context that controls the
+// request lifetime.
+func (s *AdwordsUserListService) MutateMembersContext(ctx context.Context, mutateMembersOperations MutateMembersOperations) (adwordsUserLists []UserList, err error) {
 	mutateMembersOperations.XMLName = xml.Name{
 		Space: baseRemarketingUrl,
 		Local: "mutateMembers",
 	}
 
-	respBody, err := s.Auth.request(adwordsUserListServiceUrl, "mutateMembers", mutateMembersOperations)
+	respBody, err := s.Auth.request(ctx, adwordsUserListServiceUrl, "mutateMembers", mutateMembersOperations)
 	if err != nil {
 		return adwordsUserLists, err
 	}
@@ -372,11 +392,15 @@ func (mmo MutateMembersOperand) MarshalXML(e *xml.Encoder, start xml.StartElemen
 	return nil
 }
 
+// encodeEmails hashes the members into a new slice, so that encoding the
+// operand again, as retries and chunking do, leaves the caller's members
+// as they were.
 func (mmo *MutateMembersOperand) encodeEmails() {
-
+	hashed := make([]string, len(mmo.Members))
 	for key, value := range mmo.Members {
 		h256 := sha256.New()
 		io.WriteString(h256, value)
-		mmo.Members[key] = fmt.Sprintf("%x", h256.Sum(nil))
+		hashed[key] = fmt.Sprintf("%x", h256.Sum(nil))
 	}
+	mmo.Members = hashed
 }
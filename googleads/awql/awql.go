@@ -0,0 +1,252 @@
+// Package awql builds, parses and validates AWQL queries, as taken by the
+// Query methods of the services and by ReportDownloadService.AWQL:
+//
+//	q := awql.Select("CampaignId", "Clicks").
+//		From("CAMPAIGN_PERFORMANCE_REPORT").
+//		Where("CampaignStatus", awql.In, "ENABLED", "PAUSED").
+//		During(awql.Last7Days)
+//	report, err := reportService.AWQL(q.String(), "CSV")
+//
+// Queries are checked against the fields of a service or of a report with
+// a Schema before being sent.
+package awql
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// An Operator compares a field with the values of a Condition.
+type Operator string
+
+const (
+	Equals                   Operator = "="
+	NotEquals                Operator = "!="
+	GreaterThan              Operator = ">"
+	GreaterThanEquals        Operator = ">="
+	LessThan                 Operator = "<"
+	LessThanEquals           Operator = "<="
+	In                       Operator = "IN"
+	NotIn                    Operator = "NOT_IN"
+	StartsWith               Operator = "STARTS_WITH"
+	StartsWithIgnoreCase     Operator = "STARTS_WITH_IGNORE_CASE"
+	Contains                 Operator = "CONTAINS"
+	ContainsIgnoreCase       Operator = "CONTAINS_IGNORE_CASE"
+	DoesNotContain           Operator = "DOES_NOT_CONTAIN"
+	DoesNotContainIgnoreCase Operator = "DOES_NOT_CONTAIN_IGNORE_CASE"
+	ContainsAny              Operator = "CONTAINS_ANY"
+	ContainsNone             Operator = "CONTAINS_NONE"
+	ContainsAll              Operator = "CONTAINS_ALL"
+)
+
+var operators = map[string]Operator{}
+
+func init() {
+	for _, op := range []Operator{
+		Equals, NotEquals, GreaterThan, GreaterThanEquals, LessThan, LessThanEquals,
+		In, NotIn, StartsWith, StartsWithIgnoreCase, Contains, ContainsIgnoreCase,
+		DoesNotContain, DoesNotContainIgnoreCase, ContainsAny, ContainsNone, ContainsAll,
+	} {
+		operators[string(op)] = op
+	}
+}
+
+// List reports whether op takes a list of values, written in brackets.
+func (op Operator) List() bool {
+	switch op {
+	case In, NotIn, ContainsAny, ContainsNone, ContainsAll:
+		return true
+	}
+	return false
+}
+
+// A DateRange is a predefined range of dates of a DURING clause.
+type DateRange string
+
+const (
+	Today            DateRange = "TODAY"
+	Yesterday        DateRange = "YESTERDAY"
+	Last7Days        DateRange = "LAST_7_DAYS"
+	Last14Days       DateRange = "LAST_14_DAYS"
+	Last30Days       DateRange = "LAST_30_DAYS"
+	LastWeek         DateRange = "LAST_WEEK"
+	LastBusinessWeek DateRange = "LAST_BUSINESS_WEEK"
+	LastWeekSunSat   DateRange = "LAST_WEEK_SUN_SAT"
+	ThisWeekSunToday DateRange = "THIS_WEEK_SUN_TODAY"
+	ThisWeekMonToday DateRange = "THIS_WEEK_MON_TODAY"
+	ThisMonth        DateRange = "THIS_MONTH"
+	LastMonth        DateRange = "LAST_MONTH"
+	AllTime          DateRange = "ALL_TIME"
+)
+
+var dateRanges = map[string]DateRange{}
+
+func init() {
+	for _, r := range []DateRange{
+		Today, Yesterday, Last7Days, Last14Days, Last30Days, LastWeek, LastBusinessWeek,
+		LastWeekSunSat, ThisWeekSunToday, ThisWeekMonToday, ThisMonth, LastMonth, AllTime,
+	} {
+		dateRanges[string(r)] = r
+	}
+}
+
+// dateFormat is the format of the dates of a DURING clause.
+const dateFormat = "20060102"
+
+// A Condition of a WHERE clause.
+type Condition struct {
+	Field    string
+	Operator Operator
+	Values   []string
+}
+
+// An Ordering of an ORDER BY clause.
+type Ordering struct {
+	Field      string
+	Descending bool
+}
+
+// Dates are the dates of the DURING clause of a report query: either a
+// predefined Range, or the dates from Min to Max formatted as YYYYMMDD.
+type Dates struct {
+	Range    DateRange
+	Min, Max string
+}
+
+// A Page is the LIMIT clause of a service query.
+type Page struct {
+	Offset, Count int64
+}
+
+// A Query is the syntax tree of an AWQL query.
+type Query struct {
+	Fields []string
+	// Report is the report of the FROM clause.  Service queries have
+	// none.
+	Report     string
+	Conditions []Condition
+	Dates      *Dates
+	Ordering   []Ordering
+	Page       *Page
+}
+
+// Select starts a query of fields.
+func Select(fields ...string) *Query {
+	return &Query{Fields: fields}
+}
+
+// From sets the report queried.
+func (q *Query) From(report string) *Query {
+	q.Report = report
+	return q
+}
+
+// Where adds a condition on field.
+func (q *Query) Where(field string, op Operator, values ...string) *Query {
+	q.Conditions = append(q.Conditions, Condition{Field: field, Operator: op, Values: values})
+	return q
+}
+
+// During sets the dates of a report query to a predefined range.
+func (q *Query) During(r DateRange) *Query {
+	q.Dates = &Dates{Range: r}
+	return q
+}
+
+// DuringDates sets the dates of a report query from min to max, both
+// included.
+func (q *Query) DuringDates(min, max time.Time) *Query {
+	q.Dates = &Dates{Min: min.Format(dateFormat), Max: max.Format(dateFormat)}
+	return q
+}
+
+// OrderBy adds field to the ordering of a service query.
+func (q *Query) OrderBy(field string, descending bool) *Query {
+	q.Ordering = append(q.Ordering, Ordering{Field: field, Descending: descending})
+	return q
+}
+
+// Limit sets the page of a service query.
+func (q *Query) Limit(offset, count int64) *Query {
+	q.Page = &Page{Offset: offset, Count: count}
+	return q
+}
+
+// String returns the query in AWQL.  Values are quoted unless they are
+// numbers.
+func (q *Query) String() string {
+	var b strings.Builder
+	b.WriteString("SELECT ")
+	b.WriteString(strings.Join(q.Fields, ", "))
+	if q.Report != "" {
+		b.WriteString(" FROM ")
+		b.WriteString(q.Report)
+	}
+	for i, c := range q.Conditions {
+		if i == 0 {
+			b.WriteString(" WHERE ")
+		} else {
+			b.WriteString(" AND ")
+		}
+		b.WriteString(c.Field + " " + string(c.Operator) + " ")
+		if !c.Operator.List() {
+			if len(c.Values) > 0 {
+				b.WriteString(quote(c.Values[0]))
+			}
+			continue
+		}
+		b.WriteByte('[')
+		for j, v := range c.Values {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(quote(v))
+		}
+		b.WriteByte(']')
+	}
+	if d := q.Dates; d != nil {
+		b.WriteString(" DURING ")
+		if d.Range != "" {
+			b.WriteString(string(d.Range))
+		} else {
+			b.WriteString(d.Min + "," + d.Max)
+		}
+	}
+	for i, o := range q.Ordering {
+		if i == 0 {
+			b.WriteString(" ORDER BY ")
+		} else {
+			b.WriteString(", ")
+		}
+		b.WriteString(o.Field)
+		if o.Descending {
+			b.WriteString(" DESC")
+		}
+	}
+	if p := q.Page; p != nil {
+		b.WriteString(" LIMIT " + strconv.FormatInt(p.Offset, 10) + "," + strconv.FormatInt(p.Count, 10))
+	}
+	return b.String()
+}
+
+var number = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// quote returns v as an AWQL literal: numbers as they are, anything else
+// in double quotes.
+func quote(v string) string {
+	if number.MatchString(v) {
+		return v
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range v {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package awql
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	gads "github.com/denton/gads/googleads"
+)
+
+func TestBuild(t *testing.T) {
+	q := Select("Id", "Name").
+		Where("Name", StartsWith, `Brand "A"`).
+		Where("Id", In, "1", "2").
+		OrderBy("Name", true).
+		Limit(0, 50)
+	want := `SELECT Id, Name WHERE Name STARTS_WITH "Brand \"A\"" AND Id IN [1, 2] ORDER BY Name DESC LIMIT 0,50`
+	if q.String() != want {
+		t.Errorf("want %s, got %s", want, q)
+	}
+
+	q = Select("CampaignId", "Clicks").From("CAMPAIGN_PERFORMANCE_REPORT").
+		DuringDates(time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC), time.Date(2018, 9, 30, 0, 0, 0, 0, time.UTC))
+	if want := "SELECT CampaignId, Clicks FROM CAMPAIGN_PERFORMANCE_REPORT DURING 20180901,20180930"; q.String() != want {
+		t.Errorf("want %s, got %s", want, q)
+	}
+}
+
+func TestParse(t *testing.T) {
+	q, err := Parse(`select Id, Name from CAMPAIGN_PERFORMANCE_REPORT where Name contains_ignore_case 'it''s' AND Id NOT_IN [1,2] and Status = ENABLED during last_7_days`)
+	if err == nil {
+		t.Fatalf("want a syntax error for the unescaped quote, got %#v", q)
+	}
+
+	q, err = Parse(`select Id, Name where Name CONTAINS_IGNORE_CASE 'it\'s' AND Id NOT_IN [1,2] and Status != "PAUSED" order by Name desc, Id LIMIT 10,20`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Query{
+		Fields: []string{"Id", "Name"},
+		Conditions: []Condition{
+			{"Name", ContainsIgnoreCase, []string{"it's"}},
+			{"Id", NotIn, []string{"1", "2"}},
+			{"Status", NotEquals, []string{"PAUSED"}},
+		},
+		Ordering: []Ordering{{"Name", true}, {"Id", false}},
+		Page:     &Page{10, 20},
+	}
+	if !reflect.DeepEqual(q, want) {
+		t.Errorf("want %#v, got %#v", want, q)
+	}
+	if again, err := Parse(q.String()); err != nil || !reflect.DeepEqual(again, q) {
+		t.Errorf("%s does not parse back: %v", q, err)
+	}
+
+	for _, bad := range []string{
+		"Id, Name",
+		"SELECT Id WHERE Id LIKE 1",
+		"SELECT Id WHERE Id IN 1",
+		"SELECT Id FROM R DURING 2018,20180930",
+		"SELECT Id LIMIT 10",
+		"SELECT Id WHERE Name = 'open",
+	} {
+		var syntaxErr *SyntaxError
+		if _, err := Parse(bad); !errors.As(err, &syntaxErr) {
+			t.Errorf("%s: want *SyntaxError, got %v", bad, err)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	campaigns := ServiceSchema(gads.CampaignFields)
+	if _, err := campaigns.ParseAndValidate("SELECT Id, Name WHERE Status IN ['ENABLED'] ORDER BY Name"); err != nil {
+		t.Error(err)
+	}
+	_, err := campaigns.ParseAndValidate("SELECT Id, Nmae WHERE Settings = 1 DURING TODAY")
+	var invalid *ValidationError
+	if !errors.As(err, &invalid) || len(invalid.Problems) != 3 {
+		t.Errorf("want 3 problems, got %v", err)
+	}
+
+	report := ReportSchema("CAMPAIGN_PERFORMANCE_REPORT", []gads.ReportDefinitionField{
+		{FieldName: "CampaignId", CanSelect: true, CanFilter: true},
+		{FieldName: "CampaignStatus", CanSelect: true, CanFilter: true, IsEnumType: true, EnumValues: []string{"ENABLED", "PAUSED", "REMOVED"}},
+		{FieldName: "Clicks", CanSelect: true},
+	})
+	if _, err := report.ParseAndValidate("SELECT CampaignId, Clicks FROM CAMPAIGN_PERFORMANCE_REPORT WHERE CampaignStatus IN [ENABLED, PAUSED] DURING YESTERDAY"); err != nil {
+		t.Error(err)
+	}
+	_, err = report.ParseAndValidate("SELECT CampaignId FROM CAMPAIGN_PERFORMANCE_REPORT WHERE CampaignStatus = ACTIVE AND Clicks > 1 ORDER BY CampaignId")
+	want := []string{
+		`"ACTIVE" is not a value of "CampaignStatus"`,
+		`field "Clicks" is not filterable`,
+		`reports take neither ORDER BY nor LIMIT`,
+		`report queries need DURING`,
+	}
+	if !errors.As(err, &invalid) || !reflect.DeepEqual(invalid.Problems, want) {
+		t.Errorf("want %q, got %v", want, err)
+	}
+}
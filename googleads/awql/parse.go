@@ -0,0 +1,283 @@
+package awql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// A SyntaxError is returned by Parse for malformed queries.
+type SyntaxError struct {
+	// Offset is the byte offset in the query where the error was found.
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("awql: %s at offset %d", e.Msg, e.Offset)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenPunct
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+// lex splits query into tokens.
+func lex(query string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"' || c == '\'':
+			start := i
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(query) {
+					return nil, &SyntaxError{start, "unterminated string"}
+				}
+				if query[i] == '\\' && i+1 < len(query) {
+					i++
+				} else if query[i] == c {
+					break
+				}
+				b.WriteByte(query[i])
+			}
+			i++
+			tokens = append(tokens, token{tokenString, b.String(), start})
+		case c == '-' || c >= '0' && c <= '9':
+			start := i
+			for i++; i < len(query) && (query[i] >= '0' && query[i] <= '9' || query[i] == '.'); i++ {
+			}
+			tokens = append(tokens, token{tokenNumber, query[start:i], start})
+		case c == '_' || c == '*' || unicode.IsLetter(rune(c)):
+			start := i
+			for i++; i < len(query) && (query[i] == '_' || query[i] == '.' || unicode.IsLetter(rune(query[i])) || unicode.IsDigit(rune(query[i]))); i++ {
+			}
+			tokens = append(tokens, token{tokenIdent, query[start:i], start})
+		case strings.HasPrefix(query[i:], "!=") || strings.HasPrefix(query[i:], ">=") || strings.HasPrefix(query[i:], "<="):
+			tokens = append(tokens, token{tokenPunct, query[i : i+2], i})
+			i += 2
+		case strings.IndexByte(",[]=<>", c) >= 0:
+			tokens = append(tokens, token{tokenPunct, query[i : i+1], i})
+			i++
+		default:
+			return nil, &SyntaxError{i, fmt.Sprintf("unexpected %q", c)}
+		}
+	}
+	return append(tokens, token{tokenEOF, "", len(query)}), nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// keyword consumes the next token if it is the keyword kw, in any case.
+func (p *parser) keyword(kw string) bool {
+	if t := p.peek(); t.kind == tokenIdent && strings.EqualFold(t.text, kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) punct(s string) bool {
+	if t := p.peek(); t.kind == tokenPunct && t.text == s {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{p.peek().offset, fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) ident(what string) (string, error) {
+	if t := p.peek(); t.kind == tokenIdent {
+		p.pos++
+		return t.text, nil
+	}
+	return "", p.errorf("want %s, got %q", what, p.peek().text)
+}
+
+func (p *parser) number() (int64, error) {
+	t := p.peek()
+	n, err := strconv.ParseInt(t.text, 10, 64)
+	if t.kind != tokenNumber || err != nil {
+		return 0, p.errorf("want a number, got %q", t.text)
+	}
+	p.pos++
+	return n, nil
+}
+
+func (p *parser) value() (string, error) {
+	switch t := p.peek(); t.kind {
+	case tokenString, tokenNumber, tokenIdent:
+		p.pos++
+		return t.text, nil
+	}
+	return "", p.errorf("want a value, got %q", p.peek().text)
+}
+
+// Parse parses an AWQL query.  Keywords are case insensitive.
+func Parse(query string) (*Query, error) {
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	q := &Query{}
+	if !p.keyword("SELECT") {
+		return nil, p.errorf("want SELECT")
+	}
+	for {
+		field, err := p.ident("a field")
+		if err != nil {
+			return nil, err
+		}
+		q.Fields = append(q.Fields, field)
+		if !p.punct(",") {
+			break
+		}
+	}
+	if p.keyword("FROM") {
+		if q.Report, err = p.ident("a report"); err != nil {
+			return nil, err
+		}
+	}
+	if p.keyword("WHERE") {
+		for {
+			c, err := p.condition()
+			if err != nil {
+				return nil, err
+			}
+			q.Conditions = append(q.Conditions, c)
+			if !p.keyword("AND") {
+				break
+			}
+		}
+	}
+	if p.keyword("DURING") {
+		if q.Dates, err = p.dates(); err != nil {
+			return nil, err
+		}
+	}
+	if p.keyword("ORDER") {
+		if !p.keyword("BY") {
+			return nil, p.errorf("want BY")
+		}
+		for {
+			field, err := p.ident("a field")
+			if err != nil {
+				return nil, err
+			}
+			o := Ordering{Field: field}
+			if p.keyword("DESC") {
+				o.Descending = true
+			} else {
+				p.keyword("ASC")
+			}
+			q.Ordering = append(q.Ordering, o)
+			if !p.punct(",") {
+				break
+			}
+		}
+	}
+	if p.keyword("LIMIT") {
+		page := &Page{}
+		if page.Offset, err = p.number(); err != nil {
+			return nil, err
+		}
+		if !p.punct(",") {
+			return nil, p.errorf("want ,")
+		}
+		if page.Count, err = p.number(); err != nil {
+			return nil, err
+		}
+		q.Page = page
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.errorf("unexpected %q", t.text)
+	}
+	return q, nil
+}
+
+func (p *parser) condition() (c Condition, err error) {
+	if c.Field, err = p.ident("a field"); err != nil {
+		return c, err
+	}
+	t := p.next()
+	op, ok := operators[strings.ToUpper(t.text)]
+	if !ok || t.kind != tokenPunct && t.kind != tokenIdent {
+		return c, &SyntaxError{t.offset, fmt.Sprintf("unknown operator %q", t.text)}
+	}
+	c.Operator = op
+	if !op.List() {
+		v, err := p.value()
+		c.Values = []string{v}
+		return c, err
+	}
+	if !p.punct("[") {
+		return c, p.errorf("want [ after %s", op)
+	}
+	for !p.punct("]") {
+		if len(c.Values) > 0 && !p.punct(",") {
+			return c, p.errorf("want , or ]")
+		}
+		v, err := p.value()
+		if err != nil {
+			return c, err
+		}
+		c.Values = append(c.Values, v)
+	}
+	return c, nil
+}
+
+func (p *parser) dates() (*Dates, error) {
+	t := p.next()
+	if t.kind == tokenIdent {
+		if r, ok := dateRanges[strings.ToUpper(t.text)]; ok {
+			return &Dates{Range: r}, nil
+		}
+		return nil, &SyntaxError{t.offset, fmt.Sprintf("unknown date range %q", t.text)}
+	}
+	min := t
+	if !p.punct(",") {
+		return nil, p.errorf("want , between dates")
+	}
+	max := p.next()
+	for _, d := range []token{min, max} {
+		if _, err := time.Parse(dateFormat, d.text); d.kind != tokenNumber || err != nil {
+			return nil, &SyntaxError{d.offset, fmt.Sprintf("want a date as YYYYMMDD, got %q", d.text)}
+		}
+	}
+	return &Dates{Min: min.text, Max: max.text}, nil
+}
@@ -0,0 +1,152 @@
+package awql
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	gads "github.com/denton/gads/googleads"
+)
+
+// A Schema holds the fields a query may use: those of a service, or of a
+// report.
+type Schema struct {
+	// Report is the name of the report, empty for services.
+	Report string
+	fields map[string]*field
+}
+
+type field struct {
+	selectable, filterable bool
+	// enum holds the values of enum fields of reports.
+	enum map[string]bool
+}
+
+// ServiceSchema returns the Schema of the queries of a service, e.g.
+// ServiceSchema(gads.CampaignFields).
+func ServiceSchema(fields *gads.ServiceFields) *Schema {
+	s := &Schema{fields: map[string]*field{}}
+	for _, f := range fields.Fields() {
+		s.fields[string(f)] = &field{
+			selectable: fields.Selectable(f),
+			filterable: fields.Filterable(f),
+		}
+	}
+	return s
+}
+
+// ReportSchema returns the Schema of the queries of report, from the
+// fields returned by ReportDefinitionService.GetReportFields.
+func ReportSchema(report string, fields []gads.ReportDefinitionField) *Schema {
+	s := &Schema{Report: report, fields: map[string]*field{}}
+	for _, f := range fields {
+		sf := &field{selectable: f.CanSelect, filterable: f.CanFilter}
+		if f.IsEnumType {
+			sf.enum = map[string]bool{}
+			for _, v := range f.EnumValues {
+				sf.enum[v] = true
+			}
+		}
+		s.fields[f.FieldName] = sf
+	}
+	return s
+}
+
+// ValidationError lists the problems found in a query.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "awql: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate checks q against the fields of s, and that report queries have
+// a DURING clause but neither ORDER BY nor LIMIT, and service queries the
+// reverse.  The error is a *ValidationError.
+func (s *Schema) Validate(q *Query) error {
+	var problems []string
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if len(q.Fields) == 0 {
+		add("no fields selected")
+	}
+	for _, name := range q.Fields {
+		if f, ok := s.fields[name]; name != "*" && (!ok || !f.selectable) {
+			add("field %q is not selectable", name)
+		}
+	}
+	for _, c := range q.Conditions {
+		f, ok := s.fields[c.Field]
+		if !ok || !f.filterable {
+			add("field %q is not filterable", c.Field)
+		}
+		switch {
+		case len(c.Values) == 0:
+			add("no values for %s on %q", c.Operator, c.Field)
+		case !c.Operator.List() && len(c.Values) > 1:
+			add("%s on %q takes one value, got %d", c.Operator, c.Field, len(c.Values))
+		}
+		if ok && f.enum != nil {
+			for _, v := range c.Values {
+				if !f.enum[v] {
+					add("%q is not a value of %q", v, c.Field)
+				}
+			}
+		}
+	}
+
+	if s.Report != "" {
+		if q.Report != s.Report {
+			add("want FROM %s, got %q", s.Report, q.Report)
+		}
+		if q.Ordering != nil || q.Page != nil {
+			add("reports take neither ORDER BY nor LIMIT")
+		}
+		if q.Dates == nil {
+			add("report queries need DURING")
+		}
+	} else {
+		if q.Report != "" {
+			add("service queries take no FROM")
+		}
+		if q.Dates != nil {
+			add("service queries take no DURING")
+		}
+		for _, o := range q.Ordering {
+			if f, ok := s.fields[o.Field]; !ok || !f.selectable {
+				add("field %q cannot be ordered on", o.Field)
+			}
+		}
+	}
+
+	if d := q.Dates; d != nil && d.Range == "" {
+		min, minErr := time.Parse(dateFormat, d.Min)
+		max, maxErr := time.Parse(dateFormat, d.Max)
+		switch {
+		case minErr != nil || maxErr != nil:
+			add("dates %s,%s are not formatted as YYYYMMDD", d.Min, d.Max)
+		case max.Before(min):
+			add("dates %s,%s end before they start", d.Min, d.Max)
+		}
+	}
+	if p := q.Page; p != nil && (p.Offset < 0 || p.Count <= 0) {
+		add("invalid limit %d,%d", p.Offset, p.Count)
+	}
+
+	if problems != nil {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// ParseAndValidate parses query and validates it against s.
+func (s *Schema) ParseAndValidate(query string) (*Query, error) {
+	q, err := Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	return q, s.Validate(q)
+}
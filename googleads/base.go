@@ -3,16 +3,13 @@ package v201809
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/xml"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
-	"os"
-	"strings"
 	"testing"
-	"time"
 )
 
 const (
@@ -163,12 +160,6 @@ var (
 		"TrafficEstimatorService",
 	}
 )
-var (
-	knownErrors = []string{
-		"InternalApiError.UNEXPECTED_INTERNAL_API_ERROR",
-		"Service Unavailable",
-	}
-)
 
 func (s ServiceUrl) String() string {
 	if s.Name != "" {
@@ -185,6 +176,28 @@ type Auth struct {
 	ValidateOnly   bool
 	Testing        *testing.T `json:"-"`
 	Client         HttpClient `json:"-"`
+	// Endpoints overrides the hosts requests are sent to, production
+	// endpoints are used when nil.
+	Endpoints *Endpoints `json:",omitempty"`
+	// RetryPolicy decides which failed calls are retried, DefaultRetryPolicy
+	// is used when nil.
+	RetryPolicy RetryPolicy `json:"-"`
+	// OnResponse, if set, is called with the ResponseMeta of every response
+	// received, including those of attempts that are retried.
+	OnResponse func(ResponseMeta) `json:"-"`
+	// Interceptors wrap every call, the first one outermost.
+	Interceptors []Interceptor `json:"-"`
+	// Cache enables caching of the calls, the cache set up by InitCache is
+	// used when nil.
+	Cache *CacheConfig `json:"-"`
+	// Stats records the calls, DefaultStats is used when nil.
+	Stats *Stats `json:"-"`
+	// RateLimiter, if set, spaces out the calls, see RateLimiter.
+	RateLimiter *RateLimiter `json:"-"`
+	// Logger receives the log of every call, see Auth.logger for the
+	// default.
+	Logger    Logger    `json:"-"`
+	LogConfig LogConfig `json:"-"`
 }
 
 type HttpClient interface {
@@ -263,11 +276,12 @@ func selectorError() (err error) {
 }
 
 func (a *Auth) do(
+	ctx context.Context,
 	serviceUrl ServiceUrl,
 	action string,
 	body, ret interface{},
 ) error {
-	raw, err := a.doRequest(serviceUrl, action, body)
+	raw, _, err := a.doRequest(ctx, serviceUrl, action, body)
 	if err != nil {
 		return err
 	}
@@ -276,74 +290,53 @@ func (a *Auth) do(
 		return err
 	}
 
-	if level := os.Getenv("DEBUG"); level != "" {
-		if resBody, err := xml.MarshalIndent(ret, "  ", "  "); err != nil {
-			fmt.Println("warn: ", err)
-		} else {
-			fmt.Printf("response->\n%s\n", string(resBody))
-		}
-	}
-
 	return nil
 }
 
 func (a *Auth) request(
+	ctx context.Context,
 	serviceUrl ServiceUrl,
 	action string,
 	body interface{},
 ) (respBody []byte, err error) {
-	return a.doRequest(serviceUrl, action, body)
+	respBody, _, err = a.doRequest(ctx, serviceUrl, action, body)
+	return respBody, err
 }
 
-var (
-	tokenForCache string
-)
-
-func SetCacheToken(t string) {
-	tokenForCache = t
+// requestMeta is like request but returns the ResponseMeta of the response
+// too, e.g. to pass its request id to partialFailure.
+func (a *Auth) requestMeta(
+	ctx context.Context,
+	serviceUrl ServiceUrl,
+	action string,
+	body interface{},
+) (respBody []byte, meta ResponseMeta, err error) {
+	return a.doRequest(ctx, serviceUrl, action, body)
 }
 
 func (a *Auth) doRequest(
+	ctx context.Context,
 	serviceUrl ServiceUrl,
 	action string,
 	body interface{},
-) (respBody []byte, err error) {
-	var result []byte
-
-	for i := 3; i >= 0; i-- {
-		result, err := a.doRequestFunc(serviceUrl, action, body)
-		if err != nil {
-			if !isShouldRetry(err) {
-				return result, err
-			}
-
-			if i > 0 {
-				time.Sleep(time.Second * 5)
-			}
-		} else {
-			return result, err
+) (respBody []byte, meta ResponseMeta, err error) {
+	attempt := 0
+	err = a.retry(ctx, func() (err error) {
+		if attempt++; attempt > 1 {
+			a.stats().retry(a.CustomerId, serviceUrl.Name, action)
 		}
-	}
-	return result, err
-}
-
-func isShouldRetry(err error) bool {
-	errorText := err.Error()
-	for _, v := range knownErrors {
-		if strings.Contains(errorText, v) {
-			return true
-		}
-	}
-	return false
+		respBody, meta, err = a.doRequestFunc(ctx, serviceUrl, action, body)
+		return err
+	})
+	return respBody, meta, err
 }
 
 func (a *Auth) doRequestFunc(
+	ctx context.Context,
 	serviceUrl ServiceUrl,
 	action string,
 	body interface{},
-) (respBody []byte, err error) {
-
-	startTime := time.Now()
+) (respBody []byte, meta ResponseMeta, err error) {
 
 	type devToken struct {
 		XMLName xml.Name
@@ -393,140 +386,81 @@ func (a *Auth) doRequestFunc(
 		},
 		"  ", "  ")
 	if err != nil {
-		return []byte{}, err
-	}
-
-	// load cache
-	cacheResp, ok := []byte{}, false
-	if cache_ENABLED {
-		cacheResp, ok = cache.Get([]string{
-			serviceUrl.String(),
-			tokenForCache,
-			action,
-			string(reqBody),
-		})
-	}
-
-	respStatusCode := 0
-
-	if ok && cache_ENABLED {
-		respBody = cacheResp
-		respStatusCode = 200
-	} else {
-		req, err := http.NewRequest("POST", serviceUrl.String(), bytes.NewReader(reqBody))
-		req.Header.Add("Accept", "text/xml")
-		req.Header.Add("User-Agent", "gads (gzip)")
-		req.Header.Add("Accept-Encoding", "gzip")
-		req.Header.Add("Accept", "multipart/*")
-		req.Header.Add("Content-Type", "text/xml;charset=UTF-8")
-		contentLength := fmt.Sprintf("%d", len(reqBody))
-		req.Header.Add("Content-length", contentLength)
-		req.Header.Add("SOAPAction", action)
-		//if a.Testing != nil {
-		//	a.Testing.Logf("request ->\n%s\n%#v\n%s\n", req.URL.String(), req.Header, string(reqBody))
-		//}
-
-		// Added some logging/"poor man's" debugging to inspect outbound SOAP requests
-		if level := os.Getenv("DEBUG"); level != "" {
-			fmt.Printf("request ->\n%s\n%#v\n%s\n", req.URL.String(), req.Header, string(reqBody))
-		}
+		return []byte{}, meta, err
+	}
 
-		resp, err := a.Client.Do(req)
-		if err != nil {
-			return []byte{}, err
-		}
-		defer resp.Body.Close()
-
-		var reader io.ReadCloser
-		switch resp.Header.Get("Content-Encoding") {
-		case "gzip":
-			reader, err = gzip.NewReader(resp.Body)
-			defer reader.Close()
-		default:
-			reader = resp.Body
+	resp, err := a.invoker()(ctx, &Call{
+		Service: serviceUrl,
+		Action:  action,
+		Request: reqBody,
+	})
+	if resp == nil {
+		return []byte{}, meta, err
+	}
+	if !resp.decoded {
+		// an interceptor answered without calling the decoder
+		if decodeErr := resp.decode(); err == nil {
+			err = decodeErr
 		}
-
-		respBody, err = ioutil.ReadAll(reader)
+	}
+	if resp.decoded && resp.Meta.RequestId != "" {
+		a.responseMeta(ctx, resp.Meta)
 		if err != nil {
-			return []byte{}, err
+			err = &RequestIdError{RequestId: resp.Meta.RequestId, Err: err}
 		}
-		respStatusCode = resp.StatusCode
 	}
-
-	// save cache
-	if !ok && cache_ENABLED {
-		cache.Set(
-			[]string{
-				serviceUrl.String(),
-				action,
-				string(reqBody),
-			}, respBody,
-		)
+	if resp.payload == nil {
+		return resp.Body, resp.Meta, err
 	}
+	return resp.payload, resp.Meta, err
+}
 
-	defer stat.count(serviceUrl.Name, ok, cache_MEM, time.Since(startTime))
-
-	// Added some logging/"poor man's" debugging to inspect outbound SOAP requests
-	if level := os.Getenv("DEBUG"); level != "" {
-		fmt.Printf("response ->\n%s\n", string(respBody))
+// send posts the request envelope of call to the API and returns the raw
+// response.  It is the last Invoker of the interceptor chain.
+func (a *Auth) send(ctx context.Context, call *Call) (*Response, error) {
+	if err := a.waitRate(ctx, call.Service.Name); err != nil {
+		return nil, err
 	}
-
-	if a.Testing != nil {
-		a.Testing.Logf(
-			"respBody ->\n%s\n%s\n",
-			string(respBody),
-			fmt.Sprintf("%d", respStatusCode),
-		)
+	endpoint, err := a.endpoint(call.Service)
+	if err != nil {
+		return nil, err
 	}
-
-	type soapRespHeader struct {
-		RequestId    string `xml:"requestId"`
-		ServiceName  string `xml:"serviceName"`
-		MethodName   string `xml:"methodName"`
-		Operations   int64  `xml:"operations"`
-		ResponseTime int64  `xml:"responseTime"`
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(call.Request))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "text/xml")
+	req.Header.Add("User-Agent", "gads (gzip)")
+	req.Header.Add("Accept-Encoding", "gzip")
+	req.Header.Add("Accept", "multipart/*")
+	req.Header.Add("Content-Type", "text/xml;charset=UTF-8")
+	contentLength := fmt.Sprintf("%d", len(call.Request))
+	req.Header.Add("Content-length", contentLength)
+	req.Header.Add("SOAPAction", call.Action)
+
+	resp, err := a.Client.Do(req)
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
-	type soapRespBody struct {
-		Response []byte `xml:",innerxml"`
+	var reader io.ReadCloser
+	switch resp.Header.Get("Content-Encoding") {
+	case "gzip":
+		reader, err = gzip.NewReader(resp.Body)
+		defer reader.Close()
+	default:
+		reader = resp.Body
 	}
 
-	soapResp := struct {
-		XMLName xml.Name       `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
-		Header  soapRespHeader `xml:"Header>RequestHeader"`
-		Body    soapRespBody   `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
-	}{}
-
-	err = xml.Unmarshal([]byte(respBody), &soapResp)
+	respBody, err := ioutil.ReadAll(reader)
 	if err != nil {
-		return respBody, err
+		return nil, err
 	}
-	if respStatusCode == 400 || respStatusCode == 401 || respStatusCode == 403 || respStatusCode == 405 ||
-		respStatusCode == 500 {
-		fault := Fault{}
-		err = xml.Unmarshal(soapResp.Body.Response, &fault)
-		if err != nil {
-			return respBody, err
-		}
 
-		for i := range fault.Errors.ApiExceptionFaults {
-			switch fault.Errors.ApiExceptionFaults[i].ErrorsType {
-			case "AuthenticationError",
-				"RateExceededError",
-				"DatabaseError",
-				"InternalApiError":
-				return soapResp.Body.Response, &baseError{
-					code:    fault.Errors.ApiExceptionFaults[i].Reason,
-					origErr: &fault.Errors,
-				}
-			}
-		}
-
-		if fault.Errors.ApiExceptionFaults == nil {
-			return soapResp.Body.Response, errors.New(fault.FaultString)
-		}
-
-		return soapResp.Body.Response, &fault.Errors
-	}
-	return soapResp.Body.Response, err
+	return &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       respBody,
+	}, nil
 }
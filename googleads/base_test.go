@@ -1,8 +1,13 @@
 package v201809
 
 import (
+	"context"
 	"crypto/rand"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func rand_str(str_size int) string {
@@ -33,3 +38,62 @@ func testAuthSetup(t *testing.T) Auth {
 	config.Auth.Testing = t
 	return config.Auth
 }
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+const internalApiErrorFault = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <soap:Fault>
+      <faultcode>soap:Server</faultcode>
+      <faultstring>[InternalApiError.UNEXPECTED_INTERNAL_API_ERROR @ ]</faultstring>
+      <detail>
+        <ApiExceptionFault xmlns="https://adwords.google.com/api/adwords/cm/v201809">
+          <message>[InternalApiError.UNEXPECTED_INTERNAL_API_ERROR @ ]</message>
+          <ApplicationException.Type>ApiException</ApplicationException.Type>
+          <errors xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="InternalApiError">
+            <fieldPath></fieldPath>
+            <trigger></trigger>
+            <errorString>InternalApiError.UNEXPECTED_INTERNAL_API_ERROR</errorString>
+            <ApiError.Type>InternalApiError</ApiError.Type>
+            <reason>UNEXPECTED_INTERNAL_API_ERROR</reason>
+          </errors>
+        </ApiExceptionFault>
+      </detail>
+    </soap:Fault>
+  </soap:Body>
+</soap:Envelope>`
+
+func TestRequestContextCancelsRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	auth := Auth{
+		Client: httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Context() != ctx {
+				t.Errorf("request was not sent with the caller's context")
+			}
+			calls++
+			cancel()
+			return &http.Response{
+				StatusCode: 500,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(internalApiErrorFault)),
+			}, nil
+		}),
+	}
+
+	start := time.Now()
+	_, _, err := NewCampaignService(&auth).GetContext(ctx, Selector{Fields: []string{"Id"}})
+	if err != context.Canceled {
+		t.Fatalf("want context.Canceled, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("want 1 call, got %d", calls)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("retry sleep ignored cancellation, took %s", elapsed)
+	}
+}
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"strings"
@@ -95,9 +96,16 @@ func NewBatchJobService(auth *Auth) *BatchJobService {
 //
 // 	https://developers.google.com/adwords/api/docs/reference/v201809/BatchJobService#get
 func (s *BatchJobService) Get(selector Selector) (batchJobPage BatchJobPage, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *BatchJobService) GetContext(ctx context.Context, selector Selector) (batchJobPage BatchJobPage, err error) {
 
 	selector.XMLName = xml.Name{baseUrl, "selector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		batchJobServiceUrl,
 		"get",
 		struct {
@@ -137,6 +145,12 @@ func (s *BatchJobService) Get(selector Selector) (batchJobPage BatchJobPage, err
 //
 // 	https://developers.google.com/adwords/api/docs/reference/v201809/BatchJobService#mutate
 func (s *BatchJobService) Mutate(batchJobOperations BatchJobOperations) (batchJobs []BatchJob, err error) {
+	return s.MutateContext(context.Background(), batchJobOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *BatchJobService) MutateContext(ctx context.Context, batchJobOperations BatchJobOperations) (batchJobs []BatchJob, err error) {
 
 	mutation := struct {
 		XMLName xml.Name
@@ -147,19 +161,20 @@ func (s *BatchJobService) Mutate(batchJobOperations BatchJobOperations) (batchJo
 			Local: "mutate",
 		},
 		Ops: batchJobOperations.BatchJobOperations}
-	respBody, err := s.Auth.request(batchJobServiceUrl, "mutate", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, batchJobServiceUrl, "mutate", mutation)
 	if err != nil {
 		return batchJobs, err
 	}
 	mutateResp := struct {
-		BatchJobs []BatchJob `xml:"rval>value"`
+		BatchJobs            []BatchJob `xml:"rval>value"`
+		PartialFailureErrors apiErrors  `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return batchJobs, err
 	}
 
-	return mutateResp.BatchJobs, err
+	return mutateResp.BatchJobs, partialFailure(meta.RequestId, batchJobOperations.BatchJobOperations, mutateResp.BatchJobs, mutateResp.PartialFailureErrors)
 }
 
 func (s *BatchJobService) Query() {
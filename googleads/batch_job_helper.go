@@ -2,13 +2,14 @@ package v201809
 
 import (
 	"bytes"
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"os"
 	"reflect"
+	"strconv"
 )
 
 type BatchJobHelper struct {
@@ -45,6 +46,12 @@ func NewBatchJobHelper(auth *Auth) *BatchJobHelper {
 //
 //	https://developers.google.com/adwords/api/docs/guides/batch-jobs?hl=en#upload_operations_to_the_upload_url
 func (s *BatchJobHelper) UploadBatchJobOperations(jobOperations []interface{}, url TemporaryUrl) (err error) {
+	return s.UploadBatchJobOperationsContext(context.Background(), jobOperations, url)
+}
+
+// UploadBatchJobOperationsContext is like UploadBatchJobOperations but takes
+// a context that controls both upload requests.
+func (s *BatchJobHelper) UploadBatchJobOperationsContext(ctx context.Context, jobOperations []interface{}, url TemporaryUrl) (err error) {
 
 	var operations []Operation
 	for _, operation := range jobOperations {
@@ -53,16 +60,14 @@ func (s *BatchJobHelper) UploadBatchJobOperations(jobOperations []interface{}, u
 			case reflect.Map:
 				ops := reflect.ValueOf(operation)
 
-				keys := ops.MapKeys()
-
-				for _, action := range keys {
-					jobs := ops.MapIndex(action)
+				for _, action := range sortedActions(operation) {
+					jobs := ops.MapIndex(reflect.ValueOf(action))
 
 					for i := 0; i < jobs.Len(); i++ {
 
 						operations = append(operations,
 							Operation{
-								Operator: action.String(),
+								Operator: action,
 								Operand:  jobs.Index(i).Interface(),
 								Xsi_type: operationType,
 							},
@@ -88,72 +93,85 @@ func (s *BatchJobHelper) UploadBatchJobOperations(jobOperations []interface{}, u
 		client := &http.Client{}
 
 		// Need to get the upload url
-		req, err := http.NewRequest("POST", url.Url, nil)
-		if err != nil {
-			return err
-		}
-
-		req.Header.Set("Content-Type", "application/xml")
-		req.Header.Set("Content-Length", "0")
-		req.Header.Set("x-goog-resumable", "start")
-
-		response, err := client.Do(req)
-		if err != nil {
-			return err
-		}
-		defer response.Body.Close()
+		var location string
+		err = s.Auth.retry(ctx, func() error {
+			req, err := http.NewRequestWithContext(ctx, "POST", url.Url, nil)
+			if err != nil {
+				return err
+			}
 
-		// If we got a valid upload url it will be 201
-		if response.StatusCode != http.StatusCreated {
-			respBody, err := ioutil.ReadAll(response.Body)
+			req.Header.Set("Content-Type", "application/xml")
+			req.Header.Set("Content-Length", "0")
+			req.Header.Set("x-goog-resumable", "start")
 
+			response, err := client.Do(req)
 			if err != nil {
 				return err
 			}
-			return errors.New(fmt.Sprintf("Invalid response received. %v received. Body: %v", response.StatusCode, string(respBody)))
-		}
+			defer response.Body.Close()
 
-		location := response.Header.Get("Location")
+			// If we got a valid upload url it will be 201
+			if response.StatusCode != http.StatusCreated {
+				respBody, err := ioutil.ReadAll(response.Body)
 
-		reqBody, err := xml.MarshalIndent(mutation, "  ", "  ")
-		bodyLength := len(reqBody)
+				if err != nil {
+					return err
+				}
+				if isTransientStatus(response.StatusCode) {
+					return newHTTPError(response.StatusCode, response.Header, respBody)
+				}
+				return errors.New(fmt.Sprintf("Invalid response received. %v received. Body: %v", response.StatusCode, string(respBody)))
+			}
 
+			location = response.Header.Get("Location")
+			return nil
+		})
 		if err != nil {
 			return err
 		}
 
-		req, err = http.NewRequest("PUT", location, bytes.NewReader(reqBody))
+		reqBody, err := xml.MarshalIndent(mutation, "  ", "  ")
+		bodyLength := len(reqBody)
 
 		if err != nil {
 			return err
 		}
 
-		// Set headers for incremental upload
-		req.Header.Set("Content-Type", "application/xml")
-		req.Header.Set("Content-Length", string(bodyLength))
-		req.Header.Set("Content-Range", fmt.Sprintf("bytes 0-%v/%v", bodyLength-1, bodyLength))
+		err = s.Auth.retry(ctx, func() error {
+			req, err := http.NewRequestWithContext(ctx, "PUT", location, bytes.NewReader(reqBody))
 
-		resp, err := client.Do(req)
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
+			if err != nil {
+				return err
+			}
 
-		respBody, err := ioutil.ReadAll(resp.Body)
+			// Set headers for incremental upload
+			req.Header.Set("Content-Type", "application/xml")
+			req.Header.Set("Content-Length", strconv.Itoa(bodyLength))
+			req.Header.Set("Content-Range", fmt.Sprintf("bytes 0-%v/%v", bodyLength-1, bodyLength))
 
-		if err != nil {
-			return err
-		}
+			resp, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
 
-		// Added some logging/"poor man's" debugging to inspect outbound SOAP requests
-		if level := os.Getenv("DEBUG"); level != "" {
-			fmt.Printf("response ->\n%s\n", string(respBody))
-		}
+			respBody, err := ioutil.ReadAll(resp.Body)
 
-		// resp seems to only return 200's and there is no error handling, but if we happen to get invalid status lets try to do something with it
-		if resp.StatusCode != http.StatusOK {
-			return errors.New("Non-200 response returned Body: " + string(respBody))
-		}
+			if err != nil {
+				return err
+			}
+
+			s.Auth.logTransfer("batch job upload", reqBody, resp.StatusCode, respBody)
+
+			// resp seems to only return 200's and there is no error handling, but if we happen to get invalid status lets try to do something with it
+			if resp.StatusCode != http.StatusOK {
+				if isTransientStatus(resp.StatusCode) {
+					return newHTTPError(resp.StatusCode, resp.Header, respBody)
+				}
+				return errors.New("Non-200 response returned Body: " + string(respBody))
+			}
+			return nil
+		})
 	}
 
 	return err
@@ -167,18 +185,38 @@ func (s *BatchJobHelper) UploadBatchJobOperations(jobOperations []interface{}, u
 //
 //	https://developers.google.com/adwords/api/docs/guides/batch-jobs?hl=en#download_the_batch_job_results_and_check_for_errors
 func (s *BatchJobHelper) DownloadBatchJob(url TemporaryUrl) (mutateResults []MutateResults, err error) {
-	resp, err := http.Get(url.Url)
+	return s.DownloadBatchJobContext(context.Background(), url)
+}
 
+// DownloadBatchJobContext is like DownloadBatchJob but takes a context that
+// controls the download request.
+func (s *BatchJobHelper) DownloadBatchJobContext(ctx context.Context, url TemporaryUrl) (mutateResults []MutateResults, err error) {
+	var respBody []byte
+	err = s.Auth.retry(ctx, func() error {
+		req, err := http.NewRequestWithContext(ctx, "GET", url.Url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		respBody, err = ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return newHTTPError(resp.StatusCode, resp.Header, respBody)
+		}
+		return nil
+	})
 	if err != nil {
 		return mutateResults, err
 	}
 
-	respBody, err := ioutil.ReadAll(resp.Body)
-
-	// Added some logging/"poor man's" debugging to inspect outbound SOAP requests
-	if level := os.Getenv("DEBUG"); level != "" {
-		fmt.Printf("response ->\n%s\n", string(respBody))
-	}
+	s.Auth.logTransfer("batch job download", nil, http.StatusOK, respBody)
 
 	soapResp := struct {
 		MutateResults []MutateResults `xml:"rval"`
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 	//  "fmt"
 )
@@ -30,7 +31,8 @@ type Budget struct {
 }
 
 // A BudgetOperations maps operations to the budgets they will be performed
-// on.  Budgets operations can be 'ADD', 'REMOVE' or 'SET'
+// on.  Budgets operations can be 'ADD', 'REMOVE' or 'SET'.  Mutate sends
+// them in the order given in the package doc.
 type BudgetOperations map[string][]Budget
 
 func budgetError() (err error) {
@@ -39,8 +41,15 @@ func budgetError() (err error) {
 
 // Get returns budgets matching a given selector and the total count of matching budgets.
 func (s *BudgetService) Get(selector Selector) (budgets []Budget, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *BudgetService) GetContext(ctx context.Context, selector Selector) (budgets []Budget, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "selector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		budgetServiceUrl,
 		"get",
 		struct {
@@ -70,27 +79,49 @@ func (s *BudgetService) Get(selector Selector) (budgets []Budget, totalCount int
 
 // Mutate takes a budgetOperations and creates, modifies or destroys the associated budgets.
 func (s *BudgetService) Mutate(budgetOperations BudgetOperations) (budgets []Budget, err error) {
-	type budgetOperation struct {
-		Action string `xml:"operator"`
-		Budget Budget `xml:"operand"`
-	}
-	operations := []budgetOperation{}
-	for action, budgets := range budgetOperations {
-		for _, budget := range budgets {
+	return s.MutateContext(context.Background(), budgetOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *BudgetService) MutateContext(ctx context.Context, budgetOperations BudgetOperations) (budgets []Budget, err error) {
+	operations := []BudgetOperation{}
+	for _, action := range sortedActions(budgetOperations) {
+		for _, budget := range budgetOperations[action] {
 			operations = append(operations,
-				budgetOperation{
+				BudgetOperation{
 					Action: action,
 					Budget: budget,
 				},
 			)
 		}
 	}
-	respBody, err := s.Auth.request(
+	return s.MutateOperationsContext(ctx, operations)
+}
+
+// BudgetOperation is an operation of MutateOperations, for instance the
+// "ADD" of one budget.
+type BudgetOperation struct {
+	Action string `xml:"operator"`
+	Budget Budget `xml:"operand"`
+}
+
+// MutateOperations is like Mutate but sends the operations in the order
+// given.
+func (s *BudgetService) MutateOperations(operations []BudgetOperation) (budgets []Budget, err error) {
+	return s.MutateOperationsContext(context.Background(), operations)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *BudgetService) MutateOperationsContext(ctx context.Context, operations []BudgetOperation) (budgets []Budget, err error) {
+	respBody, meta, err := s.Auth.requestMeta(
+		ctx,
 		budgetServiceUrl,
 		"mutate",
 		struct {
 			XMLName xml.Name
-			Ops     []budgetOperation `xml:"operations"`
+			Ops     []BudgetOperation `xml:"operations"`
 		}{
 			XMLName: xml.Name{
 				Space: baseUrl,
@@ -103,11 +134,30 @@ func (s *BudgetService) Mutate(budgetOperations BudgetOperations) (budgets []Bud
 		return budgets, err
 	}
 	mutateResp := struct {
-		Budgets []Budget `xml:"rval>value"`
+		Budgets              []Budget  `xml:"rval>value"`
+		PartialFailureErrors apiErrors `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return budgets, err
 	}
-	return mutateResp.Budgets, err
+	return mutateResp.Budgets, partialFailure(meta.RequestId, operations, mutateResp.Budgets, mutateResp.PartialFailureErrors)
+}
+
+// BudgetPages iterates over pages of budgets, see Pager.
+type BudgetPages struct {
+	*Pager
+}
+
+// Budgets returns the current page.
+func (p BudgetPages) Budgets() []Budget {
+	return p.Page().([]Budget)
+}
+
+// Pages returns an iterator over the budgets matching selector.
+func (s *BudgetService) Pages(ctx context.Context, selector Selector, opts *PageOptions) BudgetPages {
+	return BudgetPages{newPager(ctx, selector, opts, "BudgetId", "Id", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
 }
@@ -1,73 +1,380 @@
 package v201809
 
 import (
+	"context"
 	sha256 "crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
-// cache
-// todo: make this concurrent
+// A CacheKey identifies the response to a call in a Cache.
+type CacheKey struct {
+	CustomerId string
+	Service    string
+	// Hash is derived from the method, the request envelope and the token
+	// of the CacheConfig.
+	Hash string
+}
+
+// A Cache stores the responses of get and query calls.  Implementations
+// must be safe for concurrent use.
+type Cache interface {
+	// Get returns the response stored under key and when it expires, the
+	// zero time meaning never.  Expired entries are not returned.
+	Get(key CacheKey) (value []byte, expires time.Time, ok bool)
+	Set(key CacheKey, value []byte, expires time.Time)
+	// Invalidate removes the entries of service for customerId.
+	Invalidate(customerId, service string)
+}
+
+// CacheConfig enables caching for an Auth.
+type CacheConfig struct {
+	Cache Cache
+	// TTL is the lifetime of the entries of services missing from
+	// ServiceTTL.  Entries never expire when it is zero.
+	TTL time.Duration
+	// ServiceTTL overrides TTL by service name, e.g. "CampaignService".  A
+	// negative TTL disables caching for the service.
+	ServiceTTL map[string]time.Duration
+	// Token keeps apart the entries of credentials sharing a Cache.
+	Token string
+}
+
+func (c *CacheConfig) ttl(service string) time.Duration {
+	if ttl, ok := c.ServiceTTL[service]; ok {
+		return ttl
+	}
+	return c.TTL
+}
+
+func (c *CacheConfig) key(customerId string, call *Call) CacheKey {
+	hash := sha256.New()
+	hash.Write([]byte(c.Token + "-" + call.Action + "-"))
+	hash.Write(call.Request)
+	return CacheKey{
+		CustomerId: customerId,
+		Service:    call.Service.Name,
+		Hash:       hex.EncodeToString(hash.Sum(nil)),
+	}
+}
+
+// isRead reports whether action only reads the account, as the get and
+// query methods do.  Any other action, e.g. mutate or upload, may change it.
+func isRead(action string) bool {
+	return strings.HasPrefix(action, "get") || strings.HasPrefix(action, "query")
+}
+
+// cacheInterceptor answers the reading calls from the cache of cfg and
+// stores their successful responses.  Other successful calls invalidate the
+// entries of their service.
+func (a *Auth) cacheInterceptor(cfg *CacheConfig) Interceptor {
+	return func(ctx context.Context, call *Call, next Invoker) (*Response, error) {
+		return a.cached(ctx, cfg, call, next)
+	}
+}
+
+func (a *Auth) cached(ctx context.Context, cfg *CacheConfig, call *Call, next Invoker) (*Response, error) {
+	if !isRead(call.Action) {
+		resp, err := next(ctx, call)
+		if err == nil && resp != nil && resp.StatusCode == 200 {
+			cfg.Cache.Invalidate(a.CustomerId, call.Service.Name)
+		}
+		return resp, err
+	}
+
+	ttl := cfg.ttl(call.Service.Name)
+	if ttl < 0 {
+		return next(ctx, call)
+	}
+	key := cfg.key(a.CustomerId, call)
+	if value, mem, ok := getCached(cfg.Cache, key); ok {
+		return &Response{StatusCode: 200, Body: value, Cached: true, memCached: mem}, nil
+	}
+	resp, err := next(ctx, call)
+	if err == nil && resp != nil && resp.StatusCode == 200 {
+		var expires time.Time
+		if ttl > 0 {
+			expires = time.Now().Add(ttl)
+		}
+		cfg.Cache.Set(key, resp.Body, expires)
+	}
+	return resp, err
+}
+
+// getCached looks key up in c and reports whether it was found in memory.
+func getCached(c Cache, key CacheKey) (value []byte, mem bool, ok bool) {
+	if l, isLayered := c.(*LayeredCache); isLayered {
+		value, _, layer, ok := l.get(key)
+		if ok {
+			_, mem = l.layers[layer].(*MemoryCache)
+		}
+		return value, mem, ok
+	}
+	value, _, ok = c.Get(key)
+	_, mem = c.(*MemoryCache)
+	return value, mem, ok
+}
+
+// NewMemoryCache returns an empty in-memory Cache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{items: map[string]map[string]cacheEntry{}}
+}
+
+// MemoryCache is a Cache held in memory.
+type MemoryCache struct {
+	mu    sync.Mutex
+	items map[string]map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+func (e cacheEntry) expired() bool {
+	return !e.expires.IsZero() && time.Now().After(e.expires)
+}
+
+func (c *MemoryCache) Get(key CacheKey) ([]byte, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	service := c.items[key.CustomerId+"/"+key.Service]
+	e, ok := service[key.Hash]
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	if e.expired() {
+		delete(service, key.Hash)
+		return nil, time.Time{}, false
+	}
+	return e.value, e.expires, true
+}
+
+func (c *MemoryCache) Set(key CacheKey, value []byte, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	service, ok := c.items[key.CustomerId+"/"+key.Service]
+	if !ok {
+		service = map[string]cacheEntry{}
+		c.items[key.CustomerId+"/"+key.Service] = service
+	}
+	service[key.Hash] = cacheEntry{value: value, expires: expires}
+}
+
+func (c *MemoryCache) Invalidate(customerId, service string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, customerId+"/"+service)
+}
+
+// Clear removes all entries.
+func (c *MemoryCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items = map[string]map[string]cacheEntry{}
+}
+
+// NewDiskCache returns a Cache storing entries as files under dir, one
+// directory per customer and service.
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{dir: dir}
+}
+
+// DiskCache is a Cache stored in a directory.  Each entry is a file
+// holding its expiration on the first line, followed by the response.
+type DiskCache struct {
+	dir string
+}
+
+func (c *DiskCache) serviceDir(customerId, service string) string {
+	if customerId == "" {
+		customerId = "_"
+	}
+	return filepath.Join(c.dir, customerId, service)
+}
+
+func (c *DiskCache) Get(key CacheKey) ([]byte, time.Time, bool) {
+	name := filepath.Join(c.serviceDir(key.CustomerId, key.Service), key.Hash)
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	i := strings.IndexByte(string(data), '\n')
+	if i < 0 {
+		return nil, time.Time{}, false
+	}
+	nanos, err := strconv.ParseInt(string(data[:i]), 10, 64)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+	e := cacheEntry{value: data[i+1:]}
+	if nanos != 0 {
+		e.expires = time.Unix(0, nanos)
+	}
+	if e.expired() {
+		os.Remove(name)
+		return nil, time.Time{}, false
+	}
+	return e.value, e.expires, true
+}
+
+// Set writes the entry to a temporary file first, so that concurrent Gets
+// never see it half written.  Write errors leave the entry uncached.
+func (c *DiskCache) Set(key CacheKey, value []byte, expires time.Time) {
+	dir := c.serviceDir(key.CustomerId, key.Service)
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return
+	}
+	f, err := ioutil.TempFile(dir, ".tmp-")
+	if err != nil {
+		return
+	}
+	var nanos int64
+	if !expires.IsZero() {
+		nanos = expires.UnixNano()
+	}
+	_, err = f.WriteString(strconv.FormatInt(nanos, 10) + "\n")
+	if err == nil {
+		_, err = f.Write(value)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), filepath.Join(dir, key.Hash))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
 
-type callCache struct {
-	Items map[string][]byte
+func (c *DiskCache) Invalidate(customerId, service string) {
+	os.RemoveAll(c.serviceDir(customerId, service))
 }
 
+// NewLayeredCache returns a Cache looking entries up in layers in turn,
+// typically a MemoryCache in front of a DiskCache.  Entries are written to
+// all layers, and entries found in a layer are copied to the ones before
+// it.
+func NewLayeredCache(layers ...Cache) *LayeredCache {
+	return &LayeredCache{layers: layers}
+}
+
+// LayeredCache is a Cache made of other caches.
+type LayeredCache struct {
+	layers []Cache
+}
+
+func (c *LayeredCache) get(key CacheKey) ([]byte, time.Time, int, bool) {
+	for i, layer := range c.layers {
+		if value, expires, ok := layer.Get(key); ok {
+			for _, before := range c.layers[:i] {
+				before.Set(key, value, expires)
+			}
+			return value, expires, i, true
+		}
+	}
+	return nil, time.Time{}, -1, false
+}
+
+func (c *LayeredCache) Get(key CacheKey) ([]byte, time.Time, bool) {
+	value, expires, _, ok := c.get(key)
+	return value, expires, ok
+}
+
+func (c *LayeredCache) Set(key CacheKey, value []byte, expires time.Time) {
+	for _, layer := range c.layers {
+		layer.Set(key, value, expires)
+	}
+}
+
+func (c *LayeredCache) Invalidate(customerId, service string) {
+	for _, layer := range c.layers {
+		layer.Invalidate(customerId, service)
+	}
+}
+
+// The functions below configure the cache used by an Auth without a
+// CacheConfig, kept for compatibility.
 var (
-	cache_DIR     = ""
-	cache_ENABLED = false
-	cache_MEM     = false
-	cache         *callCache
+	defaultCacheMu         sync.Mutex
+	defaultCache           *CacheConfig
+	defaultCacheMemory     *MemoryCache
+	defaultCachePaused     bool
+	errCacheNotInitialized = errors.New("cache not initialized")
 )
 
-func (c *callCache) Set(k []string, v []byte) {
-	hashBuffer := sha256.Sum256([]byte(strings.Join(k, "-")))
-	key := hex.EncodeToString(hashBuffer[:])
-	c.Items[key] = v
-}
-
-func (c *callCache) Get(k []string) ([]byte, bool) {
-	hashBuffer := sha256.Sum256([]byte(strings.Join(k, "-")))
-	key := hex.EncodeToString(hashBuffer[:])
-	cache_MEM = false
-	if v, ok := c.Items[key]; ok {
-		cache_MEM = true
-		return v, ok
-	} else {
-		d, err := ioutil.ReadFile(cache_DIR + key)
-		if err != nil {
-			return []byte{}, false
-		}
-		return d, true
+// cacheConfig returns the CacheConfig of a, or the default one, or nil when
+// caching is disabled.
+func (a *Auth) cacheConfig() *CacheConfig {
+	if a.Cache != nil {
+		return a.Cache
+	}
+	defaultCacheMu.Lock()
+	defer defaultCacheMu.Unlock()
+	if defaultCachePaused {
+		return nil
 	}
+	return defaultCache
 }
 
+// InitCache enables caching to memory and to files under dir for every Auth
+// without a CacheConfig.
 func InitCache(dir string) {
-	cache_ENABLED = true
-	cache_DIR = dir
-	cache = &callCache{
-		Items: map[string][]byte{},
+	defaultCacheMu.Lock()
+	defer defaultCacheMu.Unlock()
+	token := ""
+	if defaultCache != nil {
+		token = defaultCache.Token
 	}
+	defaultCacheMemory = NewMemoryCache()
+	defaultCache = &CacheConfig{
+		Cache: NewLayeredCache(defaultCacheMemory, NewDiskCache(dir)),
+		Token: token,
+	}
+	defaultCachePaused = false
+}
+
+// SetCacheToken sets the token of the default cache, which keeps apart the
+// entries of different credentials.
+func SetCacheToken(t string) {
+	defaultCacheMu.Lock()
+	defer defaultCacheMu.Unlock()
+	if defaultCache == nil {
+		defaultCache = &CacheConfig{}
+		defaultCachePaused = true
+	}
+	defaultCache.Token = t
 }
 
 func ResumeCache() {
-	cache_ENABLED = true
+	defaultCacheMu.Lock()
+	defer defaultCacheMu.Unlock()
+	defaultCachePaused = defaultCache == nil || defaultCache.Cache == nil
 }
 
 func PauseCache() {
-	cache_ENABLED = false
+	defaultCacheMu.Lock()
+	defer defaultCacheMu.Unlock()
+	defaultCachePaused = true
 }
 
+// SaveCache empties the memory layer of the default cache.  Entries are
+// written to disk as they are cached.
 // call this method when you dont need memory cache
 func SaveCache() error {
-	for k, v := range cache.Items {
-		err := ioutil.WriteFile(cache_DIR+k, []byte(v), 0777)
-		if err != nil {
-			return err
-		}
+	defaultCacheMu.Lock()
+	defer defaultCacheMu.Unlock()
+	if defaultCacheMemory == nil {
+		return errCacheNotInitialized
 	}
-	// clear for memory save
-	cache.Items = map[string][]byte{}
+	defaultCacheMemory.Clear()
 	return nil
 }
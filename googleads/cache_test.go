@@ -0,0 +1,116 @@
+package v201809
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func TestCacheImplementations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gads-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	key := CacheKey{CustomerId: "123", Service: "CampaignService", Hash: "abc"}
+	for name, c := range map[string]Cache{
+		"memory": NewMemoryCache(),
+		"disk":   NewDiskCache(dir),
+	} {
+		c.Set(key, []byte("value"), time.Time{})
+		if v, expires, ok := c.Get(key); !ok || string(v) != "value" || !expires.IsZero() {
+			t.Errorf("%s: unexpected entry %q %v %v", name, v, expires, ok)
+		}
+		c.Set(key, []byte("old"), time.Now().Add(-time.Second))
+		if _, _, ok := c.Get(key); ok {
+			t.Errorf("%s: expired entry returned", name)
+		}
+		c.Set(key, []byte("value"), time.Now().Add(time.Hour))
+		c.Invalidate("123", "CampaignService")
+		if _, _, ok := c.Get(key); ok {
+			t.Errorf("%s: invalidated entry returned", name)
+		}
+	}
+
+	mem, disk := NewMemoryCache(), NewDiskCache(dir)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, c := range []Cache{mem, disk} {
+				c.Set(key, []byte("value"), time.Time{})
+				c.Get(key)
+				c.Invalidate(key.CustomerId, key.Service)
+			}
+		}()
+	}
+	wg.Wait()
+
+	disk.Set(key, []byte("from disk"), time.Time{})
+	layered := NewLayeredCache(mem, disk)
+	if v, _, ok := layered.Get(key); !ok || string(v) != "from disk" {
+		t.Fatalf("unexpected entry %q", v)
+	}
+	if v, _, ok := mem.Get(key); !ok || string(v) != "from disk" {
+		t.Errorf("entry not copied to memory: %q", v)
+	}
+}
+
+func TestCacheInterceptor(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	gadstest.NewSimulator(srv)
+	auth := simulatorAuth(srv)
+	auth.Cache = &CacheConfig{
+		Cache:      NewMemoryCache(),
+		ServiceTTL: map[string]time.Duration{"LabelService": -1},
+	}
+	budgets := NewBudgetService(auth)
+	get := func() []Budget {
+		page, _, err := budgets.Get(Selector{Fields: []string{"BudgetId", "BudgetName"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return page
+	}
+
+	get()
+	before := len(srv.RequestsFor("BudgetService", "get"))
+	if page := get(); len(page) != 0 || len(srv.RequestsFor("BudgetService", "get")) != before {
+		t.Fatalf("get not served from the cache")
+	}
+	if stat := GetStat().ServiceStat["BudgetService"]; stat.Cached == 0 || stat.MemCached == 0 {
+		t.Errorf("cache hits not counted %#v", stat)
+	}
+
+	if _, err := budgets.Mutate(BudgetOperations{"ADD": {{Name: "b", Amount: 1}}}); err != nil {
+		t.Fatal(err)
+	}
+	if page := get(); len(page) != 1 {
+		t.Errorf("mutate did not invalidate the cache, got %#v", page)
+	}
+
+	srv.Respond("MediaService", "get", gadstest.Rval("get", ""))
+	srv.Respond("MediaService", "upload", gadstest.Rval("upload", ""))
+	media := NewMediaService(auth)
+	media.Get(Selector{Fields: []string{"MediaId"}})
+	media.Upload([]Media{{Type: "Image", MediaType: "IMAGE", Name: "m"}})
+	media.Upload([]Media{{Type: "Image", MediaType: "IMAGE", Name: "m"}})
+	media.Get(Selector{Fields: []string{"MediaId"}})
+	if gets, uploads := len(srv.RequestsFor("MediaService", "get")), len(srv.RequestsFor("MediaService", "upload")); gets != 2 || uploads != 2 {
+		t.Errorf("want uploads sent and invalidating the cache, got %d gets and %d uploads", gets, uploads)
+	}
+
+	labels := NewLabelService(auth)
+	labels.Get(Selector{Fields: []string{"LabelId"}})
+	labels.Get(Selector{Fields: []string{"LabelId"}})
+	if n := len(srv.RequestsFor("LabelService", "get")); n != 2 {
+		t.Errorf("want LabelService calls not cached, got %d requests", n)
+	}
+}
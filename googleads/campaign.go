@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -156,6 +157,8 @@ type Campaign struct {
 	Errors                         []error                         `xml:"-"`
 }
 
+// CampaignOperations maps operators to the campaigns they apply to, sent
+// by Mutate in the order given in the package doc.
 type CampaignOperations map[string][]Campaign
 
 type CampaignLabel struct {
@@ -163,6 +166,8 @@ type CampaignLabel struct {
 	LabelId    int64 `xml:"labelId"`
 }
 
+// CampaignLabelOperations maps operators to the campaign labels they apply
+// to, sent by MutateLabel in the order given in the package doc.
 type CampaignLabelOperations map[string][]CampaignLabel
 
 // Get returns an array of Campaign's and the total number of campaign's matching
@@ -194,17 +199,27 @@ type CampaignLabelOperations map[string][]CampaignLabel
 //   "Id", "Name", "Status", "ServingStatus", "StartDate", "EndDate", "AdvertisingChannelType",
 //   "AdvertisingChannelSubType", "Labels", "TrackingUrlTemplate"
 //
+// CampaignFields holds the fields of v201809, which NewSelectorBuilder checks
+// selectors against.
+//
 // Relevant documentation
 //
 //     https://developers.google.com/adwords/api/docs/reference/v201409/CampaignService#get
 //
 func (s *CampaignService) Get(selector Selector) (campaigns []Campaign, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *CampaignService) GetContext(ctx context.Context, selector Selector) (campaigns []Campaign, totalCount int64, err error) {
 	// The default namespace, "", will break in 1.5 with the addition of
 	// custom namespace support.  Hence, we have to ensure that the baseUrl is
 	// set again as the proper namespace for the service/serviceSelector element
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 
 	respBody, err := s.Auth.request(
+		ctx,
 		campaignServiceUrl,
 		"get",
 		struct {
@@ -273,6 +288,12 @@ type CampaignOperation struct {
 }
 
 func (s *CampaignService) MutateOperations(ops []CampaignOperation) (campaigns []Campaign, err error) {
+	return s.MutateOperationsContext(context.Background(), ops)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *CampaignService) MutateOperationsContext(ctx context.Context, ops []CampaignOperation) (campaigns []Campaign, err error) {
 	mutation := struct {
 		XMLName xml.Name
 		Ops     []CampaignOperation `xml:"operations"`
@@ -282,25 +303,40 @@ func (s *CampaignService) MutateOperations(ops []CampaignOperation) (campaigns [
 			Local: "mutate",
 		},
 		Ops: ops}
-	respBody, err := s.Auth.request(campaignServiceUrl, "mutate", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, campaignServiceUrl, "mutate", mutation)
 	if err != nil {
 		return campaigns, err
 	}
 	mutateResp := struct {
-		Campaigns []Campaign `xml:"rval>value"`
+		Campaigns            []Campaign `xml:"rval>value"`
+		PartialFailureErrors apiErrors  `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return campaigns, err
 	}
 
+	err = partialFailure(meta.RequestId, ops, mutateResp.Campaigns, mutateResp.PartialFailureErrors)
+	if pf, ok := err.(*PartialFailureError); ok {
+		for _, r := range pf.Failed() {
+			if r.Index < len(mutateResp.Campaigns) {
+				mutateResp.Campaigns[r.Index].Errors = r.Errors
+			}
+		}
+	}
 	return mutateResp.Campaigns, err
 }
 
 func (s *CampaignService) Mutate(campaignOperations CampaignOperations) (campaigns []Campaign, err error) {
+	return s.MutateContext(context.Background(), campaignOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *CampaignService) MutateContext(ctx context.Context, campaignOperations CampaignOperations) (campaigns []Campaign, err error) {
 	operations := []CampaignOperation{}
-	for action, campaigns := range campaignOperations {
-		for _, campaign := range campaigns {
+	for _, action := range sortedActions(campaignOperations) {
+		for _, campaign := range campaignOperations[action] {
 			operations = append(operations,
 				CampaignOperation{
 					Action:   action,
@@ -309,7 +345,7 @@ func (s *CampaignService) Mutate(campaignOperations CampaignOperations) (campaig
 			)
 		}
 	}
-	return s.MutateOperations(operations)
+	return s.MutateOperationsContext(ctx, operations)
 }
 
 // Mutate allows you to add and removes labels from campaigns.
@@ -332,43 +368,65 @@ func (s *CampaignService) Mutate(campaignOperations CampaignOperations) (campaig
 //     https://developers.google.com/adwords/api/docs/reference/v201409/CampaignService#mutateLabel
 //
 func (s *CampaignService) MutateLabel(campaignLabelOperations CampaignLabelOperations) (campaignLabels []CampaignLabel, err error) {
-	type campaignLabelOperation struct {
-		Action        string        `xml:"operator"`
-		CampaignLabel CampaignLabel `xml:"operand"`
-	}
-	operations := []campaignLabelOperation{}
-	for action, campaignLabels := range campaignLabelOperations {
-		for _, campaignLabel := range campaignLabels {
+	return s.MutateLabelContext(context.Background(), campaignLabelOperations)
+}
+
+// MutateLabelContext is like MutateLabel but takes a context that controls the
+// request lifetime.
+func (s *CampaignService) MutateLabelContext(ctx context.Context, campaignLabelOperations CampaignLabelOperations) (campaignLabels []CampaignLabel, err error) {
+	operations := []CampaignLabelOperation{}
+	for _, action := range sortedActions(campaignLabelOperations) {
+		for _, campaignLabel := range campaignLabelOperations[action] {
 			operations = append(operations,
-				campaignLabelOperation{
+				CampaignLabelOperation{
 					Action:        action,
 					CampaignLabel: campaignLabel,
 				},
 			)
 		}
 	}
+	return s.MutateLabelOperationsContext(ctx, operations)
+}
+
+// CampaignLabelOperation is an operation of MutateLabelOperations, for
+// instance the "ADD" of one campaign label.
+type CampaignLabelOperation struct {
+	Action        string        `xml:"operator"`
+	CampaignLabel CampaignLabel `xml:"operand"`
+}
+
+// MutateLabelOperations is like MutateLabel but sends the operations in
+// the order given.
+func (s *CampaignService) MutateLabelOperations(operations []CampaignLabelOperation) (campaignLabels []CampaignLabel, err error) {
+	return s.MutateLabelOperationsContext(context.Background(), operations)
+}
+
+// MutateLabelOperationsContext is like MutateLabelOperations but takes a context that controls the
+// request lifetime.
+func (s *CampaignService) MutateLabelOperationsContext(ctx context.Context, operations []CampaignLabelOperation) (campaignLabels []CampaignLabel, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []campaignLabelOperation `xml:"operations"`
+		Ops     []CampaignLabelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseUrl,
 			Local: "mutateLabel",
 		},
 		Ops: operations}
-	respBody, err := s.Auth.request(campaignServiceUrl, "mutateLabel", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, campaignServiceUrl, "mutateLabel", mutation)
 	if err != nil {
 		return campaignLabels, err
 	}
 	mutateResp := struct {
-		CampaignLabels []CampaignLabel `xml:"rval>value"`
+		CampaignLabels       []CampaignLabel `xml:"rval>value"`
+		PartialFailureErrors apiErrors       `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return campaignLabels, err
 	}
 
-	return mutateResp.CampaignLabels, err
+	return mutateResp.CampaignLabels, partialFailure(meta.RequestId, operations, mutateResp.CampaignLabels, mutateResp.PartialFailureErrors)
 }
 
 // Query is not yet implemented
@@ -378,8 +436,15 @@ func (s *CampaignService) MutateLabel(campaignLabelOperations CampaignLabelOpera
 //     https://developers.google.com/adwords/api/docs/reference/v201409/CampaignService#query
 //
 func (s *CampaignService) Query(query string) (campaigns []Campaign, totalCount int64, err error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *CampaignService) QueryContext(ctx context.Context, query string) (campaigns []Campaign, totalCount int64, err error) {
 
 	respBody, err := s.Auth.request(
+		ctx,
 		campaignServiceUrl,
 		"query",
 		AWQLQuery{
@@ -406,3 +471,21 @@ func (s *CampaignService) Query(query string) (campaigns []Campaign, totalCount
 	}
 	return getResp.Campaigns, getResp.Size, err
 }
+
+// CampaignPages iterates over pages of campaigns, see Pager.
+type CampaignPages struct {
+	*Pager
+}
+
+// Campaigns returns the current page.
+func (p CampaignPages) Campaigns() []Campaign {
+	return p.Page().([]Campaign)
+}
+
+// Pages returns an iterator over the campaigns matching selector.
+func (s *CampaignService) Pages(ctx context.Context, selector Selector, opts *PageOptions) CampaignPages {
+	return CampaignPages{newPager(ctx, selector, opts, "Id", "Id", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
+}
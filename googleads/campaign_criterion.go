@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -56,6 +57,9 @@ func (cc CampaignCriterion) MarshalXML(e *xml.Encoder, start xml.StartElement) e
 }
 
 type CampaignCriterions []interface{}
+
+// CampaignCriterionOperations maps operators to the campaign criteria they
+// apply to, sent by Mutate in the order given in the package doc.
 type CampaignCriterionOperations map[string]CampaignCriterions
 
 func (ncc NegativeCampaignCriterion) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
@@ -151,6 +155,12 @@ func NewNegativeCampaignCriterion(campaignId int64, bidModifier float64, criteri
 */
 
 func (s *CampaignCriterionService) Get(selector Selector) (campaignCriterions CampaignCriterions, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *CampaignCriterionService) GetContext(ctx context.Context, selector Selector) (campaignCriterions CampaignCriterions, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 	getResp := struct {
 		XMLName            xml.Name
@@ -159,6 +169,7 @@ func (s *CampaignCriterionService) Get(selector Selector) (campaignCriterions Ca
 	}{}
 
 	err = s.Auth.do(
+		ctx,
 		campaignCriterionServiceUrl,
 		"get",
 		struct {
@@ -185,6 +196,12 @@ type CampaignCriterionOperation struct {
 }
 
 func (s *CampaignCriterionService) MutateOperations(operations []CampaignCriterionOperation) (CampaignCriterions, error) {
+	return s.MutateOperationsContext(context.Background(), operations)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *CampaignCriterionService) MutateOperationsContext(ctx context.Context, operations []CampaignCriterionOperation) (CampaignCriterions, error) {
 	mutation := struct {
 		XMLName xml.Name
 		Ops     []CampaignCriterionOperation `xml:"operations"`
@@ -197,49 +214,47 @@ func (s *CampaignCriterionService) MutateOperations(operations []CampaignCriteri
 	}
 
 	mutateResp := struct {
-		XMLName            xml.Name
-		CampaignCriterions CampaignCriterions `xml:"rval>value"`
+		XMLName              xml.Name
+		CampaignCriterions   CampaignCriterions `xml:"rval>value"`
+		PartialFailureErrors apiErrors          `xml:"rval>partialFailureErrors"`
 	}{}
-	err := s.Auth.do(campaignCriterionServiceUrl, "mutate", mutation, &mutateResp)
+	respBody, meta, err := s.Auth.requestMeta(ctx, campaignCriterionServiceUrl, "mutate", mutation)
 	if err != nil {
-		/*
-			    switch t := err.(type) {
-			    case *ErrorsType:
-				    for action, campaignCriterions := range campaignCriterionOperations {
-					    for _, campaignCriterion := range campaignCriterions {
-			          campaignCriterions = append(campaignCriterions,campaignCriterion)
-			        }
-			      }
-			      for _, aef := range t.ApiExceptionFaults {
-			        for _,e := range aef.Errors {
-			          switch et := e.(type) {
-			          case CriterionError:
-			            offset, err := strconv.ParseInt(strings.Trim(et.FieldPath,"abcdefghijklmnop.]["),10,64)
-			            if err != nil {
-			              return CampaignCriterions{}, err
-			            }
-			            cc := campaignCriterions[offset]
-			            switch c := cc.(type) {
-			            case CampaignCriterion:
-			              CampaignCriterion(campaignCriterions[offset]).Errors = append(campaignCriterions[offset].(CampaignCriterion).Errors,fmt.Errorf(et.Reason))
-			            case NegativeCampaignCriterion:
-			              NegativeCampaignCriterion(campaignCriterions[offset]).Errors = append(NegativeCampaignCriterion(campaignCriterions[offset].Errors),fmt.Errorf(et.Reason))
-			            }
-			          }
-			        }
-			      }
-			    default:
-		*/
 		return nil, err
-		//}
+	}
+	if err = xml.Unmarshal(respBody, &mutateResp); err != nil {
+		return nil, err
+	}
+
+	err = partialFailure(meta.RequestId, operations, mutateResp.CampaignCriterions, mutateResp.PartialFailureErrors)
+	if pf, ok := err.(*PartialFailureError); ok {
+		for _, r := range pf.Failed() {
+			if r.Index >= len(mutateResp.CampaignCriterions) {
+				continue
+			}
+			switch cc := mutateResp.CampaignCriterions[r.Index].(type) {
+			case CampaignCriterion:
+				cc.Errors = r.Errors
+				mutateResp.CampaignCriterions[r.Index] = cc
+			case NegativeCampaignCriterion:
+				cc.Errors = r.Errors
+				mutateResp.CampaignCriterions[r.Index] = cc
+			}
+		}
 	}
 	return mutateResp.CampaignCriterions, err
 }
 
 func (s *CampaignCriterionService) Mutate(campaignCriterionOperations CampaignCriterionOperations) (campaignCriterions CampaignCriterions, err error) {
+	return s.MutateContext(context.Background(), campaignCriterionOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *CampaignCriterionService) MutateContext(ctx context.Context, campaignCriterionOperations CampaignCriterionOperations) (campaignCriterions CampaignCriterions, err error) {
 	operations := []CampaignCriterionOperation{}
-	for action, campaignCriterions := range campaignCriterionOperations {
-		for _, campaignCriterion := range campaignCriterions {
+	for _, action := range sortedActions(campaignCriterionOperations) {
+		for _, campaignCriterion := range campaignCriterionOperations[action] {
 			operations = append(operations,
 				CampaignCriterionOperation{
 					Action:            action,
@@ -249,11 +264,18 @@ func (s *CampaignCriterionService) Mutate(campaignCriterionOperations CampaignCr
 		}
 	}
 
-	return s.MutateOperations(operations)
+	return s.MutateOperationsContext(ctx, operations)
 }
 
 func (s *CampaignCriterionService) Query(query string) (campaignCriterions CampaignCriterions, totalCount int64, err error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *CampaignCriterionService) QueryContext(ctx context.Context, query string) (campaignCriterions CampaignCriterions, totalCount int64, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		campaignCriterionServiceUrl,
 		"query",
 		AWQLQuery{
@@ -280,3 +302,22 @@ func (s *CampaignCriterionService) Query(query string) (campaignCriterions Campa
 	}
 	return getResp.CampaignCriterions, getResp.Size, err
 }
+
+// CampaignCriterionPages iterates over pages of campaign criteria, see Pager.
+type CampaignCriterionPages struct {
+	*Pager
+}
+
+// CampaignCriterions returns the current page.
+func (p CampaignCriterionPages) CampaignCriterions() CampaignCriterions {
+	return p.Page().(CampaignCriterions)
+}
+
+// Pages returns an iterator over the campaign criteria matching selector.  Criterion ids are not
+// unique, so paging stops with ErrOffsetLimit at MaxOffset.
+func (s *CampaignCriterionService) Pages(ctx context.Context, selector Selector, opts *PageOptions) CampaignCriterionPages {
+	return CampaignCriterionPages{newPager(ctx, selector, opts, "", "", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
+}
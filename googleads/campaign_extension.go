@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -20,11 +21,22 @@ type CampaignExtensionSetting struct {
 	ExtensionSetting ExtensionSetting `xml:"https://adwords.google.com/api/adwords/cm/v201809 extensionSetting,omitempty"`
 }
 
+// CampaignExtensionSettingOperations maps operators to the extension
+// settings they apply to.  Mutate sends the "ADD" operations first, then
+// "SET", then "REMOVE", each in slice order; use MutateOperations for any
+// other order.
 type CampaignExtensionSettingOperations map[string][]CampaignExtensionSetting
 
 // https://developers.google.com/adwords/api/docs/reference/v201809/CampaignExtensionSettingService#query
 func (s *CampaignExtensionSettingService) Query(query string) (settings []CampaignExtensionSetting, totalCount int64, err error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *CampaignExtensionSettingService) QueryContext(ctx context.Context, query string) (settings []CampaignExtensionSetting, totalCount int64, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		campaignExtensionSettingUrl,
 		"query",
 		AWQLQuery{
@@ -53,24 +65,45 @@ func (s *CampaignExtensionSettingService) Query(query string) (settings []Campai
 
 // https://developers.google.com/adwords/api/docs/reference/v201809/CampaignExtensionSettingService#mutate
 func (s *CampaignExtensionSettingService) Mutate(settingsOperations CampaignExtensionSettingOperations) (settings []CampaignExtensionSetting, err error) {
-	type settingOperations struct {
-		Action  string                   `xml:"operator"`
-		Setting CampaignExtensionSetting `xml:"operand"`
-	}
-	operations := []settingOperations{}
-	for action, settings := range settingsOperations {
-		for _, setting := range settings {
+	return s.MutateContext(context.Background(), settingsOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *CampaignExtensionSettingService) MutateContext(ctx context.Context, settingsOperations CampaignExtensionSettingOperations) (settings []CampaignExtensionSetting, err error) {
+	operations := []CampaignExtensionSettingOperation{}
+	for _, action := range sortedActions(settingsOperations) {
+		for _, setting := range settingsOperations[action] {
 			operations = append(operations,
-				settingOperations{
+				CampaignExtensionSettingOperation{
 					Action:  action,
 					Setting: setting,
 				},
 			)
 		}
 	}
+	return s.MutateOperationsContext(ctx, operations)
+}
+
+// CampaignExtensionSettingOperation is an operation of MutateOperations,
+// for instance the "ADD" of one campaign extension setting.
+type CampaignExtensionSettingOperation struct {
+	Action  string                   `xml:"operator"`
+	Setting CampaignExtensionSetting `xml:"operand"`
+}
+
+// MutateOperations is like Mutate but sends the operations in the order
+// given.
+func (s *CampaignExtensionSettingService) MutateOperations(operations []CampaignExtensionSettingOperation) (settings []CampaignExtensionSetting, err error) {
+	return s.MutateOperationsContext(context.Background(), operations)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *CampaignExtensionSettingService) MutateOperationsContext(ctx context.Context, operations []CampaignExtensionSettingOperation) (settings []CampaignExtensionSetting, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []settingOperations `xml:"operations"`
+		Ops     []CampaignExtensionSettingOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseUrl,
@@ -79,17 +112,18 @@ func (s *CampaignExtensionSettingService) Mutate(settingsOperations CampaignExte
 		Ops: operations,
 	}
 
-	respBody, err := s.Auth.request(campaignExtensionSettingUrl, "mutate", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, campaignExtensionSettingUrl, "mutate", mutation)
 	if err != nil {
 		return settings, err
 	}
 	mutateResp := struct {
-		Settings []CampaignExtensionSetting `xml:"rval>value"`
+		Settings             []CampaignExtensionSetting `xml:"rval>value"`
+		PartialFailureErrors apiErrors                  `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal(respBody, &mutateResp)
 	if err != nil {
 		return settings, err
 	}
 
-	return mutateResp.Settings, err
+	return mutateResp.Settings, partialFailure(meta.RequestId, operations, mutateResp.Settings, mutateResp.PartialFailureErrors)
 }
@@ -1,6 +1,9 @@
 package v201809
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 type CampaignSharedSetService struct {
 	Auth
@@ -25,8 +28,15 @@ type CampaignSharedSetOperation struct {
 }
 
 func (s CampaignSharedSetService) Get(selector Selector) (sharedSets []CampaignSharedSet, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s CampaignSharedSetService) GetContext(ctx context.Context, selector Selector) (sharedSets []CampaignSharedSet, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "selector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		campaignSharedSetServiceUrl,
 		"get",
 		struct {
@@ -55,6 +65,12 @@ func (s CampaignSharedSetService) Get(selector Selector) (sharedSets []CampaignS
 }
 
 func (s CampaignSharedSetService) Mutate(operations []CampaignSharedSetOperation) error {
+	return s.MutateContext(context.Background(), operations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s CampaignSharedSetService) MutateContext(ctx context.Context, operations []CampaignSharedSetOperation) error {
 	mutateRequest := struct {
 		XMLName xml.Name
 		Ops     []CampaignSharedSetOperation `xml:"operations"`
@@ -64,6 +80,15 @@ func (s CampaignSharedSetService) Mutate(operations []CampaignSharedSetOperation
 			Local: "mutate",
 		},
 		Ops: operations}
-	_, err := s.Auth.request(campaignSharedSetServiceUrl, "mutate", mutateRequest)
-	return err
+	respBody, meta, err := s.Auth.requestMeta(ctx, campaignSharedSetServiceUrl, "mutate", mutateRequest)
+	if err != nil {
+		return err
+	}
+	mutateResp := struct {
+		PartialFailureErrors apiErrors `xml:"rval>partialFailureErrors"`
+	}{}
+	if err := xml.Unmarshal(respBody, &mutateResp); err != nil {
+		return err
+	}
+	return partialFailure(meta.RequestId, operations, nil, mutateResp.PartialFailureErrors)
 }
@@ -0,0 +1,385 @@
+package v201809
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+const (
+	// DefaultChunkOperations is the number of operations per request when
+	// ChunkOptions.MaxOperations is zero.
+	DefaultChunkOperations = 5000
+	// DefaultChunkBytes is the size of the operations of a request when
+	// ChunkOptions.MaxBytes is zero.
+	DefaultChunkBytes = 4 << 20
+)
+
+// ChunkOptions control how the Chunked mutate methods split their
+// operations into requests.
+type ChunkOptions struct {
+	// MaxOperations is the most operations sent per request.
+	MaxOperations int
+	// MaxBytes is the most bytes of XML encoded operations sent per
+	// request.  An operation larger than MaxBytes is sent on its own.
+	MaxBytes int
+	// Concurrency is the most requests in flight, 1 when zero.  Requests
+	// also wait for Auth.RateLimiter.
+	Concurrency int
+}
+
+func (o *ChunkOptions) withDefaults() ChunkOptions {
+	var opts ChunkOptions
+	if o != nil {
+		opts = *o
+	}
+	if opts.MaxOperations <= 0 {
+		opts.MaxOperations = DefaultChunkOperations
+	}
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = DefaultChunkBytes
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+	return opts
+}
+
+// ErrChunkNotSent is the error of the chunks left unsent once a chunk has
+// failed.
+var ErrChunkNotSent = errors.New("chunk not sent after an earlier chunk failed")
+
+// A ChunkFailure is a request of a Chunked mutate method that failed as a
+// whole: none of its operations were applied.
+type ChunkFailure struct {
+	// Offset is the index of the first operation of the chunk, Len its
+	// number of operations.
+	Offset, Len int
+	Err         error
+}
+
+// ChunkError is returned by the Chunked mutate methods when some of their
+// requests failed.  The operations of the other chunks were applied, and
+// their entities are returned in place; the entries of the failed chunks
+// are zero values.  Chunks are no longer sent after a failure, those left
+// fail with ErrChunkNotSent.
+type ChunkError struct {
+	// Failures holds the failed chunks, by offset.
+	Failures []ChunkFailure
+	// PartialFailure holds the outcome of every operation when some of the
+	// chunks applied reported a partial failure, nil otherwise.  The
+	// operations of the failed chunks fail with their chunk error.
+	PartialFailure *PartialFailureError
+}
+
+func (e *ChunkError) Error() string {
+	first := e.Failures[0]
+	return fmt.Sprintf("%d chunks failed, the first of operations %d to %d: %v",
+		len(e.Failures), first.Offset, first.Offset+first.Len-1, first.Err)
+}
+
+// Unwrap returns the error of the first failed chunk.
+func (e *ChunkError) Unwrap() error {
+	return e.Failures[0].Err
+}
+
+// chunkResult is the outcome of the request of ops[offset:offset+n].
+type chunkResult struct {
+	offset, n int
+	values    reflect.Value
+	err       error
+}
+
+// splitChunks splits ops, a slice of operations, into ranges of at most
+// opts.MaxOperations operations and opts.MaxBytes bytes of XML.
+func splitChunks(ops reflect.Value, opts ChunkOptions) ([][2]int, error) {
+	var chunks [][2]int
+	start, size := 0, 0
+	for i := 0; i < ops.Len(); i++ {
+		b, err := xml.Marshal(ops.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		if i > start && (i-start == opts.MaxOperations || size+len(b) > opts.MaxBytes) {
+			chunks = append(chunks, [2]int{start, i})
+			start, size = i, 0
+		}
+		size += len(b)
+	}
+	if start < ops.Len() {
+		chunks = append(chunks, [2]int{start, ops.Len()})
+	}
+	return chunks, nil
+}
+
+// sendChunks splits ops, a slice of operations, and sends the chunks with
+// send, at most opts.Concurrency at a time.  send returns the entities of
+// the chunk as a slice.  The results are in operation order.
+//
+// Each chunk gets a ResponseMeta of its own, so that concurrent requests do
+// not share that of ctx.  The ResponseMeta of ctx then receives that of the
+// last chunk answered, in operation order, with the Operations of all.
+func sendChunks(
+	ctx context.Context,
+	ops interface{},
+	opts *ChunkOptions,
+	send func(ctx context.Context, chunk interface{}) (values interface{}, err error),
+) ([]chunkResult, error) {
+	o := opts.withDefaults()
+	opsValue := reflect.ValueOf(ops)
+	chunks, err := splitChunks(opsValue, o)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]chunkResult, len(chunks))
+	metas := make([]ResponseMeta, len(chunks))
+	callerMeta, _ := ctx.Value(responseMetaKey{}).(*ResponseMeta)
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed bool
+	)
+	sem := make(chan struct{}, o.Concurrency)
+	for i, c := range chunks {
+		results[i] = chunkResult{offset: c[0], n: c[1] - c[0]}
+		sem <- struct{}{}
+		mu.Lock()
+		stop := failed
+		mu.Unlock()
+		if stop {
+			<-sem
+			results[i].err = ErrChunkNotSent
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			<-sem
+			results[i].err = err
+			continue
+		}
+		wg.Add(1)
+		go func(r *chunkResult, meta *ResponseMeta, chunk interface{}) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			values, err := send(WithResponseMeta(ctx, meta), chunk)
+			r.values, r.err = reflect.ValueOf(values), err
+			if _, partial := err.(*PartialFailureError); err != nil && !partial {
+				mu.Lock()
+				failed = true
+				mu.Unlock()
+			}
+		}(&results[i], &metas[i], opsValue.Slice(c[0], c[1]).Interface())
+	}
+	wg.Wait()
+	if callerMeta != nil {
+		mergeResponseMeta(callerMeta, metas)
+	}
+	return results, nil
+}
+
+// mergeResponseMeta sets meta to the last of metas answered, with the
+// Operations of all of them.
+func mergeResponseMeta(meta *ResponseMeta, metas []ResponseMeta) {
+	var operations int64
+	last := -1
+	for i, m := range metas {
+		if m.RequestId != "" {
+			operations += m.Operations
+			last = i
+		}
+	}
+	if last < 0 {
+		return
+	}
+	*meta = metas[last]
+	meta.Operations = operations
+}
+
+// stitchValues returns the entities of results as one slice of typ, with
+// one entry per operation.
+func stitchValues(results []chunkResult, typ reflect.Type) reflect.Value {
+	n := 0
+	for _, r := range results {
+		n += r.n
+	}
+	values := reflect.MakeSlice(typ, n, n)
+	for _, r := range results {
+		if r.values.IsValid() && r.values.Kind() == reflect.Slice && !isChunkFailure(r.err) {
+			reflect.Copy(values.Slice(r.offset, r.offset+r.n), r.values)
+		}
+	}
+	return values
+}
+
+func isChunkFailure(err error) bool {
+	_, partial := err.(*PartialFailureError)
+	return err != nil && !partial
+}
+
+// chunkError merges the errors of results into a *PartialFailureError,
+// with the indexes of ops, and a *ChunkError when chunks failed.  values
+// holds the stitched entities.
+func chunkError(ops reflect.Value, values reflect.Value, results []chunkResult) error {
+	var (
+		failures []ChunkFailure
+		partial  bool
+	)
+	for _, r := range results {
+		if _, ok := r.err.(*PartialFailureError); ok {
+			partial = true
+		} else if r.err != nil {
+			failures = append(failures, ChunkFailure{Offset: r.offset, Len: r.n, Err: r.err})
+		}
+	}
+
+	var pf *PartialFailureError
+	if partial {
+		pf = &PartialFailureError{}
+		for _, r := range results {
+			if e, ok := r.err.(*PartialFailureError); ok {
+				// Move the field paths of the errors to the indexes of
+				// ops too, so that they agree with the results.
+				for _, res := range e.Results {
+					res.Index += r.offset
+					var errs []error
+					for _, err := range res.Errors {
+						errs = append(errs, shiftOperationIndex(err, r.offset))
+					}
+					res.Errors = errs
+					pf.Results = append(pf.Results, res)
+				}
+				for _, err := range e.Errors {
+					pf.Errors = append(pf.Errors, shiftOperationIndex(err, r.offset))
+				}
+				continue
+			}
+			for i := r.offset; i < r.offset+r.n; i++ {
+				res := OperationResult{Index: i}
+				res.Operator, res.Operand = operatorAndOperand(ops.Index(i))
+				if r.err != nil {
+					res.Errors = []error{r.err}
+				} else if values.IsValid() {
+					res.Value = values.Index(i).Interface()
+				}
+				pf.Results = append(pf.Results, res)
+			}
+		}
+	}
+
+	switch {
+	case failures != nil:
+		return &ChunkError{Failures: failures, PartialFailure: pf}
+	case pf != nil:
+		return pf
+	}
+	return nil
+}
+
+// MutateOperationsChunked is like MutateOperations but splits operations
+// into requests as set by opts, sent with bounded concurrency.  The
+// criteria are returned in operation order, and the indexes of a
+// PartialFailureError are those of operations.  The error is a *ChunkError
+// when some of the requests failed.
+func (s *AdGroupCriterionService) MutateOperationsChunked(ctx context.Context, operations []AdGroupCriterionOperation, opts *ChunkOptions) (AdGroupCriterions, error) {
+	results, err := sendChunks(ctx, operations, opts, func(ctx context.Context, chunk interface{}) (interface{}, error) {
+		return s.MutateOperationsContext(ctx, chunk.([]AdGroupCriterionOperation))
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := stitchValues(results, reflect.TypeOf(AdGroupCriterions{}))
+	return values.Interface().(AdGroupCriterions), chunkError(reflect.ValueOf(operations), values, results)
+}
+
+// MutateOperationsChunked is like MutateOperations but splits operations
+// into requests as set by opts, see AdGroupCriterionService.MutateOperationsChunked.
+func (s *CampaignCriterionService) MutateOperationsChunked(ctx context.Context, operations []CampaignCriterionOperation, opts *ChunkOptions) (CampaignCriterions, error) {
+	results, err := sendChunks(ctx, operations, opts, func(ctx context.Context, chunk interface{}) (interface{}, error) {
+		return s.MutateOperationsContext(ctx, chunk.([]CampaignCriterionOperation))
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := stitchValues(results, reflect.TypeOf(CampaignCriterions{}))
+	return values.Interface().(CampaignCriterions), chunkError(reflect.ValueOf(operations), values, results)
+}
+
+// MutateOperationsChunked is like MutateOperations but splits operations
+// into requests as set by opts, see AdGroupCriterionService.MutateOperationsChunked.
+func (s *AdGroupAdService) MutateOperationsChunked(ctx context.Context, operations []AdGroupAdOperation, opts *ChunkOptions) (AdGroupAds, error) {
+	results, err := sendChunks(ctx, operations, opts, func(ctx context.Context, chunk interface{}) (interface{}, error) {
+		return s.MutateOperationsContext(ctx, chunk.([]AdGroupAdOperation))
+	})
+	if err != nil {
+		return nil, err
+	}
+	values := stitchValues(results, reflect.TypeOf(AdGroupAds{}))
+	return values.Interface().(AdGroupAds), chunkError(reflect.ValueOf(operations), values, results)
+}
+
+// MutateMembersChunked is like MutateMembers but splits the members of the
+// operations into requests as set by opts, where MaxOperations counts
+// members.  The members of an operation split across requests are sent as
+// one operation per request, and its user list is returned once per
+// request, in operation order.  The error is a *ChunkError when some of
+// the requests failed; its offsets count members.
+func (s *AdwordsUserListService) MutateMembersChunked(ctx context.Context, mutateMembersOperations MutateMembersOperations, opts *ChunkOptions) (adwordsUserLists []UserList, err error) {
+	// Split the operations into one operation per member, merged back per
+	// chunk.
+	var units []Operation
+	for _, op := range mutateMembersOperations.Operations {
+		var operand MutateMembersOperand
+		switch o := op.Operand.(type) {
+		case MutateMembersOperand:
+			operand = o
+		case *MutateMembersOperand:
+			operand = *o
+		default:
+			units = append(units, op)
+			continue
+		}
+		if len(operand.Members) == 0 {
+			units = append(units, op)
+			continue
+		}
+		for _, member := range operand.Members {
+			unit := operand
+			unit.Members = []string{member}
+			units = append(units, Operation{Operator: op.Operator, Operand: &unit, Xsi_type: op.Xsi_type})
+		}
+	}
+
+	results, err := sendChunks(ctx, units, opts, func(ctx context.Context, chunk interface{}) (interface{}, error) {
+		var merged []Operation
+		for _, unit := range chunk.([]Operation) {
+			operand, ok := unit.Operand.(*MutateMembersOperand)
+			if n := len(merged); ok && n > 0 && merged[n-1].Operator == unit.Operator {
+				if last, ok := merged[n-1].Operand.(*MutateMembersOperand); ok && last.UserListId == operand.UserListId {
+					last.Members = append(last.Members, operand.Members...)
+					continue
+				}
+			}
+			if ok {
+				copied := *operand
+				copied.Members = append([]string(nil), operand.Members...)
+				unit.Operand = &copied
+			}
+			merged = append(merged, unit)
+		}
+		return s.MutateMembersContext(ctx, MutateMembersOperations{Operations: merged})
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		if !isChunkFailure(r.err) && r.values.IsValid() {
+			adwordsUserLists = append(adwordsUserLists, r.values.Interface().([]UserList)...)
+		}
+	}
+	return adwordsUserLists, chunkError(reflect.ValueOf(units), reflect.Value{}, results)
+}
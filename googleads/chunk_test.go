@@ -0,0 +1,212 @@
+package v201809
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func TestMutateOperationsChunked(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	sim := gadstest.NewSimulator(srv)
+	budgetId, _ := sim.Add("BudgetService", `<operand><name>b</name><amount><microAmount>1</microAmount></amount></operand>`)
+	campaignId, _ := sim.Add("CampaignService", `<operand><name>c</name><budget><budgetId>`+budgetId+`</budgetId></budget></operand>`)
+	adGroupId, err := sim.Add("AdGroupService", `<operand><name>ag</name><campaignId>`+campaignId+`</campaignId></operand>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := strconv.ParseInt(adGroupId, 10, 64)
+
+	var ops []AdGroupCriterionOperation
+	for i := 0; i < 5; i++ {
+		criterion := BiddableAdGroupCriterion{AdGroupId: id, Criterion: KeywordCriterion{Text: fmt.Sprint("k", i), MatchType: "EXACT"}}
+		if i == 3 {
+			criterion.AdGroupId = 1
+		}
+		ops = append(ops, AdGroupCriterionOperation{Action: "ADD", AdGroupCriterion: criterion})
+	}
+	criteria, err := NewAdGroupCriterionService(simulatorAuth(srv)).MutateOperationsChunked(
+		context.Background(), ops, &ChunkOptions{MaxOperations: 2, Concurrency: 2})
+	if n := len(srv.RequestsFor("AdGroupCriterionService", "mutate")); n != 3 {
+		t.Errorf("want 3 requests, got %d", n)
+	}
+	var pf *PartialFailureError
+	if !errors.As(err, &pf) || len(pf.Results) != 5 {
+		t.Fatalf("want a PartialFailureError of 5 operations, got %v", err)
+	}
+	if failed := pf.Failed(); len(failed) != 1 || failed[0].Index != 3 {
+		t.Errorf("unexpected failures %#v", failed)
+	}
+	if len(criteria) != 5 {
+		t.Fatalf("unexpected criteria %#v", criteria)
+	}
+	for i, c := range criteria {
+		if i == 3 {
+			continue
+		}
+		if k := c.(BiddableAdGroupCriterion).Criterion.(KeywordCriterion); k.Text != fmt.Sprint("k", i) {
+			t.Errorf("criterion %d is %q", i, k.Text)
+		}
+	}
+}
+
+// TestChunkedResponseMeta is meant to be run with -race: the concurrent
+// chunks must not share the ResponseMeta of the context.
+func TestChunkedResponseMeta(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	srv.Handle("CampaignCriterionService", "mutate", func(req *gadstest.Request) (string, error) {
+		return gadstest.Rval("mutate", `<value xsi:type="CampaignCriterion" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><campaignId>1</campaignId></value>`), nil
+	})
+
+	ops := make([]CampaignCriterionOperation, 8)
+	for i := range ops {
+		ops[i] = CampaignCriterionOperation{Action: "ADD", CampaignCriterion: CampaignCriterion{CampaignId: 1, Criterion: KeywordCriterion{Text: "k", MatchType: "EXACT"}}}
+	}
+	var meta ResponseMeta
+	_, err := NewCampaignCriterionService(simulatorAuth(srv)).MutateOperationsChunked(
+		WithResponseMeta(context.Background(), &meta), ops, &ChunkOptions{MaxOperations: 1, Concurrency: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.RequestId == "" || meta.Operations != 8 {
+		t.Errorf("want the meta of the last chunk with 8 operations, got %+v", meta)
+	}
+}
+
+func TestMutateOperationsChunkFailure(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	var calls int32
+	srv.Handle("CampaignCriterionService", "mutate", func(req *gadstest.Request) (string, error) {
+		if atomic.AddInt32(&calls, 1) == 2 {
+			return "", &gadstest.Fault{Status: http.StatusInternalServerError, Errors: []gadstest.ApiError{{
+				Type:   "AuthorizationError",
+				Reason: "USER_PERMISSION_DENIED",
+			}}}
+		}
+		return gadstest.Rval("mutate", `<value xsi:type="CampaignCriterion" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><campaignId>1</campaignId></value>`), nil
+	})
+
+	ops := make([]CampaignCriterionOperation, 3)
+	for i := range ops {
+		ops[i] = CampaignCriterionOperation{Action: "ADD", CampaignCriterion: CampaignCriterion{CampaignId: 1, Criterion: KeywordCriterion{Text: "k", MatchType: "EXACT"}}}
+	}
+	criteria, err := NewCampaignCriterionService(simulatorAuth(srv)).MutateOperationsChunked(
+		context.Background(), ops, &ChunkOptions{MaxOperations: 1})
+	var chunkErr *ChunkError
+	if !errors.As(err, &chunkErr) {
+		t.Fatalf("want a ChunkError, got %v", err)
+	}
+	if len(chunkErr.Failures) != 2 || chunkErr.Failures[0].Offset != 1 || !errors.Is(chunkErr.Failures[1].Err, ErrChunkNotSent) {
+		t.Errorf("unexpected failures %#v", chunkErr.Failures)
+	}
+	var authErr AuthorizationError
+	if !errors.As(err, &authErr) {
+		t.Errorf("want the AuthorizationError, got %v", err)
+	}
+	if len(criteria) != 3 || criteria[0] == nil || criteria[1] != nil || criteria[2] != nil {
+		t.Errorf("unexpected criteria %#v", criteria)
+	}
+}
+
+func TestSplitChunks(t *testing.T) {
+	ops := []CampaignLabelOperation{
+		{"ADD", CampaignLabel{1, 1}},
+		{"ADD", CampaignLabel{2, 2}},
+		{"ADD", CampaignLabel{3, 3}},
+	}
+	b, _ := xml.Marshal(ops[0])
+	chunks, err := splitChunks(reflect.ValueOf(ops), ChunkOptions{MaxOperations: 5, MaxBytes: 2*len(b) + 1})
+	if want := [][2]int{{0, 2}, {2, 3}}; err != nil || !reflect.DeepEqual(chunks, want) {
+		t.Errorf("want %v, got %v %v", want, chunks, err)
+	}
+	chunks, _ = splitChunks(reflect.ValueOf(ops), ChunkOptions{MaxOperations: 5, MaxBytes: 1})
+	if len(chunks) != 3 {
+		t.Errorf("want an operation per chunk, got %v", chunks)
+	}
+}
+
+func TestMutateMembersChunked(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	srv.Handle("AdwordsUserListService", "mutateMembers", func(req *gadstest.Request) (string, error) {
+		lists := ""
+		ops := strings.Split(string(req.Body), "</operations>")
+		for _, op := range ops[:len(ops)-1] {
+			lists += "<userLists><name>" + fmt.Sprint(strings.Count(op, "</members>")) + "</name></userLists>"
+		}
+		return gadstest.Rval("mutateMembers", lists), nil
+	})
+
+	first := &MutateMembersOperand{UserListId: 1, Members: []string{"a@x", "b@x", "c@x", "d@x"}}
+	second := &MutateMembersOperand{UserListId: 2, Members: []string{"e@x"}}
+	lists, err := NewAdwordsUserListService(simulatorAuth(srv)).MutateMembersChunked(
+		context.Background(),
+		MutateMembersOperations{Operations: []Operation{{Operator: "ADD", Operand: first}, {Operator: "ADD", Operand: second}}},
+		&ChunkOptions{MaxOperations: 3},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sizes []string
+	for _, l := range lists {
+		sizes = append(sizes, l.Name)
+	}
+	if want := []string{"3", "1", "1"}; !reflect.DeepEqual(sizes, want) {
+		t.Errorf("want members per operation %v, got %v", want, sizes)
+	}
+	if first.Members[0] != "a@x" || len(first.Members) != 4 {
+		t.Errorf("members changed to %v", first.Members)
+	}
+}
+
+func TestChunkErrorShiftsFieldPaths(t *testing.T) {
+	ops := make([]CampaignLabelOperation, 4)
+	index := 1
+	violation := PolicyViolationError{
+		EntityError: EntityError{
+			FieldPath:         "operations[1].operand.name",
+			FieldPathElements: []FieldPathElement{{Field: "operations", Index: &index}, {Field: "operand"}},
+			Type:              "PolicyViolationError",
+		},
+		Key:          PolicyViolationKey{PolicyName: "trademark"},
+		IsExemptable: true,
+	}
+	second := &PartialFailureError{
+		Results: []OperationResult{{Index: 0}, {Index: 1, Errors: []error{violation}}},
+		Errors:  []error{violation},
+	}
+	err := chunkError(reflect.ValueOf(ops), reflect.Value{}, []chunkResult{
+		{offset: 0, n: 2},
+		{offset: 2, n: 2, err: second},
+	})
+
+	requests := ExemptionRequests(err)
+	if len(requests) != 1 || len(requests[3]) != 1 || requests[3][0].Key.PolicyName != "trademark" {
+		t.Errorf("want an exemption request for operation 3, got %v", requests)
+	}
+	var pf *PartialFailureError
+	if !errors.As(err, &pf) {
+		t.Fatalf("want a PartialFailureError, got %v", err)
+	}
+	if failed := pf.Failed(); len(failed) != 1 || failed[0].Index != 3 || operationIndex(failed[0].Errors[0]) != 3 {
+		t.Errorf("unexpected failures %#v", failed)
+	}
+	if e := pf.Errors[0].(PolicyViolationError); e.FieldPath != "operations[3].operand.name" || *e.FieldPathElements[0].Index != 3 {
+		t.Errorf("unexpected field path %q %v", e.FieldPath, *e.FieldPathElements[0].Index)
+	}
+	if index != 1 || violation.FieldPath != "operations[1].operand.name" {
+		t.Error("the errors of the chunk were modified")
+	}
+}
@@ -12,14 +12,18 @@ import (
 	gads "github.com/denton/gads/googleads"
 )
 
-var configJson = flag.String("oauth", "./oauth.json", "API credentials")
+var (
+	configFile = flag.String("config", "", "gads JSON, googleads.yaml or ads.properties config file (default $ADWORDS_CONFIG_FILE, ~/googleads.yaml or ~/ads.properties)")
+	profile    = flag.String("profile", "", "credential profile of the profiles file to use in place of -config, which it excludes (default $ADWORDS_PROFILE)")
+)
 
 // Your campaign ID should go here
 var campaignId int64 = 1234567890
 
 func main() {
+	flag.StringVar(configFile, "oauth", "", "deprecated alias of -config")
 	flag.Parse()
-	config, err := gads.NewCredentialsFromFile(*configJson)
+	config, err := gads.LoadConfig(gads.ConfigOptions{Profile: *profile, ConfigFile: *configFile})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -9,62 +10,56 @@ import (
 	gads "github.com/denton/gads/googleads"
 )
 
-var configJson = flag.String("oauth", "./oauth.json", "API credentials")
+var (
+	configFile = flag.String("config", "", "gads JSON, googleads.yaml or ads.properties config file (default $ADWORDS_CONFIG_FILE, ~/googleads.yaml or ~/ads.properties)")
+	profile    = flag.String("profile", "", "credential profile of the profiles file to use in place of -config, which it excludes (default $ADWORDS_PROFILE)")
+)
 
 func main() {
-	config, err := gads.NewCredentialsFromFile(*configJson)
+	flag.StringVar(configFile, "oauth", "", "deprecated alias of -config")
+	flag.Parse()
+	config, err := gads.LoadConfig(gads.ConfigOptions{Profile: *profile, ConfigFile: *configFile})
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	var pageSize int64 = 500
-	var offset int64
-
 	// show all Campaigns
 	cs := gads.NewCampaignService(&config.Auth)
-	paging := gads.Paging{
-		Offset: offset,
-		Limit:  pageSize,
-	}
 	fmt.Printf("\nCampaigns\n")
-	for {
-		foundCampaigns, totalCount, err := cs.Get(
-			gads.Selector{
-				Fields: []string{
-					"Id",
-					"Name",
-					"Status",
-					"ServingStatus",
-					"StartDate",
-					"EndDate",
-					"Settings",
-					"AdvertisingChannelType",
-					"AdvertisingChannelSubType",
-					"Labels",
-					"TrackingUrlTemplate",
-					"UrlCustomParameters",
-				},
-				Predicates: []gads.Predicate{
-					{"Status", "EQUALS", []string{"PAUSED"}},
-				},
-				Ordering: []gads.OrderBy{
-					{"Id", "ASCENDING"},
-				},
-				Paging: &paging,
+	campaigns := cs.Pages(
+		context.Background(),
+		gads.Selector{
+			Fields: []string{
+				"Id",
+				"Name",
+				"Status",
+				"ServingStatus",
+				"StartDate",
+				"EndDate",
+				"Settings",
+				"AdvertisingChannelType",
+				"AdvertisingChannelSubType",
+				"Labels",
+				"TrackingUrlTemplate",
+				"UrlCustomParameters",
 			},
-		)
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, campaign := range foundCampaigns {
+			Predicates: []gads.Predicate{
+				{"Status", "EQUALS", []string{"PAUSED"}},
+			},
+			Ordering: []gads.OrderBy{
+				{"Id", "ASCENDING"},
+			},
+		},
+		&gads.PageOptions{PageSize: 500},
+	)
+	for campaigns.Next() {
+		for _, campaign := range campaigns.Campaigns() {
 			campaignJson, _ := json.MarshalIndent(campaign, "", "  ")
 			fmt.Printf("%s\n", campaignJson)
 		}
-		offset += pageSize
-		paging.Offset = offset
-		if totalCount < offset {
-			break
-		}
+	}
+	if err := campaigns.Err(); err != nil {
+		log.Fatal(err)
 	}
 
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -9,176 +10,144 @@ import (
 	gads "github.com/denton/gads/googleads"
 )
 
-var configJson = flag.String("oauth", "./oauth.json", "API credentials")
+var (
+	configFile = flag.String("config", "", "gads JSON, googleads.yaml or ads.properties config file (default $ADWORDS_CONFIG_FILE, ~/googleads.yaml or ~/ads.properties)")
+	profile    = flag.String("profile", "", "credential profile of the profiles file to use in place of -config, which it excludes (default $ADWORDS_PROFILE)")
+)
 
 func main() {
-	config, err := gads.NewCredentialsFromFile(*configJson)
+	flag.StringVar(configFile, "oauth", "", "deprecated alias of -config")
+	flag.Parse()
+	config, err := gads.LoadConfig(gads.ConfigOptions{Profile: *profile, ConfigFile: *configFile})
 	if err != nil {
 		log.Fatal(err)
 	}
-	bs := gads.NewBudgetService(&config.Auth)
+	ctx := context.Background()
+	pageOptions := &gads.PageOptions{PageSize: 500, Prefetch: true}
 
-	var pageSize int64 = 500
-	var offset int64 = 0
-	paging := gads.Paging{
-		Offset: offset,
-		Limit:  pageSize,
-	}
+	bs := gads.NewBudgetService(&config.Auth)
 	fmt.Printf("\nBudgets\n")
-	for {
-		foundBudgets, totalCount, err := bs.Get(gads.Selector{
-			Fields: []string{
-				"BudgetId",
-				"BudgetName",
-				"Period",
-				"Amount",
-				"DeliveryMethod",
-				"BudgetReferenceCount",
-				"IsBudgetExplicitlyShared",
-				"BudgetStatus",
-			},
-			Paging: &paging,
-		})
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, budget := range foundBudgets {
+	budgets := bs.Pages(ctx, gads.Selector{
+		Fields: []string{
+			"BudgetId",
+			"BudgetName",
+			"Period",
+			"Amount",
+			"DeliveryMethod",
+			"BudgetReferenceCount",
+			"IsBudgetExplicitlyShared",
+			"BudgetStatus",
+		},
+	}, pageOptions)
+	for budgets.Next() {
+		for _, budget := range budgets.Budgets() {
 			budgetJson, _ := json.MarshalIndent(budget, "", "  ")
 			fmt.Printf("  %s\n", string(budgetJson))
 		}
-		offset += pageSize
-		paging.Offset = offset
-		if totalCount < offset {
-			break
-		}
+	}
+	if err := budgets.Err(); err != nil {
+		log.Fatal(err)
 	}
 
 	// show all Campaigns
 	cs := gads.NewCampaignService(&config.Auth)
-	offset = 0
-	paging = gads.Paging{
-		Offset: offset,
-		Limit:  pageSize,
-	}
 	fmt.Printf("\nCampaigns\n")
-	for {
-		foundCampaigns, totalCount, err := cs.Get(
-			gads.Selector{
-				Fields: []string{
-					"Id",
-					"BudgetId",
-					"Name",
-					"Status",
-					"ServingStatus",
-					"StartDate",
-					"EndDate",
-					"Settings",
-					"AdvertisingChannelType",
-					"AdvertisingChannelSubType",
-					"Labels",
-					"TrackingUrlTemplate",
-					"UrlCustomParameters",
-				},
-				Predicates: []gads.Predicate{
-					{"Status", "EQUALS", []string{"PAUSED"}},
-				},
-				Ordering: []gads.OrderBy{
-					{"Id", "ASCENDING"},
-				},
-				Paging: &paging,
+	campaigns := cs.Pages(
+		ctx,
+		gads.Selector{
+			Fields: []string{
+				"Id",
+				"BudgetId",
+				"Name",
+				"Status",
+				"ServingStatus",
+				"StartDate",
+				"EndDate",
+				"Settings",
+				"AdvertisingChannelType",
+				"AdvertisingChannelSubType",
+				"Labels",
+				"TrackingUrlTemplate",
+				"UrlCustomParameters",
 			},
-		)
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, campaign := range foundCampaigns {
+			Predicates: []gads.Predicate{
+				{"Status", "EQUALS", []string{"PAUSED"}},
+			},
+			Ordering: []gads.OrderBy{
+				{"Id", "ASCENDING"},
+			},
+		},
+		pageOptions,
+	)
+	for campaigns.Next() {
+		for _, campaign := range campaigns.Campaigns() {
 			campaignJson, _ := json.MarshalIndent(campaign, "", "  ")
 			fmt.Printf("%s\n", campaignJson)
 		}
-		offset += pageSize
-		paging.Offset = offset
-		if totalCount < offset {
-			break
-		}
+	}
+	if err := campaigns.Err(); err != nil {
+		log.Fatal(err)
 	}
 
 	ags := gads.NewAdGroupService(&config.Auth)
-	offset = 0
-	paging = gads.Paging{
-		Offset: offset,
-		Limit:  pageSize,
-	}
 	fmt.Printf("\nAdGroups\n")
-	for {
-		foundAdGroups, totalCount, err := ags.Get(
-			gads.Selector{
-				Fields: []string{
-					"Id",
-					"CampaignId",
-					"CampaignName",
-					"Name",
-					"Status",
-					"Settings",
-					"ContentBidCriterionTypeGroup",
-				},
-				Predicates: []gads.Predicate{
-					{"Status", "EQUALS", []string{"PAUSED"}},
-				},
-				Ordering: []gads.OrderBy{
-					{"Id", "ASCENDING"},
-				},
-				Paging: &paging,
+	adGroups := ags.Pages(
+		ctx,
+		gads.Selector{
+			Fields: []string{
+				"Id",
+				"CampaignId",
+				"CampaignName",
+				"Name",
+				"Status",
+				"Settings",
+				"ContentBidCriterionTypeGroup",
 			},
-		)
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, adGroup := range foundAdGroups {
+			Predicates: []gads.Predicate{
+				{"Status", "EQUALS", []string{"PAUSED"}},
+			},
+			Ordering: []gads.OrderBy{
+				{"Id", "ASCENDING"},
+			},
+		},
+		pageOptions,
+	)
+	for adGroups.Next() {
+		for _, adGroup := range adGroups.AdGroups() {
 			adGroupJson, _ := json.MarshalIndent(adGroup, "", "  ")
 			fmt.Printf("%#v\n", adGroupJson)
 		}
-		offset += pageSize
-		paging.Offset = offset
-		if totalCount < offset {
-			break
-		}
+	}
+	if err := adGroups.Err(); err != nil {
+		log.Fatal(err)
 	}
 
 	agas := gads.NewAdGroupAdService(&config.Auth)
-	offset = 0
-	paging = gads.Paging{
-		Offset: offset,
-		Limit:  pageSize,
-	}
 	fmt.Printf("\nAds\n")
-	for {
-		foundAds, totalCount, err := agas.Get(
-			gads.Selector{
-				Fields: []string{
-					"AdGroupId",
-					"Status",
-					"AdGroupCreativeApprovalStatus",
-					"AdGroupAdDisapprovalReasons",
-					"AdGroupAdTrademarkDisapproved",
-				},
-				Ordering: []gads.OrderBy{
-					{"AdGroupId", "ASCENDING"},
-					{"Id", "ASCENDING"},
-				},
-				Paging: &paging,
+	ads := agas.Pages(
+		ctx,
+		gads.Selector{
+			Fields: []string{
+				"AdGroupId",
+				"Status",
+				"AdGroupCreativeApprovalStatus",
+				"AdGroupAdDisapprovalReasons",
+				"AdGroupAdTrademarkDisapproved",
 			},
-		)
-		if err != nil {
-			log.Fatal(err)
-		}
-		for _, ad := range foundAds {
+			Ordering: []gads.OrderBy{
+				{"AdGroupId", "ASCENDING"},
+				{"Id", "ASCENDING"},
+			},
+		},
+		pageOptions,
+	)
+	for ads.Next() {
+		for _, ad := range ads.AdGroupAds() {
 			adJson, _ := json.MarshalIndent(ad, "", "  ")
 			fmt.Printf("%s\n", adJson)
 		}
-		offset += pageSize
-		paging.Offset = offset
-		if totalCount < offset {
-			break
-		}
+	}
+	if err := ads.Err(); err != nil {
+		log.Fatal(err)
 	}
 }
@@ -18,10 +18,15 @@ func rand_str(str_size int) string {
 	return string(bytes)
 }
 
-var configJson = flag.String("oauth", "./oauth.json", "API credentials")
+var (
+	configFile = flag.String("config", "", "gads JSON, googleads.yaml or ads.properties config file (default $ADWORDS_CONFIG_FILE, ~/googleads.yaml or ~/ads.properties)")
+	profile    = flag.String("profile", "", "credential profile of the profiles file to use in place of -config, which it excludes (default $ADWORDS_PROFILE)")
+)
 
 func main() {
-	config, err := gads.NewCredentialsFromFile(*configJson)
+	flag.StringVar(configFile, "oauth", "", "deprecated alias of -config")
+	flag.Parse()
+	config, err := gads.LoadConfig(gads.ConfigOptions{Profile: *profile, ConfigFile: *configFile})
 	if err != nil {
 		log.Fatal(err)
 	}
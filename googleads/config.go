@@ -0,0 +1,397 @@
+package v201809
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ConfigOptions are the settings given to LoadConfig explicitly, which
+// take precedence over the environment and the config file.  Empty fields
+// are left to them.
+type ConfigOptions struct {
+	// Profile names the profile of ProfilesFile read in place of the
+	// config file, $ADWORDS_PROFILE when empty.  See Profiles.  LoadConfig
+	// fails when both a profile and ConfigFile are given.
+	Profile string
+	// ProfilesFile is the profiles file, $ADWORDS_PROFILES_FILE or
+	// DefaultProfilesFile when empty.
+	ProfilesFile string
+
+	// ConfigFile is the config file to read: a gads JSON file as written
+	// by setup_oauth2, a googleads.yaml of the Python library or an
+	// ads.properties of the Java library, told apart by their extension.
+	// When empty, $ADWORDS_CONFIG_FILE is read, else the first of
+	// ~/googleads.yaml and ~/ads.properties that exists, if any.
+	ConfigFile string
+	// NoDefaultFile skips ~/googleads.yaml and ~/ads.properties, so that
+	// only the config file named by ConfigFile or $ADWORDS_CONFIG_FILE is
+	// read.
+	NoDefaultFile bool
+
+	ClientId     string
+	ClientSecret string
+	RefreshToken string
+	// ServiceAccountKeyFile is the JSON key of a service account used
+	// instead of the client ID and refresh token, impersonating
+	// DelegatedAccount when not empty.
+	ServiceAccountKeyFile string
+	DelegatedAccount      string
+
+	DeveloperToken   string
+	ClientCustomerId string
+	UserAgent        string
+	PartialFailure   *bool
+	ValidateOnly     *bool
+}
+
+// The environment variables read by LoadConfig.
+const (
+	EnvConfigFile            = "ADWORDS_CONFIG_FILE"
+	EnvProfile               = "ADWORDS_PROFILE"
+	EnvProfilesFile          = "ADWORDS_PROFILES_FILE"
+	EnvClientId              = "ADWORDS_CLIENT_ID"
+	EnvClientSecret          = "ADWORDS_CLIENT_SECRET"
+	EnvRefreshToken          = "ADWORDS_REFRESH_TOKEN"
+	EnvServiceAccountKeyFile = "ADWORDS_SERVICE_ACCOUNT_KEY_FILE"
+	EnvDelegatedAccount      = "ADWORDS_DELEGATED_ACCOUNT"
+	EnvDeveloperToken        = "ADWORDS_DEVELOPER_TOKEN"
+	EnvClientCustomerId      = "ADWORDS_CLIENT_CUSTOMER_ID"
+	EnvUserAgent             = "ADWORDS_USER_AGENT"
+	EnvPartialFailure        = "ADWORDS_PARTIAL_FAILURE"
+	EnvValidateOnly          = "ADWORDS_VALIDATE_ONLY"
+)
+
+// yamlKeys, propertiesKeys and envKeys map the keys of googleads.yaml,
+// ads.properties and the environment to the settings of LoadConfig.
+var (
+	yamlKeys = map[string]string{
+		"adwords.client_id":                "clientId",
+		"adwords.client_secret":            "clientSecret",
+		"adwords.refresh_token":            "refreshToken",
+		"adwords.path_to_private_key_file": "serviceAccountKeyFile",
+		"adwords.delegated_account":        "delegatedAccount",
+		"adwords.developer_token":          "developerToken",
+		"adwords.client_customer_id":       "clientCustomerId",
+		"adwords.user_agent":               "userAgent",
+		"adwords.partial_failure":          "partialFailure",
+		"adwords.validate_only":            "validateOnly",
+	}
+	propertiesKeys = map[string]string{
+		"api.adwords.clientId":           "clientId",
+		"api.adwords.clientSecret":       "clientSecret",
+		"api.adwords.refreshToken":       "refreshToken",
+		"api.adwords.jsonKeyFilePath":    "serviceAccountKeyFile",
+		"api.adwords.serviceAccountUser": "delegatedAccount",
+		"api.adwords.developerToken":     "developerToken",
+		"api.adwords.clientCustomerId":   "clientCustomerId",
+		"api.adwords.userAgent":          "userAgent",
+		"api.adwords.isPartialFailure":   "partialFailure",
+		"api.adwords.isValidateOnly":     "validateOnly",
+	}
+	envKeys = map[string]string{
+		EnvClientId:              "clientId",
+		EnvClientSecret:          "clientSecret",
+		EnvRefreshToken:          "refreshToken",
+		EnvServiceAccountKeyFile: "serviceAccountKeyFile",
+		EnvDelegatedAccount:      "delegatedAccount",
+		EnvDeveloperToken:        "developerToken",
+		EnvClientCustomerId:      "clientCustomerId",
+		EnvUserAgent:             "userAgent",
+		EnvPartialFailure:        "partialFailure",
+		EnvValidateOnly:          "validateOnly",
+	}
+)
+
+// LoadConfig returns an AuthConfig of the settings of opts, else of the
+// environment variables, else of the profile or config file.  It
+// authenticates with the token of a gads JSON file, a service account key,
+// or a client ID, secret and refresh token, as set by the first of opts,
+// the environment and the file that sets any of them.
+func LoadConfig(opts ConfigOptions) (ac AuthConfig, err error) {
+	path := opts.ConfigFile
+	if path == "" {
+		path = os.Getenv(EnvConfigFile)
+	}
+	if path == "" && !opts.NoDefaultFile {
+		path = defaultConfigFile()
+	}
+	profile := opts.Profile
+	if profile == "" {
+		profile = os.Getenv(EnvProfile)
+	}
+	if profile != "" && opts.ConfigFile != "" {
+		return ac, fmt.Errorf("gads: both profile %q and config file %s given", profile, opts.ConfigFile)
+	}
+
+	settings := map[string]string{}
+	isJSON := profile == "" && strings.EqualFold(filepath.Ext(path), ".json")
+	switch {
+	case profile != "":
+		profiles, err := LoadProfiles(opts.ProfilesFile)
+		if err != nil {
+			return ac, err
+		}
+		if settings, err = profiles.settings(profile); err != nil {
+			return ac, err
+		}
+	case path == "":
+	case isJSON:
+		if ac, err = NewCredentialsFromFile(path); err != nil {
+			return ac, err
+		}
+	default:
+		if settings, err = readConfigFile(path); err != nil {
+			return ac, err
+		}
+	}
+	env := map[string]string{}
+	for name, key := range envKeys {
+		if v := os.Getenv(name); v != "" {
+			env[key] = v
+		}
+	}
+	overlaySettings(settings, env)
+	explicit := map[string]string{}
+	for key, v := range map[string]string{
+		"clientId":              opts.ClientId,
+		"clientSecret":          opts.ClientSecret,
+		"refreshToken":          opts.RefreshToken,
+		"serviceAccountKeyFile": opts.ServiceAccountKeyFile,
+		"delegatedAccount":      opts.DelegatedAccount,
+		"developerToken":        opts.DeveloperToken,
+		"clientCustomerId":      opts.ClientCustomerId,
+		"userAgent":             opts.UserAgent,
+	} {
+		if v != "" {
+			explicit[key] = v
+		}
+	}
+	for key, v := range map[string]*bool{"partialFailure": opts.PartialFailure, "validateOnly": opts.ValidateOnly} {
+		if v != nil {
+			explicit[key] = strconv.FormatBool(*v)
+		}
+	}
+	overlaySettings(settings, explicit)
+
+	return newAuthConfig(ac, settings, isJSON)
+}
+
+// credentialKeys are the settings of each kind of credentials.
+var credentialKeys = [][]string{
+	{"serviceAccountKeyFile", "delegatedAccount"},
+	{"clientId", "clientSecret", "refreshToken"},
+}
+
+// overlaySettings sets the settings of layer over those of settings.  The
+// kind of credentials is that of the layer when it sets any, the settings
+// of the other kinds being dropped, so that e.g. a refresh token given
+// explicitly is used over the service account key of the config file.
+func overlaySettings(settings, layer map[string]string) {
+	for i, keys := range credentialKeys {
+		if !hasAnyKey(layer, keys) {
+			continue
+		}
+		for j, other := range credentialKeys {
+			if j != i {
+				for _, key := range other {
+					delete(settings, key)
+				}
+			}
+		}
+	}
+	for key, v := range layer {
+		settings[key] = v
+	}
+}
+
+func hasAnyKey(settings map[string]string, keys []string) bool {
+	for _, key := range keys {
+		if _, ok := settings[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// newAuthConfig returns base with the settings applied.  The credentials
+// of base are kept when keep is set and settings have none.
+func newAuthConfig(base AuthConfig, settings map[string]string, keep bool) (ac AuthConfig, err error) {
+	ac = base
+	auth := ac.Auth
+	for key, field := range map[string]*string{
+		"developerToken":   &auth.DeveloperToken,
+		"clientCustomerId": &auth.CustomerId,
+		"userAgent":        &auth.UserAgent,
+	} {
+		if v, ok := settings[key]; ok {
+			*field = v
+		}
+	}
+	for key, field := range map[string]*bool{"partialFailure": &auth.PartialFailure, "validateOnly": &auth.ValidateOnly} {
+		if v, ok := settings[key]; ok {
+			if *field, err = parseBool(v); err != nil {
+				return ac, fmt.Errorf("gads: %s: %v", key, err)
+			}
+		}
+	}
+	if auth.DeveloperToken == "" {
+		return ac, errors.New("gads: no developer token configured")
+	}
+
+	switch {
+	case settings["serviceAccountKeyFile"] != "":
+		return NewCredentialsFromServiceAccountFile(settings["serviceAccountKeyFile"], settings["delegatedAccount"], auth)
+	case settings["refreshToken"] != "":
+		if ac, err = NewCredentialsFromParams(Credentials{
+			Config: OAuthConfigArgs{ClientID: settings["clientId"], ClientSecret: settings["clientSecret"]},
+			Token:  OAuthTokenArgs{RefreshToken: settings["refreshToken"]},
+		}); err != nil {
+			return ac, err
+		}
+	case !keep:
+		return ac, errors.New("gads: no credentials configured")
+	}
+	auth.Client = ac.Auth.Client
+	ac.Auth = auth
+	return ac, nil
+}
+
+// defaultConfigFile returns the first of the standard config files that
+// exists, or "".
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	for _, name := range []string{"googleads.yaml", "ads.properties"} {
+		path := filepath.Join(home, name)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// readConfigFile returns the settings of a googleads.yaml or ads.properties
+// file.
+func readConfigFile(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var values, keys map[string]string
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		values, err = parseYAML(f)
+		keys = yamlKeys
+	case ".properties":
+		values, err = parseProperties(f)
+		keys = propertiesKeys
+	default:
+		return nil, fmt.Errorf("gads: %s: unknown config file type %q", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("gads: %s: %v", path, err)
+	}
+	settings := map[string]string{}
+	for k, v := range values {
+		if key, ok := keys[k]; ok && v != "" {
+			settings[key] = v
+		}
+	}
+	return settings, nil
+}
+
+// parseYAML reads the scalars of the nested mappings of a YAML file, the
+// subset of YAML used by googleads.yaml, keyed by their dotted path, e.g.
+// adwords.developer_token.
+func parseYAML(r io.Reader) (map[string]string, error) {
+	values := map[string]string{}
+	type level struct {
+		indent int
+		key    string
+	}
+	var parents []level
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		i := strings.Index(trimmed, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("line %d: want key: value", n)
+		}
+		key, value := strings.TrimSpace(trimmed[:i]), yamlScalar(trimmed[i+1:])
+		for len(parents) > 0 && parents[len(parents)-1].indent >= indent {
+			parents = parents[:len(parents)-1]
+		}
+		path := key
+		if len(parents) > 0 {
+			path = parents[len(parents)-1].key + "." + key
+		}
+		if value == "" {
+			parents = append(parents, level{indent, path})
+			continue
+		}
+		values[path] = value
+	}
+	return values, scanner.Err()
+}
+
+// yamlScalar returns the value of a scalar, without its quotes or comment.
+func yamlScalar(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') {
+		if end := strings.IndexByte(s[1:], s[0]); end >= 0 {
+			return s[1 : end+1]
+		}
+	}
+	if i := strings.Index(s, " #"); i >= 0 {
+		s = s[:i]
+	}
+	if strings.HasPrefix(s, "#") {
+		return ""
+	}
+	return strings.TrimSpace(s)
+}
+
+// parseProperties reads the key=value or key: value lines of a Java
+// properties file.
+func parseProperties(r io.Reader) (map[string]string, error) {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		i := strings.IndexAny(line, "=:")
+		if i < 0 {
+			values[line] = ""
+			continue
+		}
+		values[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+	}
+	return values, scanner.Err()
+}
+
+// parseBool is strconv.ParseBool accepting the yes and no of YAML too.
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "yes", "y", "on":
+		return true, nil
+	case "no", "n", "off":
+		return false, nil
+	}
+	return strconv.ParseBool(strings.ToLower(s))
+}
@@ -0,0 +1,164 @@
+package v201809
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// setenv sets the environment variable key for the duration of the test.
+func setenv(t *testing.T, key, value string) {
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+// clearConfigEnv clears the environment variables read by LoadConfig for
+// the duration of the test.
+func clearConfigEnv(t *testing.T) {
+	for key := range envKeys {
+		setenv(t, key, "")
+	}
+	for _, key := range []string{EnvConfigFile, EnvProfile, EnvProfilesFile} {
+		setenv(t, key, "")
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	values, err := parseYAML(strings.NewReader(`# googleads.yaml
+adwords:
+  developer_token: 'abc # not a comment'
+  client_customer_id: 123-456-7890  # the account
+  partial_failure: True
+  oauth:
+    user_agent: "x: y"
+ad_manager:
+  application_name: other
+`))
+	want := map[string]string{
+		"adwords.developer_token":     "abc # not a comment",
+		"adwords.client_customer_id":  "123-456-7890",
+		"adwords.partial_failure":     "True",
+		"adwords.oauth.user_agent":    "x: y",
+		"ad_manager.application_name": "other",
+	}
+	if err != nil || !reflect.DeepEqual(values, want) {
+		t.Errorf("want %v, got %v %v", want, values, err)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	clearConfigEnv(t)
+	dir := t.TempDir()
+	yaml := filepath.Join(dir, "googleads.yaml")
+	ioutil.WriteFile(yaml, []byte(`adwords:
+  developer_token: file-token
+  client_customer_id: 111
+  user_agent: file-agent
+  client_id: id
+  client_secret: secret
+  refresh_token: refresh
+  validate_only: yes
+`), 0600)
+	properties := filepath.Join(dir, "ads.properties")
+	ioutil.WriteFile(properties, []byte(`# ads.properties
+api.adwords.developerToken=properties-token
+api.adwords.clientId = id
+api.adwords.refreshToken: refresh
+api.adwords.isPartialFailure=true
+`), 0600)
+	setenv(t, EnvConfigFile, yaml)
+	setenv(t, EnvClientCustomerId, "222")
+	setenv(t, EnvUserAgent, "env-agent")
+
+	partialFailure := true
+	config, err := LoadConfig(ConfigOptions{UserAgent: "option-agent", PartialFailure: &partialFailure})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := config.Auth
+	if auth.DeveloperToken != "file-token" || auth.CustomerId != "222" || auth.UserAgent != "option-agent" ||
+		!auth.PartialFailure || !auth.ValidateOnly || auth.Client == nil {
+		t.Errorf("unexpected Auth %+v", auth)
+	}
+	if config.OAuth2Token.RefreshToken != "refresh" || config.OAuth2Config.ClientSecret != "secret" {
+		t.Errorf("unexpected credentials %+v %+v", config.OAuth2Config, config.OAuth2Token)
+	}
+
+	config, err = LoadConfig(ConfigOptions{ConfigFile: properties})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth := config.Auth; auth.DeveloperToken != "properties-token" || auth.CustomerId != "222" || !auth.PartialFailure {
+		t.Errorf("unexpected Auth %+v", auth)
+	}
+
+	json := filepath.Join(dir, "config.json")
+	if err := config.SaveAs(json); err != nil {
+		t.Fatal(err)
+	}
+	setenv(t, EnvConfigFile, json)
+	config, err = LoadConfig(ConfigOptions{})
+	if err != nil || config.Auth.DeveloperToken != "properties-token" || config.OAuth2Token.RefreshToken != "refresh" {
+		t.Errorf("unexpected config %+v %v", config, err)
+	}
+
+	if _, err := LoadConfig(ConfigOptions{ConfigFile: filepath.Join(dir, "missing.yaml")}); err == nil {
+		t.Error("want an error for a missing file")
+	}
+}
+
+func TestLoadConfigNoDefaultFile(t *testing.T) {
+	clearConfigEnv(t)
+	home := t.TempDir()
+	setenv(t, "HOME", home)
+	ioutil.WriteFile(filepath.Join(home, "googleads.yaml"), []byte(`adwords:
+  developer_token: file-token
+  client_id: id
+  refresh_token: refresh
+`), 0600)
+
+	config, err := LoadConfig(ConfigOptions{})
+	if err != nil || config.Auth.DeveloperToken != "file-token" {
+		t.Errorf("unexpected config %+v %v", config, err)
+	}
+	if _, err := LoadConfig(ConfigOptions{NoDefaultFile: true}); err == nil {
+		t.Error("want an error without the default file")
+	}
+	config, err = LoadConfig(ConfigOptions{NoDefaultFile: true, DeveloperToken: "option-token", RefreshToken: "option-refresh"})
+	if err != nil || config.Auth.DeveloperToken != "option-token" {
+		t.Errorf("unexpected config %+v %v", config, err)
+	}
+}
+
+func TestLoadConfigCredentialPrecedence(t *testing.T) {
+	clearConfigEnv(t)
+	yaml := filepath.Join(t.TempDir(), "googleads.yaml")
+	ioutil.WriteFile(yaml, []byte(`adwords:
+  developer_token: file-token
+  client_id: id
+  client_secret: secret
+  path_to_private_key_file: missing-key.json
+`), 0600)
+
+	config, err := LoadConfig(ConfigOptions{ConfigFile: yaml, RefreshToken: "option-refresh"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.OAuth2Token.RefreshToken != "option-refresh" || config.OAuth2Config.ClientID != "id" {
+		t.Errorf("unexpected credentials %+v %+v", config.OAuth2Config, config.OAuth2Token)
+	}
+
+	if _, err := LoadConfig(ConfigOptions{ConfigFile: yaml}); err == nil {
+		t.Error("want an error for the missing key of the file")
+	}
+}
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -13,7 +14,14 @@ func NewConstantDataService(auth *Auth) *ConstantDataService {
 }
 
 func (s *ConstantDataService) GetAgeRangeCriterion() (ageRanges []AgeRangeCriterion, err error) {
+	return s.GetAgeRangeCriterionContext(context.Background())
+}
+
+// GetAgeRangeCriterionContext is like GetAgeRangeCriterion but takes a context that controls the
+// request lifetime.
+func (s *ConstantDataService) GetAgeRangeCriterionContext(ctx context.Context) (ageRanges []AgeRangeCriterion, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		constantDataServiceUrl,
 		"getAgeRangeCriterion",
 		struct {
@@ -34,7 +42,14 @@ func (s *ConstantDataService) GetAgeRangeCriterion() (ageRanges []AgeRangeCriter
 }
 
 func (s *ConstantDataService) GetCarrierCriterion() (carriers []CarrierCriterion, err error) {
+	return s.GetCarrierCriterionContext(context.Background())
+}
+
+// GetCarrierCriterionContext is like GetCarrierCriterion but takes a context that controls the
+// request lifetime.
+func (s *ConstantDataService) GetCarrierCriterionContext(ctx context.Context) (carriers []CarrierCriterion, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		constantDataServiceUrl,
 		"getCarrierCriterion",
 		struct {
@@ -55,7 +70,14 @@ func (s *ConstantDataService) GetCarrierCriterion() (carriers []CarrierCriterion
 }
 
 func (s *ConstantDataService) GetGenderCriterion() (genders []GenderCriterion, err error) {
+	return s.GetGenderCriterionContext(context.Background())
+}
+
+// GetGenderCriterionContext is like GetGenderCriterion but takes a context that controls the
+// request lifetime.
+func (s *ConstantDataService) GetGenderCriterionContext(ctx context.Context) (genders []GenderCriterion, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		constantDataServiceUrl,
 		"getGenderCriterion",
 		struct {
@@ -76,7 +98,14 @@ func (s *ConstantDataService) GetGenderCriterion() (genders []GenderCriterion, e
 }
 
 func (s *ConstantDataService) GetLanguageCriterion() (languages []LanguageCriterion, err error) {
+	return s.GetLanguageCriterionContext(context.Background())
+}
+
+// GetLanguageCriterionContext is like GetLanguageCriterion but takes a context that controls the
+// request lifetime.
+func (s *ConstantDataService) GetLanguageCriterionContext(ctx context.Context) (languages []LanguageCriterion, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		constantDataServiceUrl,
 		"getLanguageCriterion",
 		struct {
@@ -97,7 +126,14 @@ func (s *ConstantDataService) GetLanguageCriterion() (languages []LanguageCriter
 }
 
 func (s *ConstantDataService) GetMobileDeviceCriterion() (mobileDevices []MobileDeviceCriterion, err error) {
+	return s.GetMobileDeviceCriterionContext(context.Background())
+}
+
+// GetMobileDeviceCriterionContext is like GetMobileDeviceCriterion but takes a context that controls the
+// request lifetime.
+func (s *ConstantDataService) GetMobileDeviceCriterionContext(ctx context.Context) (mobileDevices []MobileDeviceCriterion, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		constantDataServiceUrl,
 		"getMobileDeviceCriterion",
 		struct {
@@ -118,7 +154,14 @@ func (s *ConstantDataService) GetMobileDeviceCriterion() (mobileDevices []Mobile
 }
 
 func (s *ConstantDataService) GetOperatingSystemVersionCriterion() (operatingSystemVersions []OperatingSystemVersionCriterion, err error) {
+	return s.GetOperatingSystemVersionCriterionContext(context.Background())
+}
+
+// GetOperatingSystemVersionCriterionContext is like GetOperatingSystemVersionCriterion but takes a context that controls the
+// request lifetime.
+func (s *ConstantDataService) GetOperatingSystemVersionCriterionContext(ctx context.Context) (operatingSystemVersions []OperatingSystemVersionCriterion, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		constantDataServiceUrl,
 		"getOperatingSystemVersionCriterion",
 		struct {
@@ -139,9 +182,16 @@ func (s *ConstantDataService) GetOperatingSystemVersionCriterion() (operatingSys
 }
 
 func (s *ConstantDataService) GetProductBiddingCategoryCriterion(selector Selector) (categoryData []ProductBiddingCategoryData, err error) {
+	return s.GetProductBiddingCategoryCriterionContext(context.Background(), selector)
+}
+
+// GetProductBiddingCategoryCriterionContext is like GetProductBiddingCategoryCriterion but takes a context that controls the
+// request lifetime.
+func (s *ConstantDataService) GetProductBiddingCategoryCriterionContext(ctx context.Context, selector Selector) (categoryData []ProductBiddingCategoryData, err error) {
 	selector.XMLName = xml.Name{baseUrl, "selector"}
 
 	respBody, err := s.Auth.request(
+		ctx,
 		constantDataServiceUrl,
 		"getProductBiddingCategoryData",
 		struct {
@@ -169,7 +219,14 @@ func (s *ConstantDataService) GetProductBiddingCategoryCriterion(selector Select
 }
 
 func (s *ConstantDataService) GetUserInterestCriterion() (userInterests []UserInterestCriterion, err error) {
+	return s.GetUserInterestCriterionContext(context.Background())
+}
+
+// GetUserInterestCriterionContext is like GetUserInterestCriterion but takes a context that controls the
+// request lifetime.
+func (s *ConstantDataService) GetUserInterestCriterionContext(ctx context.Context) (userInterests []UserInterestCriterion, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		constantDataServiceUrl,
 		"getUserInterestCriterion",
 		struct {
@@ -190,7 +247,14 @@ func (s *ConstantDataService) GetUserInterestCriterion() (userInterests []UserIn
 }
 
 func (s *ConstantDataService) GetVerticalCriterion() (verticals []VerticalCriterion, err error) {
+	return s.GetVerticalCriterionContext(context.Background())
+}
+
+// GetVerticalCriterionContext is like GetVerticalCriterion but takes a context that controls the
+// request lifetime.
+func (s *ConstantDataService) GetVerticalCriterionContext(ctx context.Context) (verticals []VerticalCriterion, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		constantDataServiceUrl,
 		"getVerticalCriterion",
 		struct {
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -30,7 +31,14 @@ func NewCustomerService(auth *Auth) *CustomerService {
 }
 
 func (s *CustomerService) GetCustomers() (customers []Customer, err error) {
+	return s.GetCustomersContext(context.Background())
+}
+
+// GetCustomersContext is like GetCustomers but takes a context that controls the
+// request lifetime.
+func (s *CustomerService) GetCustomersContext(ctx context.Context) (customers []Customer, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		customerServiceUrl,
 		"getCustomers",
 		struct {
@@ -1,6 +1,9 @@
 package v201809
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 type CustomerSyncService struct {
 	Auth
@@ -50,9 +53,16 @@ type CustomerSyncSelector struct {
 }
 
 func (s *CustomerSyncService) Get(selector CustomerSyncSelector) (changeData CustomerChangeData, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *CustomerSyncService) GetContext(ctx context.Context, selector CustomerSyncSelector) (changeData CustomerChangeData, err error) {
 	selector.XMLName = xml.Name{baseSyncUrl, "selector"}
 
 	respBody, err := s.Auth.request(
+		ctx,
 		customerSyncServiceUrl,
 		"get",
 		struct {
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -82,12 +83,19 @@ type CriterionBidLandscape struct {
 //	   https://developers.google.com/adwords/api/docs/appendix/selectorfields#v201809-DataService
 //
 func (s *DataService) GetAdGroupBidLandscape(selector Selector) (adGroupBidLandscapes []AdGroupBidLandscape, totalCount int64, err error) {
+	return s.GetAdGroupBidLandscapeContext(context.Background(), selector)
+}
+
+// GetAdGroupBidLandscapeContext is like GetAdGroupBidLandscape but takes a context that controls the
+// request lifetime.
+func (s *DataService) GetAdGroupBidLandscapeContext(ctx context.Context, selector Selector) (adGroupBidLandscapes []AdGroupBidLandscape, totalCount int64, err error) {
 	// The default namespace, "", will break in 1.5 with the addition of
 	// custom namespace support.  Hence, we have to ensure that the baseUrl is
 	// set again as the proper namespace for the service/serviceSelector element
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 
 	respBody, err := s.Auth.request(
+		ctx,
 		dataServiceUrl,
 		"getAdGroupBidLandscape",
 		struct {
@@ -116,12 +124,19 @@ func (s *DataService) GetAdGroupBidLandscape(selector Selector) (adGroupBidLands
 }
 
 func (s *DataService) GetCampaignCriterionBidLandscape(selector Selector) (ret []CriterionBidLandscape, totalCount int64, err error) {
+	return s.GetCampaignCriterionBidLandscapeContext(context.Background(), selector)
+}
+
+// GetCampaignCriterionBidLandscapeContext is like GetCampaignCriterionBidLandscape but takes a context that controls the
+// request lifetime.
+func (s *DataService) GetCampaignCriterionBidLandscapeContext(ctx context.Context, selector Selector) (ret []CriterionBidLandscape, totalCount int64, err error) {
 	// The default namespace, "", will break in 1.5 with the addition of
 	// custom namespace support.  Hence, we have to ensure that the baseUrl is
 	// set again as the proper namespace for the service/serviceSelector element
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 
 	respBody, err := s.Auth.request(
+		ctx,
 		dataServiceUrl,
 		"getCampaignCriterionBidLandscape",
 		struct {
@@ -185,12 +200,19 @@ func (s *DataService) GetCampaignCriterionBidLandscape(selector Selector) (ret [
 //	   https://developers.google.com/adwords/api/docs/appendix/selectorfields#v201809-DataService
 //
 func (s *DataService) GetCriterionBidLandscape(selector Selector) (criterionBidLandscapes []CriterionBidLandscape, totalCount int64, err error) {
+	return s.GetCriterionBidLandscapeContext(context.Background(), selector)
+}
+
+// GetCriterionBidLandscapeContext is like GetCriterionBidLandscape but takes a context that controls the
+// request lifetime.
+func (s *DataService) GetCriterionBidLandscapeContext(ctx context.Context, selector Selector) (criterionBidLandscapes []CriterionBidLandscape, totalCount int64, err error) {
 	// The default namespace, "", will break in 1.5 with the addition of
 	// custom namespace support.  Hence, we have to ensure that the baseUrl is
 	// set again as the proper namespace for the service/serviceSelector element
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 
 	respBody, err := s.Auth.request(
+		ctx,
 		dataServiceUrl,
 		"getCriterionBidLandscape",
 		struct {
@@ -225,8 +247,15 @@ func (s *DataService) GetCriterionBidLandscape(selector Selector) (criterionBidL
 //     https://developers.google.com/adwords/api/docs/reference/v201809/DataService#queryadgroupbidlandscape
 //
 func (s *DataService) QueryAdGroupBidLandscape(query string) (adGroupBidLandscapes []AdGroupBidLandscape, totalCount int64, err error) {
+	return s.QueryAdGroupBidLandscapeContext(context.Background(), query)
+}
+
+// QueryAdGroupBidLandscapeContext is like QueryAdGroupBidLandscape but takes a context that controls the
+// request lifetime.
+func (s *DataService) QueryAdGroupBidLandscapeContext(ctx context.Context, query string) (adGroupBidLandscapes []AdGroupBidLandscape, totalCount int64, err error) {
 
 	respBody, err := s.Auth.request(
+		ctx,
 		dataServiceUrl,
 		"queryAdGroupBidLandscape",
 		AWQLQuery{
@@ -261,8 +290,15 @@ func (s *DataService) QueryAdGroupBidLandscape(query string) (adGroupBidLandscap
 //     https://developers.google.com/adwords/api/docs/reference/v201809/DataService#querycriterionbidlandscape
 //
 func (s *DataService) QueryCriterionBidLandscape(query string) (criterionBidLandscapes []CriterionBidLandscape, totalCount int64, err error) {
+	return s.QueryCriterionBidLandscapeContext(context.Background(), query)
+}
+
+// QueryCriterionBidLandscapeContext is like QueryCriterionBidLandscape but takes a context that controls the
+// request lifetime.
+func (s *DataService) QueryCriterionBidLandscapeContext(ctx context.Context, query string) (criterionBidLandscapes []CriterionBidLandscape, totalCount int64, err error) {
 
 	respBody, err := s.Auth.request(
+		ctx,
 		dataServiceUrl,
 		"queryCriterionBidLandscape",
 		AWQLQuery{
@@ -289,3 +325,60 @@ func (s *DataService) QueryCriterionBidLandscape(query string) (criterionBidLand
 	}
 	return getResp.CriterionBidLandscapes, getResp.Size, err
 }
+
+// AdGroupBidLandscapePages iterates over pages of ad group bid landscapes,
+// see Pager.
+type AdGroupBidLandscapePages struct {
+	*Pager
+}
+
+// AdGroupBidLandscapes returns the current page.
+func (p AdGroupBidLandscapePages) AdGroupBidLandscapes() []AdGroupBidLandscape {
+	return p.Page().([]AdGroupBidLandscape)
+}
+
+// AdGroupBidLandscapePages returns an iterator over the ad group bid
+// landscapes matching selector.  The page size counts landscape points.
+func (s *DataService) AdGroupBidLandscapePages(ctx context.Context, selector Selector, opts *PageOptions) AdGroupBidLandscapePages {
+	p := newPager(ctx, selector, opts, "", "", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetAdGroupBidLandscapeContext(ctx, selector)
+		return page, page, totalCount, err
+	})
+	p.pointPaging = true
+	return AdGroupBidLandscapePages{p}
+}
+
+// CriterionBidLandscapePages iterates over pages of criterion bid
+// landscapes, see Pager.
+type CriterionBidLandscapePages struct {
+	*Pager
+}
+
+// CriterionBidLandscapes returns the current page.
+func (p CriterionBidLandscapePages) CriterionBidLandscapes() []CriterionBidLandscape {
+	return p.Page().([]CriterionBidLandscape)
+}
+
+// CriterionBidLandscapePages returns an iterator over the ad group
+// criterion bid landscapes matching selector.  The page size counts
+// landscape points.
+func (s *DataService) CriterionBidLandscapePages(ctx context.Context, selector Selector, opts *PageOptions) CriterionBidLandscapePages {
+	p := newPager(ctx, selector, opts, "", "", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetCriterionBidLandscapeContext(ctx, selector)
+		return page, page, totalCount, err
+	})
+	p.pointPaging = true
+	return CriterionBidLandscapePages{p}
+}
+
+// CampaignCriterionBidLandscapePages returns an iterator over the campaign
+// criterion bid landscapes matching selector.  The page size counts
+// landscape points.
+func (s *DataService) CampaignCriterionBidLandscapePages(ctx context.Context, selector Selector, opts *PageOptions) CriterionBidLandscapePages {
+	p := newPager(ctx, selector, opts, "", "", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetCampaignCriterionBidLandscapeContext(ctx, selector)
+		return page, page, totalCount, err
+	})
+	p.pointPaging = true
+	return CriterionBidLandscapePages{p}
+}
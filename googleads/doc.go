@@ -7,9 +7,11 @@
 // The package is comprised of services used to manipulate various
 // adwords structures.  To access a service you need to create an
 // gads.Auth and parse it to the service initializer, then can call
-// the service methods on the service object.
+// the service methods on the service object.  LoadConfig reads the
+// credentials from the environment and from a gads JSON, googleads.yaml or
+// ads.properties file.
 //
-//     authConf, err := NewCredentials(context.TODO())
+//     authConf, err := gads.LoadConfig(gads.ConfigOptions{})
 //     campaignService := gads.NewCampaignService(&authConf.Auth)
 //
 //     campaigns, totalCount, err := cs.Get(
@@ -22,9 +24,43 @@
 //       },
 //     )
 //
+// Every service method also has a Context variant, e.g. GetContext, that
+// takes a context.Context.  Cancelling the context or letting its deadline
+// pass aborts the HTTP request, any pending retry and the OAuth2 token
+// refresh.
+//
+//     ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+//     defer cancel()
+//     campaigns, totalCount, err := cs.GetContext(ctx, selector)
+//
+// The client of an AuthConfig refreshes its OAuth2 token when it expires,
+// once for all the goroutines using it.  Configs loaded with
+// NewCredentialsFromFile write refreshed tokens back to their file, others
+// keep them in memory unless given a TokenStore:
+//
+//     authConf, err := gads.NewCredentialsFromParams(creds)
+//     err = authConf.SetTokenStore(gads.FileTokenStore{Path: "token.json"})
+//
+// Servers without a user at hand can authenticate with the JSON key of a
+// service account, impersonating a user of their domain:
+//
+//     authConf, err := gads.NewCredentialsFromServiceAccountFile(
+//       "key.json", "user@example.com", gads.Auth{DeveloperToken: "..."})
+//
+// The Mutate methods taking a map of operator to operands, e.g.
+// CampaignOperations, send the "ADD" operations first, then "SET", then
+// "REMOVE", then any other operator by name, each in slice order, so that
+// the index of an operation in a PartialFailureError is the same from run
+// to run.  The MutateOperations methods take a slice of operations instead
+// and send them in the order given, the index of an operation in a
+// PartialFailureError being its index in the slice.
+//
 // 1. http://www.google.com/adwords/myclientcenter/
 //
 // 2. https://developers.google.com/adwords/api/docs/signingup
 //
 // 3. https://developers.google.com/adwords/api/docs/guides/authentication
 package v201809
+
+//go:generate go run ../wsdlgen -known Selector -ns https://adwords.google.com/api/adwords/cm/v201809=baseUrl -o ad_param.go ../wsdlgen/wsdl/v201809/AdParamService.wsdl
+//go:generate go run ../wsdlgen -known Selector,Operator -ns https://adwords.google.com/api/adwords/cm/v201809=baseUrl -o ad_group_feed.go ../wsdlgen/wsdl/v201809/AdGroupFeedService.wsdl
@@ -0,0 +1,80 @@
+package v201809
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Endpoints redirects requests away from the production AdWords hosts, e.g.
+// to a local stand-in server, a recording proxy or a regional endpoint.
+// Only the transport URL changes, the XML namespaces of the requests keep
+// referring to https://adwords.google.com.
+//
+//	auth.Endpoints = &gads.Endpoints{Host: "http://127.0.0.1:8080"}
+type Endpoints struct {
+	// Host replaces the scheme and host of every endpoint.  A path, if
+	// present, is prepended to the path of the endpoint.
+	Host string `json:",omitempty"`
+
+	// Services maps a service name, e.g. "CampaignService", to the URL its
+	// requests are posted to.  It takes precedence over Host.
+	Services map[string]string `json:",omitempty"`
+
+	// ReportDownload is the URL reports are downloaded from.  It takes
+	// precedence over Host.
+	ReportDownload string `json:",omitempty"`
+}
+
+// URL returns the URL requests for serviceUrl are sent to.  The overrides
+// must be absolute http or https URLs: a malformed one is an error rather
+// than a fall back to the production hosts.
+func (e *Endpoints) URL(serviceUrl ServiceUrl) (string, error) {
+	if e == nil {
+		return serviceUrl.String(), nil
+	}
+	if serviceUrl.Name == "" && serviceUrl.Url == reportDownloadServiceUrl.Url {
+		if e.ReportDownload != "" {
+			_, err := parseEndpoint(e.ReportDownload)
+			return e.ReportDownload, err
+		}
+	} else if u, ok := e.Services[serviceUrl.Name]; ok {
+		_, err := parseEndpoint(u)
+		return u, err
+	}
+	if e.Host == "" {
+		return serviceUrl.String(), nil
+	}
+
+	host, err := parseEndpoint(e.Host)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(serviceUrl.String())
+	if err != nil {
+		return "", err
+	}
+	u.Scheme = host.Scheme
+	u.Host = host.Host
+	u.Path = strings.TrimSuffix(host.Path, "/") + u.Path
+	return u.String(), nil
+}
+
+// parseEndpoint parses an endpoint override, which must be an absolute
+// http or https URL with a host, e.g. http://127.0.0.1:8080 rather than
+// 127.0.0.1:8080.
+func parseEndpoint(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("gads: bad endpoint %q: %v", s, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("gads: bad endpoint %q: want an http or https URL with a host", s)
+	}
+	return u, nil
+}
+
+// endpoint returns the URL requests for serviceUrl are sent to.
+func (a *Auth) endpoint(serviceUrl ServiceUrl) (string, error) {
+	return a.Endpoints.URL(serviceUrl)
+}
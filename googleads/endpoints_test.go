@@ -0,0 +1,86 @@
+package v201809
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEndpointsURL(t *testing.T) {
+	e := &Endpoints{
+		Host:           "http://127.0.0.1:8080/proxy/",
+		Services:       map[string]string{"BudgetService": "http://budgets.local/soap"},
+		ReportDownload: "http://reports.local/download",
+	}
+	for _, tt := range []struct {
+		endpoints  *Endpoints
+		serviceUrl ServiceUrl
+		want       string
+	}{
+		{nil, campaignServiceUrl, "https://adwords.google.com/api/adwords/cm/v201809/CampaignService"},
+		{e, campaignServiceUrl, "http://127.0.0.1:8080/proxy/api/adwords/cm/v201809/CampaignService"},
+		{e, managedCustomerServiceUrl, "http://127.0.0.1:8080/proxy/api/adwords/mcm/v201809/ManagedCustomerService"},
+		{e, budgetServiceUrl, "http://budgets.local/soap"},
+		{e, reportDownloadServiceUrl, "http://reports.local/download"},
+		{&Endpoints{Host: "http://127.0.0.1:8080"}, reportDownloadServiceUrl, "http://127.0.0.1:8080/api/adwords/reportdownload/v201809"},
+	} {
+		if got, err := tt.endpoints.URL(tt.serviceUrl); err != nil || got != tt.want {
+			t.Errorf("%v: want %s, got %s %v", tt.serviceUrl, tt.want, got, err)
+		}
+	}
+}
+
+func TestEndpointsURLMalformed(t *testing.T) {
+	for _, e := range []*Endpoints{
+		{Host: "127.0.0.1:8080"},
+		{Host: "localhost:8080"},
+		{Host: "ftp://127.0.0.1"},
+		{Host: "http://[::1"},
+		{Host: "http:///path"},
+		{Services: map[string]string{"CampaignService": "campaigns.local"}},
+	} {
+		if got, err := e.URL(campaignServiceUrl); err == nil {
+			t.Errorf("%+v: want an error, got %s", e, got)
+		}
+	}
+	if _, err := (&Endpoints{ReportDownload: "localhost:8080"}).URL(reportDownloadServiceUrl); err == nil {
+		t.Error("want an error for a scheme-less report download URL")
+	}
+
+	var sent bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = true
+	}))
+	defer srv.Close()
+	auth := &Auth{Client: srv.Client(), Endpoints: &Endpoints{Host: strings.TrimPrefix(srv.URL, "http://")}, RetryPolicy: NoRetry}
+	if _, _, err := NewCampaignService(auth).Get(Selector{Fields: []string{"Id"}}); err == nil || !strings.Contains(err.Error(), "bad endpoint") {
+		t.Errorf("want a bad endpoint error, got %v", err)
+	}
+	if sent {
+		t.Error("request sent despite the bad endpoint")
+	}
+}
+
+func TestEndpointsKeepNamespaces(t *testing.T) {
+	var path, body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.Write([]byte(`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><getResponse><rval><totalNumEntries>0</totalNumEntries></rval></getResponse></soap:Body></soap:Envelope>`))
+	}))
+	defer srv.Close()
+
+	auth := &Auth{Client: srv.Client(), Endpoints: &Endpoints{Host: srv.URL}}
+	if _, _, err := NewCampaignService(auth).Get(Selector{Fields: []string{"Id"}}); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/api/adwords/cm/v201809/CampaignService" {
+		t.Errorf("unexpected path %s", path)
+	}
+	if !strings.Contains(body, `xmlns="https://adwords.google.com/api/adwords/cm/v201809"`) {
+		t.Errorf("namespace changed with the host:\n%s", body)
+	}
+}
@@ -2,8 +2,13 @@ package v201809
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type baseError struct {
@@ -27,6 +32,10 @@ func (b baseError) OrigErr() error {
 	return b.origErr
 }
 
+func (b baseError) Unwrap() error {
+	return b.origErr
+}
+
 type Error interface {
 	// Satisfy the generic error interface.
 	error
@@ -45,69 +54,482 @@ type OperationError struct {
 	Message   string `xml:"OperationError>Message"`
 }
 
+// EntityError holds the fields every ApiError returned by the API has.  It
+// is embedded in the typed errors below and is what errors of a type this
+// package does not know decode to.
 type EntityError struct {
-	FieldPath   string `xml:"fieldPath"`
-	Trigger     string `xml:"trigger"`
-	ErrorString string `xml:"errorString"`
-	Reason      string `xml:"reason"`
+	FieldPath         string             `xml:"fieldPath"`
+	FieldPathElements []FieldPathElement `xml:"fieldPathElements"`
+	Trigger           string             `xml:"trigger"`
+	ErrorString       string             `xml:"errorString"`
+	// Type is the xsi:type of the error, e.g. "BudgetError".
+	Type   string `xml:"ApiError.Type"`
+	Reason string `xml:"reason"`
+}
+
+// A FieldPathElement is one part of the field path of an error, e.g.
+// operations[1] is {Field: "operations", Index: 1}.
+type FieldPathElement struct {
+	Field string `xml:"field"`
+	Index *int   `xml:"index"`
+}
+
+func (e EntityError) Error() string {
+	if e.FieldPath == "" {
+		return e.ErrorString
+	}
+	return fmt.Sprintf("%s @ %s", e.ErrorString, e.FieldPath)
+}
+
+// Entity returns the fields common to all ApiErrors.
+func (e EntityError) Entity() EntityError {
+	return e
+}
+
+// Is reports whether target is an ApiErrorReason matching the type and
+// reason of e.
+func (e EntityError) Is(target error) bool {
+	r, ok := target.(ApiErrorReason)
+	return ok && r.Type == e.Type && (r.Reason == "" || r.Reason == e.Reason)
+}
+
+// TypedApiError is implemented by all the errors decoded from the ApiErrors
+// of a fault or of a partial failure.
+type TypedApiError interface {
+	error
+	// Entity returns the fields common to all ApiErrors.
+	Entity() EntityError
+}
+
+// ApiErrorReason matches ApiErrors by type and reason with errors.Is:
+//
+//	if errors.Is(err, gads.ApiErrorReason{Type: "BudgetError", Reason: "DUPLICATE_NAME"}) {
+//
+// An empty Reason matches every reason of Type.
+type ApiErrorReason struct {
+	Type   string
+	Reason string
+}
+
+func (r ApiErrorReason) Error() string {
+	if r.Reason == "" {
+		return r.Type
+	}
+	return r.Type + "." + r.Reason
+}
+
+type AdCustomizerError struct {
+	EntityError
+}
+
+type AdError struct {
+	EntityError
+}
+
+type AdGroupAdError struct {
+	EntityError
+}
+
+type AdGroupCriterionError struct {
+	EntityError
+}
+
+type AdGroupServiceError struct {
+	EntityError
+}
+
+type AdParamError struct {
+	EntityError
+}
+
+type AdSharingError struct {
+	EntityError
+}
+
+type AdxError struct {
+	EntityError
+}
+
+type AssetError struct {
+	EntityError
+}
+
+type AssetLinkError struct {
+	EntityError
+}
+
+type AuthenticationError struct {
+	EntityError
+}
+
+type AuthorizationError struct {
+	EntityError
+}
+
+type BatchJobError struct {
+	EntityError
+}
+
+type BetaError struct {
+	EntityError
+}
+
+type BiddingErrors struct {
+	EntityError
+}
+
+type BiddingStrategyError struct {
+	EntityError
 }
 
 type BudgetError struct {
 	EntityError
 }
 
+type CampaignCriterionError struct {
+	EntityError
+}
+
+type CampaignError struct {
+	EntityError
+}
+
+type CampaignFeedError struct {
+	EntityError
+}
+
+type CampaignPreferenceError struct {
+	EntityError
+}
+
+type CampaignSharedSetError struct {
+	EntityError
+}
+
+type ClientTermsError struct {
+	EntityError
+}
+
+type CollectionSizeError struct {
+	EntityError
+}
+
+type ConversionTrackingError struct {
+	EntityError
+}
+
 type CriterionError struct {
 	EntityError
 }
 
-type TargetError struct {
-	FieldPath   string `xml:"fieldPath"`
-	Trigger     string `xml:"trigger"`
-	ErrorString string `xml:"errorString"`
-	Reason      string `xml:"reason"`
+type CustomerSyncError struct {
+	EntityError
 }
 
-type AdGroupServiceError struct {
-	FieldPath   string `xml:"fieldPath"`
-	Trigger     string `xml:"trigger"`
-	ErrorString string `xml:"errorString"`
-	Reason      string `xml:"reason"`
+// DatabaseError is returned on database level failures such as
+// CONCURRENT_MODIFICATION.  The call can usually be retried.
+type DatabaseError struct {
+	EntityError
 }
 
-type NotEmptyError struct {
-	FieldPath   string `xml:"fieldPath"`
-	Trigger     string `xml:"trigger"`
-	ErrorString string `xml:"errorString"`
-	Reason      string `xml:"reason"`
+type DateError struct {
+	EntityError
 }
 
-type AdError struct {
-	FieldPath   string `xml:"fieldPath"`
-	Trigger     string `xml:"trigger"`
-	ErrorString string `xml:"errorString"`
-	Reason      string `xml:"reason"`
+type DateRangeError struct {
+	EntityError
+}
+
+type DistinctError struct {
+	EntityError
+}
+
+type EntityAccessDenied struct {
+	EntityError
+}
+
+// EntityCountLimitExceeded is returned when an operation would exceed the
+// number of entities allowed under EnclosingId.
+type EntityCountLimitExceeded struct {
+	EntityError
+	EnclosingId      string `xml:"enclosingId"`
+	Limit            int    `xml:"limit"`
+	AccountLimitType string `xml:"accountLimitType"`
+	ExistingCount    int    `xml:"existingCount"`
+}
+
+type AdGroupAdCountLimitExceeded struct {
+	EntityCountLimitExceeded
+}
+
+type EntityNotFound struct {
+	EntityError
+}
+
+type ExtensionSettingError struct {
+	EntityError
+}
+
+type FeedAttributeReferenceError struct {
+	EntityError
+	FeedAttributeName string `xml:"feedAttributeName"`
+}
+
+type FeedError struct {
+	EntityError
+}
+
+type FeedItemError struct {
+	EntityError
+}
+
+type FeedMappingError struct {
+	EntityError
+}
+
+type ForwardCompatibilityError struct {
+	EntityError
+}
+
+type FunctionError struct {
+	EntityError
+}
+
+type FunctionParsingError struct {
+	EntityError
+	OffendingText      string `xml:"offendingText"`
+	OffendingTextIndex int    `xml:"offendingTextIndex"`
+}
+
+type IdError struct {
+	EntityError
+}
+
+type ImageError struct {
+	EntityError
+}
+
+// InternalApiError is returned when the API hit an unexpected internal
+// problem.  The call can usually be retried.
+type InternalApiError struct {
+	EntityError
 }
 
 type LabelError struct {
-	FieldPath   string `xml:"fieldPath"`
-	Trigger     string `xml:"trigger"`
-	ErrorString string `xml:"errorString"`
-	Reason      string `xml:"reason"`
+	EntityError
 }
 
-type UrlError struct {
+type ManagedCustomerServiceError struct {
 	EntityError
 }
 
-// if you exceed the quota given by google
+type MediaBundleError struct {
+	EntityError
+}
+
+type MediaError struct {
+	EntityError
+}
+
+type MultiplierError struct {
+	EntityError
+}
+
+type NewEntityCreationError struct {
+	EntityError
+}
+
+type NotEmptyError struct {
+	EntityError
+}
+
+type NullError struct {
+	EntityError
+}
+
+type OperationAccessDenied struct {
+	EntityError
+}
+
+type OperatorError struct {
+	EntityError
+}
+
+type PagingError struct {
+	EntityError
+}
+
+type PolicyFindingError struct {
+	EntityError
+	PolicyName        string `xml:"policyName"`
+	PolicyDescription string `xml:"policyDescription"`
+}
+
+// PolicyViolationKey identifies the policy and text an ad or keyword was
+// rejected for.
+type PolicyViolationKey struct {
+	PolicyName    string `xml:"policyName"`
+	ViolatingText string `xml:"violatingText"`
+}
+
+// A PolicyViolationPart locates the violating text in the field of the
+// error.
+type PolicyViolationPart struct {
+	Index  int `xml:"index"`
+	Length int `xml:"length"`
+}
+
+// PolicyViolationError is returned when an ad or keyword violates an
+// advertising policy.  When IsExemptable, sending the operation again with
+// the ExemptionRequest of the error in the ExemptionRequests of its
+// AdGroupAdOperation or AdGroupCriterionOperation asks for an exemption.
+type PolicyViolationError struct {
+	EntityError
+	Key                       PolicyViolationKey    `xml:"key"`
+	ExternalPolicyName        string                `xml:"externalPolicyName"`
+	ExternalPolicyUrl         string                `xml:"externalPolicyUrl"`
+	ExternalPolicyDescription string                `xml:"externalPolicyDescription"`
+	IsExemptable              bool                  `xml:"isExemptable"`
+	ViolatingParts            []PolicyViolationPart `xml:"violatingParts"`
+}
+
+// An ExemptionRequest asks for an exemption from the policy violation
+// identified by Key.
+type ExemptionRequest struct {
+	Key PolicyViolationKey `xml:"key"`
+}
+
+// ExemptionRequest returns the request exempting the operation from e, or
+// false if the violation is not exemptable.
+func (e PolicyViolationError) ExemptionRequest() (ExemptionRequest, bool) {
+	return ExemptionRequest{Key: e.Key}, e.IsExemptable
+}
+
+type QueryError struct {
+	EntityError
+	Message string `xml:"message"`
+}
+
+// QuotaCheckError is returned when the developer token may not make the
+// call, e.g. because it is not approved for production accounts.
+type QuotaCheckError struct {
+	EntityError
+}
+
+type RangeError struct {
+	EntityError
+}
+
+// RateExceededError is returned when you exceed the quota given by google.
+// The call can be retried after RetryAfterSeconds.
 type RateExceededError struct {
-	RateName          string `xml:"rateName"`  // For example OperationsByMinute
-	RateScope         string `xml:"rateScope"` // ACCOUNT or DEVELOPER
-	ErrorString       string `xml:"errorString"`
-	Reason            string `xml:"reason"`
+	EntityError
+	RateName          string `xml:"rateName"`          // For example OperationsByMinute
+	RateScope         string `xml:"rateScope"`         // ACCOUNT or DEVELOPER
 	RetryAfterSeconds uint   `xml:"retryAfterSeconds"` // Try again in...
 }
 
+func (e RateExceededError) Error() string {
+	return fmt.Sprintf("%s (%s, retry after %ds)", e.ErrorString, e.RateName, e.RetryAfterSeconds)
+}
+
+type ReadOnlyError struct {
+	EntityError
+}
+
+type RegionCodeError struct {
+	EntityError
+}
+
+type RejectedError struct {
+	EntityError
+}
+
+type RequestError struct {
+	EntityError
+}
+
+type RequiredError struct {
+	EntityError
+}
+
+type SelectorError struct {
+	EntityError
+}
+
+type SettingError struct {
+	EntityError
+}
+
+type SharedCriterionError struct {
+	EntityError
+}
+
+type SharedSetError struct {
+	EntityError
+}
+
+type SizeLimitError struct {
+	EntityError
+}
+
+type StatsQueryError struct {
+	EntityError
+}
+
+type StringFormatError struct {
+	EntityError
+}
+
+type StringLengthError struct {
+	EntityError
+}
+
+type TargetError struct {
+	EntityError
+}
+
+type UrlError struct {
+	EntityError
+}
+
+type UserListError struct {
+	EntityError
+}
+
+// apiErrorTypes maps the xsi:type of ApiErrors to the Go types they decode
+// to, which carry the same name.
+var apiErrorTypes = registerApiErrors(
+	AdCustomizerError{}, AdError{}, AdGroupAdCountLimitExceeded{}, AdGroupAdError{},
+	AdGroupCriterionError{}, AdGroupServiceError{}, AdParamError{}, AdSharingError{},
+	AdxError{}, AssetError{}, AssetLinkError{}, AuthenticationError{}, AuthorizationError{},
+	BatchJobError{}, BetaError{}, BiddingErrors{}, BiddingStrategyError{}, BudgetError{},
+	CampaignCriterionError{}, CampaignError{}, CampaignFeedError{}, CampaignPreferenceError{},
+	CampaignSharedSetError{}, ClientTermsError{}, CollectionSizeError{},
+	ConversionTrackingError{}, CriterionError{}, CustomerSyncError{}, DatabaseError{},
+	DateError{}, DateRangeError{}, DistinctError{}, EntityAccessDenied{},
+	EntityCountLimitExceeded{}, EntityNotFound{}, ExtensionSettingError{},
+	FeedAttributeReferenceError{}, FeedError{}, FeedItemError{}, FeedMappingError{},
+	ForwardCompatibilityError{}, FunctionError{}, FunctionParsingError{}, IdError{},
+	ImageError{}, InternalApiError{}, LabelError{}, ManagedCustomerServiceError{},
+	MediaBundleError{}, MediaError{}, MultiplierError{}, NewEntityCreationError{},
+	NotEmptyError{}, NullError{}, OperationAccessDenied{}, OperatorError{}, PagingError{},
+	PolicyFindingError{}, PolicyViolationError{}, QueryError{}, QuotaCheckError{},
+	RangeError{}, RateExceededError{}, ReadOnlyError{}, RegionCodeError{}, RejectedError{},
+	RequestError{}, RequiredError{}, SelectorError{}, SettingError{}, SharedCriterionError{},
+	SharedSetError{}, SizeLimitError{}, StatsQueryError{}, StringFormatError{},
+	StringLengthError{}, TargetError{}, UrlError{}, UserListError{},
+)
+
+func registerApiErrors(errs ...TypedApiError) map[string]reflect.Type {
+	types := make(map[string]reflect.Type, len(errs))
+	for _, e := range errs {
+		t := reflect.TypeOf(e)
+		types[t.Name()] = t
+	}
+	return types
+}
+
 type ApiExceptionFault struct {
 	Message    string `xml:"message"`
 	Type       string `xml:"ApplicationException.Type"`
@@ -132,43 +554,119 @@ func (aes *ApiExceptionFault) UnmarshalXML(dec *xml.Decoder, start xml.StartElem
 			case "errors":
 				errorType, _ := findAttr(start.Attr, xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"})
 				aes.ErrorsType = errorType
-
-				switch errorType {
-				case "RateExceededError":
-					e := RateExceededError{}
-					dec.DecodeElement(&e, &start)
-					aes.Errors = append(aes.Errors, e)
-					aes.Reason = e.Reason
-
-				case "AuthenticationError", "DatabaseError", "InternalApiError":
-					e := EntityError{}
-					if err := dec.DecodeElement(&e, &start); err != nil {
-						return fmt.Errorf("Unknown error type -> %s", start)
-					}
-					aes.Errors = append(aes.Errors, e)
-					aes.Reason = e.Reason
-
-				default:
-					e := EntityError{}
-					if err := dec.DecodeElement(&e, &start); err != nil {
-						return fmt.Errorf("Unknown error type -> %s", start)
-					}
-					aes.Errors = append(aes.Errors, e)
+				e, reason, err := decodeApiError(dec, start)
+				if err != nil {
+					return err
 				}
-			case "reason":
-				break
+				aes.Errors = append(aes.Errors, e)
+				aes.Reason = reason
 			default:
-				return fmt.Errorf("Unknown error field -> %s", start)
+				// newer versions of the API may add fields
+				if err := dec.Skip(); err != nil {
+					return err
+				}
 			}
 		}
 	}
 	return err
 }
 
+// decodeApiError decodes an ApiError element into the type named by its
+// xsi:type, or an EntityError if the type is unknown, and returns it along
+// with its reason.
+func decodeApiError(dec *xml.Decoder, start xml.StartElement) (e error, reason string, err error) {
+	errorType, _ := findAttr(start.Attr, xml.Name{Space: "http://www.w3.org/2001/XMLSchema-instance", Local: "type"})
+	t, ok := apiErrorTypes[errorType]
+	if !ok {
+		t = reflect.TypeOf(EntityError{})
+	}
+	v := reflect.New(t)
+	if err := dec.DecodeElement(v.Interface(), &start); err != nil {
+		return nil, "", fmt.Errorf("Unknown error type -> %s: %v", errorType, err)
+	}
+	// the type is also in ApiError.Type, but not in partial failure errors
+	entity := v.Elem()
+	if ok {
+		entity = entity.FieldByName("EntityError")
+	}
+	if typ := entity.FieldByName("Type"); typ.String() == "" {
+		typ.SetString(errorType)
+	}
+	apiErr := v.Elem().Interface().(TypedApiError)
+	return apiErr, apiErr.Entity().Reason, nil
+}
+
+// apiErrorsOf returns the ApiErrors carried by err, either in a fault or as
+// a partial failure.
+func apiErrorsOf(err error) (errs []error) {
+	var faults *ErrorsType
+	if errors.As(err, &faults) {
+		errs = faults.apiErrors()
+	}
+	var pf *PartialFailureError
+	if errors.As(err, &pf) {
+		errs = append(errs, pf.Errors...)
+	}
+	return errs
+}
+
+// ExemptionRequests returns the exemption requests for the exemptable
+// PolicyViolationErrors in err, by index of the operation they were
+// returned for.
+func ExemptionRequests(err error) map[int][]ExemptionRequest {
+	var requests map[int][]ExemptionRequest
+	for _, e := range apiErrorsOf(err) {
+		pv, ok := e.(PolicyViolationError)
+		if !ok {
+			continue
+		}
+		if req, ok := pv.ExemptionRequest(); ok {
+			if requests == nil {
+				requests = map[int][]ExemptionRequest{}
+			}
+			i := operationIndex(e)
+			requests[i] = append(requests[i], req)
+		}
+	}
+	return requests
+}
+
 type ErrorsType struct {
 	ApiExceptionFaults []ApiExceptionFault `xml:"ApiExceptionFault"`
 }
 
+func (f ErrorsType) apiErrors() (errs []error) {
+	for _, fault := range f.ApiExceptionFaults {
+		for _, e := range fault.Errors {
+			if e, ok := e.(error); ok {
+				errs = append(errs, e)
+			}
+		}
+	}
+	return errs
+}
+
+// Is reports whether one of the ApiErrors of f matches target.
+func (f ErrorsType) Is(target error) bool {
+	for _, e := range f.apiErrors() {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first ApiError of f that matches target, so that
+// errors.As(err, &budgetErr) works on the errors returned by calls.
+func (f ErrorsType) As(target interface{}) bool {
+	for _, e := range f.apiErrors() {
+		if errors.As(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
 func (f ErrorsType) Error() string {
 	errors := []string{}
 	for _, e := range f.ApiExceptionFaults {
@@ -177,6 +675,38 @@ func (f ErrorsType) Error() string {
 	return strings.Join(errors, "\n")
 }
 
+// HTTPError is returned when the server answers with a status code and a
+// body that is not a SOAP envelope, e.g. a 503 from a load balancer.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+	// RetryAfter is the delay requested by the Retry-After header, if any.
+	RetryAfter time.Duration
+	Body       []byte
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("unexpected HTTP status %s", e.Status)
+}
+
+func newHTTPError(statusCode int, header http.Header, body []byte) *HTTPError {
+	e := &HTTPError{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Body:       body,
+	}
+	if secs, err := strconv.Atoi(header.Get("Retry-After")); err == nil {
+		e.RetryAfter = time.Duration(secs) * time.Second
+	}
+	return e
+}
+
+// isTransientStatus reports whether an HTTP status code signals a temporary
+// condition on the server side.
+func isTransientStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
 type Fault struct {
 	XMLName     xml.Name   `xml:"Fault"`
 	FaultCode   string     `xml:"faultcode"`
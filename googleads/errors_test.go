@@ -0,0 +1,156 @@
+package v201809
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+const policyViolationFault = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <soap:Fault>
+      <faultcode>soap:Server</faultcode>
+      <faultstring>[PolicyViolationError.POLICY_ERROR @ operations[1].operand.criterion.text]</faultstring>
+      <detail>
+        <ApiExceptionFault xmlns="https://adwords.google.com/api/adwords/cm/v201809">
+          <message>[PolicyViolationError.POLICY_ERROR @ operations[1].operand.criterion.text]</message>
+          <ApplicationException.Type>ApiException</ApplicationException.Type>
+          <errors xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="PolicyViolationError">
+            <fieldPath>operations[1].operand.criterion.text</fieldPath>
+            <fieldPathElements><field>operations</field><index>1</index></fieldPathElements>
+            <fieldPathElements><field>operand</field></fieldPathElements>
+            <trigger></trigger>
+            <errorString>PolicyViolationError.POLICY_ERROR</errorString>
+            <ApiError.Type>PolicyViolationError</ApiError.Type>
+            <key><policyName>pharmacy</policyName><violatingText>pills</violatingText></key>
+            <externalPolicyName>Healthcare and medicines</externalPolicyName>
+            <isExemptable>true</isExemptable>
+            <violatingParts><index>0</index><length>5</length></violatingParts>
+            <someFutureField>ignored</someFutureField>
+          </errors>
+          <errors xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="BrandNewError">
+            <fieldPath>operations[0]</fieldPath>
+            <errorString>BrandNewError.UNKNOWN</errorString>
+            <reason>UNKNOWN</reason>
+          </errors>
+          <someFutureElement><nested>1</nested></someFutureElement>
+        </ApiExceptionFault>
+      </detail>
+    </soap:Fault>
+  </soap:Body>
+</soap:Envelope>`
+
+func TestDecodeTypedApiErrors(t *testing.T) {
+	auth, _ := cannedAuth(cannedResponse{500, policyViolationFault})
+	auth.RetryPolicy = NoRetry
+
+	_, err := NewAdGroupCriterionService(auth).MutateOperations(nil)
+	var pv PolicyViolationError
+	if !errors.As(err, &pv) {
+		t.Fatalf("want a PolicyViolationError, got %#v", err)
+	}
+	if pv.Type != "PolicyViolationError" || pv.Key.PolicyName != "pharmacy" || len(pv.ViolatingParts) != 1 || pv.ViolatingParts[0].Length != 5 {
+		t.Errorf("unexpected error %#v", pv)
+	}
+	if len(pv.FieldPathElements) != 2 || *pv.FieldPathElements[0].Index != 1 || pv.FieldPathElements[1].Index != nil {
+		t.Errorf("unexpected field path %#v", pv.FieldPathElements)
+	}
+
+	var unknown EntityError
+	if !errors.As(err, &unknown) || unknown.Type != "BrandNewError" || unknown.Reason != "UNKNOWN" {
+		t.Errorf("want the unknown error as an EntityError, got %#v", unknown)
+	}
+	if !errors.Is(err, ApiErrorReason{Type: "BrandNewError", Reason: "UNKNOWN"}) || !errors.Is(err, ApiErrorReason{Type: "PolicyViolationError"}) {
+		t.Error("errors.Is does not match the fault errors")
+	}
+	if errors.Is(err, ApiErrorReason{Type: "BudgetError"}) {
+		t.Error("errors.Is matches a BudgetError")
+	}
+
+	requests := ExemptionRequests(err)
+	if len(requests) != 1 || len(requests[1]) != 1 || requests[1][0].Key.ViolatingText != "pills" {
+		t.Errorf("unexpected exemption requests %#v", requests)
+	}
+}
+
+func TestResendExemptionRequests(t *testing.T) {
+	auth, _ := cannedAuth(cannedResponse{500, policyViolationFault})
+	auth.RetryPolicy = NoRetry
+	_, err := NewAdGroupCriterionService(auth).MutateOperations(nil)
+	requests := ExemptionRequests(err)[1]
+
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	srv.Respond("AdGroupCriterionService", "mutate", gadstest.Rval("mutate", ""))
+	srv.Respond("AdGroupAdService", "mutate", gadstest.Rval("mutate", ""))
+	keyword := BiddableAdGroupCriterion{AdGroupId: 1, Criterion: KeywordCriterion{Text: "pills", MatchType: "EXACT"}}
+	if _, err := NewAdGroupCriterionService(simulatorAuth(srv)).MutateOperations([]AdGroupCriterionOperation{
+		{Action: "ADD", AdGroupCriterion: keyword},
+		{Action: "ADD", AdGroupCriterion: keyword, ExemptionRequests: requests},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewAdGroupAdService(simulatorAuth(srv)).MutateOperations([]AdGroupAdOperation{
+		{Action: "ADD", AdGroupAd: ExpandedTextAd{AdGroupId: 1, HeadlinePart1: "pills"}, ExemptionRequests: requests},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var sent struct {
+		Operations []struct {
+			Operator          string             `xml:"operator"`
+			ExemptionRequests []ExemptionRequest `xml:"exemptionRequests"`
+		} `xml:"operations"`
+	}
+	req := srv.RequestsFor("AdGroupCriterionService", "mutate")[0]
+	if err := req.Decode(&sent); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent.Operations) != 2 || len(sent.Operations[0].ExemptionRequests) != 0 ||
+		!reflect.DeepEqual(sent.Operations[1].ExemptionRequests, requests) {
+		t.Errorf("unexpected operations %+v in %s", sent.Operations, req.Body)
+	}
+	req = srv.RequestsFor("AdGroupAdService", "mutate")[0]
+	sent.Operations = nil
+	if err := req.Decode(&sent); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent.Operations) != 1 || !reflect.DeepEqual(sent.Operations[0].ExemptionRequests, requests) {
+		t.Errorf("unexpected operations %+v in %s", sent.Operations, req.Body)
+	}
+}
+
+func TestTypedPartialFailureErrors(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	gadstest.NewSimulator(srv)
+	srv.Handle("BudgetService", "mutate", func(req *gadstest.Request) (string, error) {
+		return "", &gadstest.Fault{Status: http.StatusInternalServerError, Errors: []gadstest.ApiError{{
+			Type:      "BudgetError",
+			Reason:    "DUPLICATE_NAME",
+			FieldPath: "operations[0].operand.name",
+		}}}
+	})
+
+	_, err := NewBudgetService(simulatorAuth(srv)).Mutate(BudgetOperations{"ADD": {{Name: "b", Amount: 1}}})
+	var budgetErr BudgetError
+	if !errors.As(err, &budgetErr) || budgetErr.Reason != "DUPLICATE_NAME" {
+		t.Fatalf("want a BudgetError, got %#v", err)
+	}
+	if !strings.Contains(err.Error(), "BudgetError.DUPLICATE_NAME") {
+		t.Errorf("unexpected message %q", err)
+	}
+
+	_, err = NewAdGroupService(simulatorAuth(srv)).Mutate(AdGroupOperations{"ADD": {{CampaignId: 1, Name: "ag"}}})
+	var notFound EntityNotFound
+	if !errors.As(err, &notFound) || notFound.FieldPath != "operations[0].operand.campaignId" {
+		t.Fatalf("want an EntityNotFound, got %#v", err)
+	}
+	if !errors.Is(err, ApiErrorReason{Type: "EntityNotFound", Reason: "INVALID_ID"}) {
+		t.Error("errors.Is does not match the partial failure")
+	}
+}
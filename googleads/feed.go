@@ -1,6 +1,9 @@
 package v201809
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 type FeedService struct {
 	Auth
@@ -12,7 +15,14 @@ func NewFeedService(auth *Auth) *FeedService {
 
 // https://developers.google.com/adwords/api/docs/reference/v201809/FeedService
 func (s *FeedService) Query(query string) (page []Feed, totalCount int64, err error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *FeedService) QueryContext(ctx context.Context, query string) (page []Feed, totalCount int64, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		feedServiceUrl,
 		"query",
 		AWQLQuery{
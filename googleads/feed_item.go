@@ -22,7 +22,7 @@ type FeedItemDevicePreference struct {
 // Represents a collection of FeedItem schedules specifying all time intervals for which the feed item may serve.
 // Any time range not covered by the specified FeedItemSchedules will prevent the feed item from serving during those times.
 type FeedItemScheduling struct {
-	feedItemSchedules int64 `xml:"https://adwords.google.com/api/adwords/cm/v201809 feedItemSchedules,omitempty"`
+	FeedItemSchedules []FeedItemSchedule `xml:"https://adwords.google.com/api/adwords/cm/v201809 feedItemSchedules,omitempty"`
 }
 
 // https://developers.google.com/adwords/api/docs/reference/v201809/AdGroupExtensionSettingService.FeedItemSchedule
@@ -0,0 +1,67 @@
+// Package gadsmock provides in-memory fakes of the gads services, for
+// testing code written against the Servicer interfaces without a server.
+//
+// Each fake records its calls and answers them with its Func fields, or
+// with zero values when they are nil:
+//
+//	campaigns := &gadsmock.CampaignService{
+//		GetFunc: func(selector gads.Selector) ([]gads.Campaign, int64, error) {
+//			return []gads.Campaign{{Id: 1, Name: "c"}}, 1, nil
+//		},
+//	}
+//	report(campaigns) // takes a gads.CampaignServicer
+//	if calls := campaigns.CallsTo("Get"); len(calls) != 1 {
+//		t.Errorf("want one Get, got %v", calls)
+//	}
+//
+// The fakes are generated from the interfaces of the gads package.
+package gadsmock
+
+//go:generate go run gen.go
+
+import "sync"
+
+// A Call is a call made to a fake.
+type Call struct {
+	// Method is the name of the method called, e.g. "MutateOperations".
+	Method string
+	// Args holds the arguments of the call.
+	Args []interface{}
+}
+
+// Recorder records the calls made to a fake.  It is embedded in every fake
+// and safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls made so far, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made so far to method.
+func (r *Recorder) CallsTo(method string) (calls []Call) {
+	for _, c := range r.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls made so far.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
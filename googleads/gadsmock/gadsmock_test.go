@@ -0,0 +1,51 @@
+package gadsmock
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	gads "github.com/denton/gads/googleads"
+)
+
+func TestCampaignService(t *testing.T) {
+	fake := &CampaignService{
+		MutateOperationsFunc: func(ops []gads.CampaignOperation) ([]gads.Campaign, error) {
+			return []gads.Campaign{{Id: 1, Name: ops[0].Campaign.Name}}, nil
+		},
+	}
+	var campaigns gads.CampaignServicer = fake
+
+	ops := []gads.CampaignOperation{{Action: "ADD", Campaign: gads.Campaign{Name: "c"}}}
+	got, err := campaigns.MutateOperations(ops)
+	if err != nil || len(got) != 1 || got[0].Name != "c" {
+		t.Errorf("unexpected result %#v %v", got, err)
+	}
+	if got, total, err := campaigns.GetContext(context.Background(), gads.Selector{}); got != nil || total != 0 || err != nil {
+		t.Errorf("want zero values, got %#v %d %v", got, total, err)
+	}
+
+	want := []Call{
+		{Method: "MutateOperations", Args: []interface{}{ops}},
+		{Method: "GetContext", Args: []interface{}{context.Background(), gads.Selector{}}},
+	}
+	if !reflect.DeepEqual(fake.Calls(), want) {
+		t.Errorf("want calls %#v, got %#v", want, fake.Calls())
+	}
+	if calls := fake.CallsTo("GetContext"); len(calls) != 1 {
+		t.Errorf("want one GetContext, got %#v", calls)
+	}
+	fake.Reset()
+	if fake.Calls() != nil {
+		t.Errorf("calls left after Reset: %#v", fake.Calls())
+	}
+
+	budgets := &BudgetService{}
+	budgets.MutateFunc = func(gads.BudgetOperations) ([]gads.Budget, error) {
+		return nil, errors.New("scripted")
+	}
+	if _, err := budgets.Mutate(gads.BudgetOperations{}); err == nil || err.Error() != "scripted" {
+		t.Errorf("want the scripted error, got %v", err)
+	}
+}
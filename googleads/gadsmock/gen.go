@@ -0,0 +1,183 @@
+//go:build ignore
+// +build ignore
+
+// gen writes mocks.go, a fake of each Servicer interface of
+// ../services.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "../services.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	imports := map[string]bool{}
+	var assertions []string
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !strings.HasSuffix(ts.Name.Name, "Servicer") {
+				continue
+			}
+			writeFake(&b, fset, ts.Name.Name, it, imports)
+			assertions = append(assertions, fmt.Sprintf("_ gads.%s = (*%s)(nil)", ts.Name.Name, strings.TrimSuffix(ts.Name.Name, "r")))
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by gen.go from ../services.go; DO NOT EDIT.\n\npackage gadsmock\n\nimport (\n")
+	for _, path := range []string{"context", "io"} {
+		if imports[path] {
+			fmt.Fprintf(&out, "\t%q\n", path)
+		}
+	}
+	fmt.Fprintf(&out, "\n\tgads %q\n)\n\n", "github.com/denton/gads/googleads")
+	fmt.Fprintf(&out, "var (\n\t%s\n)\n\n", strings.Join(assertions, "\n\t"))
+	out.Write(b.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("%v\n%s", err, out.Bytes())
+	}
+	if err := ioutil.WriteFile("mocks.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeFake writes the fake of the interface name.
+func writeFake(b *bytes.Buffer, fset *token.FileSet, name string, it *ast.InterfaceType, imports map[string]bool) {
+	fake := strings.TrimSuffix(name, "r")
+	fmt.Fprintf(b, "// %s is a fake gads.%s.\ntype %s struct {\n\tRecorder\n\n", fake, name, fake)
+	for _, m := range it.Methods.List {
+		fmt.Fprintf(b, "\t%sFunc func%s\n", m.Names[0].Name, signature(fset, m.Type.(*ast.FuncType), imports, false))
+	}
+	fmt.Fprintf(b, "}\n\n")
+
+	for _, m := range it.Methods.List {
+		method := m.Names[0].Name
+		ft := m.Type.(*ast.FuncType)
+		var args []string
+		for i, p := range ft.Params.List {
+			if len(p.Names) == 0 {
+				args = append(args, fmt.Sprintf("arg%d", i))
+			}
+			for _, n := range p.Names {
+				args = append(args, n.Name)
+			}
+		}
+		recorded := strings.Join(append([]string{fmt.Sprintf("%q", method)}, args...), ", ")
+		call := fmt.Sprintf("m.%sFunc(%s)", method, strings.Join(args, ", "))
+
+		fmt.Fprintf(b, "func (m *%s) %s%s {\n\tm.record(%s)\n", fake, method, signature(fset, ft, imports, true), recorded)
+		if ft.Results == nil {
+			fmt.Fprintf(b, "\tif m.%sFunc != nil {\n\t\t%s\n\t}\n}\n\n", method, call)
+			continue
+		}
+		fmt.Fprintf(b, "\tif m.%sFunc != nil {\n\t\treturn %s\n\t}\n", method, call)
+		var zeros []string
+		for _, r := range ft.Results.List {
+			n := len(r.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				zero := fmt.Sprintf("r%d", len(zeros))
+				fmt.Fprintf(b, "\tvar %s %s\n", zero, typeString(fset, r.Type, imports))
+				zeros = append(zeros, zero)
+			}
+		}
+		fmt.Fprintf(b, "\treturn %s\n}\n\n", strings.Join(zeros, ", "))
+	}
+}
+
+// signature returns the parameters and results of ft, with the types of
+// package gads qualified.  Parameters are named when named is set, results
+// never are.
+func signature(fset *token.FileSet, ft *ast.FuncType, imports map[string]bool, named bool) string {
+	var params []string
+	for i, p := range ft.Params.List {
+		typ := typeString(fset, p.Type, imports)
+		switch {
+		case !named:
+			for range p.Names {
+				params = append(params, typ)
+			}
+			if len(p.Names) == 0 {
+				params = append(params, typ)
+			}
+		case len(p.Names) == 0:
+			params = append(params, fmt.Sprintf("arg%d %s", i, typ))
+		default:
+			for _, n := range p.Names {
+				params = append(params, n.Name+" "+typ)
+			}
+		}
+	}
+	var results []string
+	if ft.Results != nil {
+		for _, r := range ft.Results.List {
+			n := len(r.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				results = append(results, typeString(fset, r.Type, imports))
+			}
+		}
+	}
+	s := "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+	case 1:
+		s += " " + results[0]
+	default:
+		s += " (" + strings.Join(results, ", ") + ")"
+	}
+	return s
+}
+
+// typeString prints typ with its exported identifiers qualified by gads,
+// and notes the packages it uses.
+func typeString(fset *token.FileSet, typ ast.Expr, imports map[string]bool) string {
+	var qualify func(ast.Expr) ast.Expr
+	qualify = func(e ast.Expr) ast.Expr {
+		switch t := e.(type) {
+		case *ast.Ident:
+			if ast.IsExported(t.Name) {
+				return &ast.SelectorExpr{X: ast.NewIdent("gads"), Sel: ast.NewIdent(t.Name)}
+			}
+		case *ast.SelectorExpr:
+			imports[t.X.(*ast.Ident).Name] = true
+		case *ast.StarExpr:
+			return &ast.StarExpr{X: qualify(t.X)}
+		case *ast.ArrayType:
+			return &ast.ArrayType{Len: t.Len, Elt: qualify(t.Elt)}
+		case *ast.MapType:
+			return &ast.MapType{Key: qualify(t.Key), Value: qualify(t.Value)}
+		}
+		return e
+	}
+	var b bytes.Buffer
+	printer.Fprint(&b, fset, qualify(typ))
+	return b.String()
+}
@@ -0,0 +1,2262 @@
+// Code generated by gen.go from ../services.go; DO NOT EDIT.
+
+package gadsmock
+
+import (
+	"context"
+	"io"
+
+	gads "github.com/denton/gads/googleads"
+)
+
+var (
+	_ gads.AdGroupAdServicer                = (*AdGroupAdService)(nil)
+	_ gads.AdGroupCriterionServicer         = (*AdGroupCriterionService)(nil)
+	_ gads.AdGroupExtensionSettingServicer  = (*AdGroupExtensionSettingService)(nil)
+	_ gads.AdGroupFeedServicer              = (*AdGroupFeedService)(nil)
+	_ gads.AdGroupServicer                  = (*AdGroupService)(nil)
+	_ gads.AdParamServicer                  = (*AdParamService)(nil)
+	_ gads.AdwordsUserListServicer          = (*AdwordsUserListService)(nil)
+	_ gads.BatchJobServicer                 = (*BatchJobService)(nil)
+	_ gads.BudgetServicer                   = (*BudgetService)(nil)
+	_ gads.CampaignCriterionServicer        = (*CampaignCriterionService)(nil)
+	_ gads.CampaignExtensionSettingServicer = (*CampaignExtensionSettingService)(nil)
+	_ gads.CampaignServicer                 = (*CampaignService)(nil)
+	_ gads.CampaignSharedSetServicer        = (*CampaignSharedSetService)(nil)
+	_ gads.ConstantDataServicer             = (*ConstantDataService)(nil)
+	_ gads.CustomerServicer                 = (*CustomerService)(nil)
+	_ gads.CustomerSyncServicer             = (*CustomerSyncService)(nil)
+	_ gads.DataServicer                     = (*DataService)(nil)
+	_ gads.FeedServicer                     = (*FeedService)(nil)
+	_ gads.LabelServicer                    = (*LabelService)(nil)
+	_ gads.LocationCriterionServicer        = (*LocationCriterionService)(nil)
+	_ gads.ManagedCustomerServicer          = (*ManagedCustomerService)(nil)
+	_ gads.MediaServicer                    = (*MediaService)(nil)
+	_ gads.ReportDefinitionServicer         = (*ReportDefinitionService)(nil)
+	_ gads.ReportDownloadServicer           = (*ReportDownloadService)(nil)
+	_ gads.SharedCriterionServicer          = (*SharedCriterionService)(nil)
+	_ gads.SharedSetServicer                = (*SharedSetService)(nil)
+	_ gads.TargetingIdeaServicer            = (*TargetingIdeaService)(nil)
+	_ gads.TrafficEstimatorServicer         = (*TrafficEstimatorService)(nil)
+)
+
+// AdGroupAdService is a fake gads.AdGroupAdServicer.
+type AdGroupAdService struct {
+	Recorder
+
+	GetFunc                          func(gads.Selector) (gads.AdGroupAds, int64, error)
+	GetContextFunc                   func(context.Context, gads.Selector) (gads.AdGroupAds, int64, error)
+	MutateFunc                       func(gads.AdGroupAdOperations) (gads.AdGroupAds, error)
+	MutateContextFunc                func(context.Context, gads.AdGroupAdOperations) (gads.AdGroupAds, error)
+	MutateOperationsFunc             func([]gads.AdGroupAdOperation) (gads.AdGroupAds, error)
+	MutateOperationsContextFunc      func(context.Context, []gads.AdGroupAdOperation) (gads.AdGroupAds, error)
+	MutateLabelFunc                  func(gads.AdGroupAdLabelOperations) ([]gads.AdGroupAdLabel, error)
+	MutateLabelContextFunc           func(context.Context, gads.AdGroupAdLabelOperations) ([]gads.AdGroupAdLabel, error)
+	MutateLabelOperationsFunc        func([]gads.AdGroupAdLabelOperation) ([]gads.AdGroupAdLabel, error)
+	MutateLabelOperationsContextFunc func(context.Context, []gads.AdGroupAdLabelOperation) ([]gads.AdGroupAdLabel, error)
+	QueryFunc                        func(string) (gads.AdGroupAds, int64, error)
+	QueryContextFunc                 func(context.Context, string) (gads.AdGroupAds, int64, error)
+	UpgradeUrlFunc                   func([]gads.AdUrlUpgrade) (gads.AdGroupAds, error)
+	MutateOperationsChunkedFunc      func(context.Context, []gads.AdGroupAdOperation, *gads.ChunkOptions) (gads.AdGroupAds, error)
+}
+
+func (m *AdGroupAdService) Get(selector gads.Selector) (gads.AdGroupAds, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 gads.AdGroupAds
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupAdService) GetContext(ctx context.Context, selector gads.Selector) (gads.AdGroupAds, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 gads.AdGroupAds
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupAdService) Mutate(adGroupAdOperations gads.AdGroupAdOperations) (gads.AdGroupAds, error) {
+	m.record("Mutate", adGroupAdOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(adGroupAdOperations)
+	}
+	var r0 gads.AdGroupAds
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupAdService) MutateContext(ctx context.Context, adGroupAdOperations gads.AdGroupAdOperations) (gads.AdGroupAds, error) {
+	m.record("MutateContext", ctx, adGroupAdOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, adGroupAdOperations)
+	}
+	var r0 gads.AdGroupAds
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupAdService) MutateOperations(operations []gads.AdGroupAdOperation) (gads.AdGroupAds, error) {
+	m.record("MutateOperations", operations)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(operations)
+	}
+	var r0 gads.AdGroupAds
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupAdService) MutateOperationsContext(ctx context.Context, operations []gads.AdGroupAdOperation) (gads.AdGroupAds, error) {
+	m.record("MutateOperationsContext", ctx, operations)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, operations)
+	}
+	var r0 gads.AdGroupAds
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupAdService) MutateLabel(adGroupAdLabelOperations gads.AdGroupAdLabelOperations) ([]gads.AdGroupAdLabel, error) {
+	m.record("MutateLabel", adGroupAdLabelOperations)
+	if m.MutateLabelFunc != nil {
+		return m.MutateLabelFunc(adGroupAdLabelOperations)
+	}
+	var r0 []gads.AdGroupAdLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupAdService) MutateLabelContext(ctx context.Context, adGroupAdLabelOperations gads.AdGroupAdLabelOperations) ([]gads.AdGroupAdLabel, error) {
+	m.record("MutateLabelContext", ctx, adGroupAdLabelOperations)
+	if m.MutateLabelContextFunc != nil {
+		return m.MutateLabelContextFunc(ctx, adGroupAdLabelOperations)
+	}
+	var r0 []gads.AdGroupAdLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupAdService) MutateLabelOperations(operations []gads.AdGroupAdLabelOperation) ([]gads.AdGroupAdLabel, error) {
+	m.record("MutateLabelOperations", operations)
+	if m.MutateLabelOperationsFunc != nil {
+		return m.MutateLabelOperationsFunc(operations)
+	}
+	var r0 []gads.AdGroupAdLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupAdService) MutateLabelOperationsContext(ctx context.Context, operations []gads.AdGroupAdLabelOperation) ([]gads.AdGroupAdLabel, error) {
+	m.record("MutateLabelOperationsContext", ctx, operations)
+	if m.MutateLabelOperationsContextFunc != nil {
+		return m.MutateLabelOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.AdGroupAdLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupAdService) Query(query string) (gads.AdGroupAds, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 gads.AdGroupAds
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupAdService) QueryContext(ctx context.Context, query string) (gads.AdGroupAds, int64, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 gads.AdGroupAds
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupAdService) UpgradeUrl(adUrlUpgrades []gads.AdUrlUpgrade) (gads.AdGroupAds, error) {
+	m.record("UpgradeUrl", adUrlUpgrades)
+	if m.UpgradeUrlFunc != nil {
+		return m.UpgradeUrlFunc(adUrlUpgrades)
+	}
+	var r0 gads.AdGroupAds
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupAdService) MutateOperationsChunked(ctx context.Context, operations []gads.AdGroupAdOperation, opts *gads.ChunkOptions) (gads.AdGroupAds, error) {
+	m.record("MutateOperationsChunked", ctx, operations, opts)
+	if m.MutateOperationsChunkedFunc != nil {
+		return m.MutateOperationsChunkedFunc(ctx, operations, opts)
+	}
+	var r0 gads.AdGroupAds
+	var r1 error
+	return r0, r1
+}
+
+// AdGroupCriterionService is a fake gads.AdGroupCriterionServicer.
+type AdGroupCriterionService struct {
+	Recorder
+
+	GetFunc                          func(gads.Selector) (gads.AdGroupCriterions, int64, error)
+	GetContextFunc                   func(context.Context, gads.Selector) (gads.AdGroupCriterions, int64, error)
+	MutateOperationsFunc             func([]gads.AdGroupCriterionOperation) (gads.AdGroupCriterions, error)
+	MutateOperationsContextFunc      func(context.Context, []gads.AdGroupCriterionOperation) (gads.AdGroupCriterions, error)
+	MutateFunc                       func(gads.AdGroupCriterionOperations) (gads.AdGroupCriterions, error)
+	MutateContextFunc                func(context.Context, gads.AdGroupCriterionOperations) (gads.AdGroupCriterions, error)
+	MutateLabelFunc                  func(gads.AdGroupCriterionLabelOperations) ([]gads.AdGroupCriterionLabel, error)
+	MutateLabelContextFunc           func(context.Context, gads.AdGroupCriterionLabelOperations) ([]gads.AdGroupCriterionLabel, error)
+	MutateLabelOperationsFunc        func([]gads.AdGroupCriterionLabelOperation) ([]gads.AdGroupCriterionLabel, error)
+	MutateLabelOperationsContextFunc func(context.Context, []gads.AdGroupCriterionLabelOperation) ([]gads.AdGroupCriterionLabel, error)
+	QueryFunc                        func(string) (gads.AdGroupCriterions, int64, error)
+	QueryContextFunc                 func(context.Context, string) (gads.AdGroupCriterions, int64, error)
+	MutateOperationsChunkedFunc      func(context.Context, []gads.AdGroupCriterionOperation, *gads.ChunkOptions) (gads.AdGroupCriterions, error)
+}
+
+func (m *AdGroupCriterionService) Get(selector gads.Selector) (gads.AdGroupCriterions, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 gads.AdGroupCriterions
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupCriterionService) GetContext(ctx context.Context, selector gads.Selector) (gads.AdGroupCriterions, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 gads.AdGroupCriterions
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupCriterionService) MutateOperations(operations []gads.AdGroupCriterionOperation) (gads.AdGroupCriterions, error) {
+	m.record("MutateOperations", operations)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(operations)
+	}
+	var r0 gads.AdGroupCriterions
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupCriterionService) MutateOperationsContext(ctx context.Context, operations []gads.AdGroupCriterionOperation) (gads.AdGroupCriterions, error) {
+	m.record("MutateOperationsContext", ctx, operations)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, operations)
+	}
+	var r0 gads.AdGroupCriterions
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupCriterionService) Mutate(adGroupCriterionOperations gads.AdGroupCriterionOperations) (gads.AdGroupCriterions, error) {
+	m.record("Mutate", adGroupCriterionOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(adGroupCriterionOperations)
+	}
+	var r0 gads.AdGroupCriterions
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupCriterionService) MutateContext(ctx context.Context, adGroupCriterionOperations gads.AdGroupCriterionOperations) (gads.AdGroupCriterions, error) {
+	m.record("MutateContext", ctx, adGroupCriterionOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, adGroupCriterionOperations)
+	}
+	var r0 gads.AdGroupCriterions
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupCriterionService) MutateLabel(adGroupCriterionLabelOperations gads.AdGroupCriterionLabelOperations) ([]gads.AdGroupCriterionLabel, error) {
+	m.record("MutateLabel", adGroupCriterionLabelOperations)
+	if m.MutateLabelFunc != nil {
+		return m.MutateLabelFunc(adGroupCriterionLabelOperations)
+	}
+	var r0 []gads.AdGroupCriterionLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupCriterionService) MutateLabelContext(ctx context.Context, adGroupCriterionLabelOperations gads.AdGroupCriterionLabelOperations) ([]gads.AdGroupCriterionLabel, error) {
+	m.record("MutateLabelContext", ctx, adGroupCriterionLabelOperations)
+	if m.MutateLabelContextFunc != nil {
+		return m.MutateLabelContextFunc(ctx, adGroupCriterionLabelOperations)
+	}
+	var r0 []gads.AdGroupCriterionLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupCriterionService) MutateLabelOperations(operations []gads.AdGroupCriterionLabelOperation) ([]gads.AdGroupCriterionLabel, error) {
+	m.record("MutateLabelOperations", operations)
+	if m.MutateLabelOperationsFunc != nil {
+		return m.MutateLabelOperationsFunc(operations)
+	}
+	var r0 []gads.AdGroupCriterionLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupCriterionService) MutateLabelOperationsContext(ctx context.Context, operations []gads.AdGroupCriterionLabelOperation) ([]gads.AdGroupCriterionLabel, error) {
+	m.record("MutateLabelOperationsContext", ctx, operations)
+	if m.MutateLabelOperationsContextFunc != nil {
+		return m.MutateLabelOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.AdGroupCriterionLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupCriterionService) Query(query string) (gads.AdGroupCriterions, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 gads.AdGroupCriterions
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupCriterionService) QueryContext(ctx context.Context, query string) (gads.AdGroupCriterions, int64, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 gads.AdGroupCriterions
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupCriterionService) MutateOperationsChunked(ctx context.Context, operations []gads.AdGroupCriterionOperation, opts *gads.ChunkOptions) (gads.AdGroupCriterions, error) {
+	m.record("MutateOperationsChunked", ctx, operations, opts)
+	if m.MutateOperationsChunkedFunc != nil {
+		return m.MutateOperationsChunkedFunc(ctx, operations, opts)
+	}
+	var r0 gads.AdGroupCriterions
+	var r1 error
+	return r0, r1
+}
+
+// AdGroupExtensionSettingService is a fake gads.AdGroupExtensionSettingServicer.
+type AdGroupExtensionSettingService struct {
+	Recorder
+
+	QueryFunc                   func(string) ([]gads.AdGroupExtensionSetting, int64, error)
+	QueryContextFunc            func(context.Context, string) ([]gads.AdGroupExtensionSetting, int64, error)
+	MutateFunc                  func(gads.AdGroupExtensionSettingOperations) ([]gads.AdGroupExtensionSetting, error)
+	MutateContextFunc           func(context.Context, gads.AdGroupExtensionSettingOperations) ([]gads.AdGroupExtensionSetting, error)
+	MutateOperationsFunc        func([]gads.AdGroupExtensionSettingOperation) ([]gads.AdGroupExtensionSetting, error)
+	MutateOperationsContextFunc func(context.Context, []gads.AdGroupExtensionSettingOperation) ([]gads.AdGroupExtensionSetting, error)
+}
+
+func (m *AdGroupExtensionSettingService) Query(query string) ([]gads.AdGroupExtensionSetting, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 []gads.AdGroupExtensionSetting
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupExtensionSettingService) QueryContext(ctx context.Context, query string) ([]gads.AdGroupExtensionSetting, int64, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 []gads.AdGroupExtensionSetting
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupExtensionSettingService) Mutate(settingsOperations gads.AdGroupExtensionSettingOperations) ([]gads.AdGroupExtensionSetting, error) {
+	m.record("Mutate", settingsOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(settingsOperations)
+	}
+	var r0 []gads.AdGroupExtensionSetting
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupExtensionSettingService) MutateContext(ctx context.Context, settingsOperations gads.AdGroupExtensionSettingOperations) ([]gads.AdGroupExtensionSetting, error) {
+	m.record("MutateContext", ctx, settingsOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, settingsOperations)
+	}
+	var r0 []gads.AdGroupExtensionSetting
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupExtensionSettingService) MutateOperations(operations []gads.AdGroupExtensionSettingOperation) ([]gads.AdGroupExtensionSetting, error) {
+	m.record("MutateOperations", operations)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(operations)
+	}
+	var r0 []gads.AdGroupExtensionSetting
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupExtensionSettingService) MutateOperationsContext(ctx context.Context, operations []gads.AdGroupExtensionSettingOperation) ([]gads.AdGroupExtensionSetting, error) {
+	m.record("MutateOperationsContext", ctx, operations)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.AdGroupExtensionSetting
+	var r1 error
+	return r0, r1
+}
+
+// AdGroupFeedService is a fake gads.AdGroupFeedServicer.
+type AdGroupFeedService struct {
+	Recorder
+
+	GetFunc           func(gads.Selector) (gads.AdGroupFeedPage, error)
+	GetContextFunc    func(context.Context, gads.Selector) (gads.AdGroupFeedPage, error)
+	MutateFunc        func([]gads.AdGroupFeedOperation) (gads.AdGroupFeedReturnValue, error)
+	MutateContextFunc func(context.Context, []gads.AdGroupFeedOperation) (gads.AdGroupFeedReturnValue, error)
+	QueryFunc         func(string) (gads.AdGroupFeedPage, error)
+	QueryContextFunc  func(context.Context, string) (gads.AdGroupFeedPage, error)
+}
+
+func (m *AdGroupFeedService) Get(selector gads.Selector) (gads.AdGroupFeedPage, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 gads.AdGroupFeedPage
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupFeedService) GetContext(ctx context.Context, selector gads.Selector) (gads.AdGroupFeedPage, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 gads.AdGroupFeedPage
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupFeedService) Mutate(operations []gads.AdGroupFeedOperation) (gads.AdGroupFeedReturnValue, error) {
+	m.record("Mutate", operations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(operations)
+	}
+	var r0 gads.AdGroupFeedReturnValue
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupFeedService) MutateContext(ctx context.Context, operations []gads.AdGroupFeedOperation) (gads.AdGroupFeedReturnValue, error) {
+	m.record("MutateContext", ctx, operations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, operations)
+	}
+	var r0 gads.AdGroupFeedReturnValue
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupFeedService) Query(query string) (gads.AdGroupFeedPage, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 gads.AdGroupFeedPage
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupFeedService) QueryContext(ctx context.Context, query string) (gads.AdGroupFeedPage, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 gads.AdGroupFeedPage
+	var r1 error
+	return r0, r1
+}
+
+// AdGroupService is a fake gads.AdGroupServicer.
+type AdGroupService struct {
+	Recorder
+
+	GetFunc                          func(gads.Selector) ([]gads.AdGroup, int64, error)
+	GetContextFunc                   func(context.Context, gads.Selector) ([]gads.AdGroup, int64, error)
+	MutateFunc                       func(gads.AdGroupOperations) ([]gads.AdGroup, error)
+	MutateContextFunc                func(context.Context, gads.AdGroupOperations) ([]gads.AdGroup, error)
+	MutateOperationsFunc             func([]gads.AdGroupOperation) ([]gads.AdGroup, error)
+	MutateOperationsContextFunc      func(context.Context, []gads.AdGroupOperation) ([]gads.AdGroup, error)
+	MutateLabelFunc                  func(gads.AdGroupLabelOperations) ([]gads.AdGroupLabel, error)
+	MutateLabelContextFunc           func(context.Context, gads.AdGroupLabelOperations) ([]gads.AdGroupLabel, error)
+	MutateLabelOperationsFunc        func([]gads.AdGroupLabelOperation) ([]gads.AdGroupLabel, error)
+	MutateLabelOperationsContextFunc func(context.Context, []gads.AdGroupLabelOperation) ([]gads.AdGroupLabel, error)
+	QueryFunc                        func(string) ([]gads.AdGroup, int64, error)
+	QueryContextFunc                 func(context.Context, string) ([]gads.AdGroup, int64, error)
+}
+
+func (m *AdGroupService) Get(selector gads.Selector) ([]gads.AdGroup, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.AdGroup
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupService) GetContext(ctx context.Context, selector gads.Selector) ([]gads.AdGroup, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.AdGroup
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupService) Mutate(adGroupOperations gads.AdGroupOperations) ([]gads.AdGroup, error) {
+	m.record("Mutate", adGroupOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(adGroupOperations)
+	}
+	var r0 []gads.AdGroup
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupService) MutateContext(ctx context.Context, adGroupOperations gads.AdGroupOperations) ([]gads.AdGroup, error) {
+	m.record("MutateContext", ctx, adGroupOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, adGroupOperations)
+	}
+	var r0 []gads.AdGroup
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupService) MutateOperations(operations []gads.AdGroupOperation) ([]gads.AdGroup, error) {
+	m.record("MutateOperations", operations)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(operations)
+	}
+	var r0 []gads.AdGroup
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupService) MutateOperationsContext(ctx context.Context, operations []gads.AdGroupOperation) ([]gads.AdGroup, error) {
+	m.record("MutateOperationsContext", ctx, operations)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.AdGroup
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupService) MutateLabel(adGroupLabelOperations gads.AdGroupLabelOperations) ([]gads.AdGroupLabel, error) {
+	m.record("MutateLabel", adGroupLabelOperations)
+	if m.MutateLabelFunc != nil {
+		return m.MutateLabelFunc(adGroupLabelOperations)
+	}
+	var r0 []gads.AdGroupLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupService) MutateLabelContext(ctx context.Context, adGroupLabelOperations gads.AdGroupLabelOperations) ([]gads.AdGroupLabel, error) {
+	m.record("MutateLabelContext", ctx, adGroupLabelOperations)
+	if m.MutateLabelContextFunc != nil {
+		return m.MutateLabelContextFunc(ctx, adGroupLabelOperations)
+	}
+	var r0 []gads.AdGroupLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupService) MutateLabelOperations(operations []gads.AdGroupLabelOperation) ([]gads.AdGroupLabel, error) {
+	m.record("MutateLabelOperations", operations)
+	if m.MutateLabelOperationsFunc != nil {
+		return m.MutateLabelOperationsFunc(operations)
+	}
+	var r0 []gads.AdGroupLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupService) MutateLabelOperationsContext(ctx context.Context, operations []gads.AdGroupLabelOperation) ([]gads.AdGroupLabel, error) {
+	m.record("MutateLabelOperationsContext", ctx, operations)
+	if m.MutateLabelOperationsContextFunc != nil {
+		return m.MutateLabelOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.AdGroupLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdGroupService) Query(query string) ([]gads.AdGroup, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 []gads.AdGroup
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *AdGroupService) QueryContext(ctx context.Context, query string) ([]gads.AdGroup, int64, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 []gads.AdGroup
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+// AdParamService is a fake gads.AdParamServicer.
+type AdParamService struct {
+	Recorder
+
+	GetFunc           func(gads.Selector) (gads.AdParamPage, error)
+	GetContextFunc    func(context.Context, gads.Selector) (gads.AdParamPage, error)
+	MutateFunc        func([]gads.AdParamOperation) ([]gads.AdParam, error)
+	MutateContextFunc func(context.Context, []gads.AdParamOperation) ([]gads.AdParam, error)
+}
+
+func (m *AdParamService) Get(serviceSelector gads.Selector) (gads.AdParamPage, error) {
+	m.record("Get", serviceSelector)
+	if m.GetFunc != nil {
+		return m.GetFunc(serviceSelector)
+	}
+	var r0 gads.AdParamPage
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdParamService) GetContext(ctx context.Context, serviceSelector gads.Selector) (gads.AdParamPage, error) {
+	m.record("GetContext", ctx, serviceSelector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, serviceSelector)
+	}
+	var r0 gads.AdParamPage
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdParamService) Mutate(operations []gads.AdParamOperation) ([]gads.AdParam, error) {
+	m.record("Mutate", operations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(operations)
+	}
+	var r0 []gads.AdParam
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdParamService) MutateContext(ctx context.Context, operations []gads.AdParamOperation) ([]gads.AdParam, error) {
+	m.record("MutateContext", ctx, operations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, operations)
+	}
+	var r0 []gads.AdParam
+	var r1 error
+	return r0, r1
+}
+
+// AdwordsUserListService is a fake gads.AdwordsUserListServicer.
+type AdwordsUserListService struct {
+	Recorder
+
+	GetFunc                  func(gads.Selector) ([]gads.UserList, error)
+	GetContextFunc           func(context.Context, gads.Selector) ([]gads.UserList, error)
+	MutateFunc               func(gads.UserListOperations) ([]gads.UserList, error)
+	MutateContextFunc        func(context.Context, gads.UserListOperations) ([]gads.UserList, error)
+	MutateMembersFunc        func(gads.MutateMembersOperations) ([]gads.UserList, error)
+	MutateMembersContextFunc func(context.Context, gads.MutateMembersOperations) ([]gads.UserList, error)
+	MutateMembersChunkedFunc func(context.Context, gads.MutateMembersOperations, *gads.ChunkOptions) ([]gads.UserList, error)
+}
+
+func (m *AdwordsUserListService) Get(selector gads.Selector) ([]gads.UserList, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.UserList
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdwordsUserListService) GetContext(ctx context.Context, selector gads.Selector) ([]gads.UserList, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.UserList
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdwordsUserListService) Mutate(userListOperations gads.UserListOperations) ([]gads.UserList, error) {
+	m.record("Mutate", userListOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(userListOperations)
+	}
+	var r0 []gads.UserList
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdwordsUserListService) MutateContext(ctx context.Context, userListOperations gads.UserListOperations) ([]gads.UserList, error) {
+	m.record("MutateContext", ctx, userListOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, userListOperations)
+	}
+	var r0 []gads.UserList
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdwordsUserListService) MutateMembers(mutateMembersOperations gads.MutateMembersOperations) ([]gads.UserList, error) {
+	m.record("MutateMembers", mutateMembersOperations)
+	if m.MutateMembersFunc != nil {
+		return m.MutateMembersFunc(mutateMembersOperations)
+	}
+	var r0 []gads.UserList
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdwordsUserListService) MutateMembersContext(ctx context.Context, mutateMembersOperations gads.MutateMembersOperations) ([]gads.UserList, error) {
+	m.record("MutateMembersContext", ctx, mutateMembersOperations)
+	if m.MutateMembersContextFunc != nil {
+		return m.MutateMembersContextFunc(ctx, mutateMembersOperations)
+	}
+	var r0 []gads.UserList
+	var r1 error
+	return r0, r1
+}
+
+func (m *AdwordsUserListService) MutateMembersChunked(ctx context.Context, mutateMembersOperations gads.MutateMembersOperations, opts *gads.ChunkOptions) ([]gads.UserList, error) {
+	m.record("MutateMembersChunked", ctx, mutateMembersOperations, opts)
+	if m.MutateMembersChunkedFunc != nil {
+		return m.MutateMembersChunkedFunc(ctx, mutateMembersOperations, opts)
+	}
+	var r0 []gads.UserList
+	var r1 error
+	return r0, r1
+}
+
+// BatchJobService is a fake gads.BatchJobServicer.
+type BatchJobService struct {
+	Recorder
+
+	GetFunc           func(gads.Selector) (gads.BatchJobPage, error)
+	GetContextFunc    func(context.Context, gads.Selector) (gads.BatchJobPage, error)
+	MutateFunc        func(gads.BatchJobOperations) ([]gads.BatchJob, error)
+	MutateContextFunc func(context.Context, gads.BatchJobOperations) ([]gads.BatchJob, error)
+}
+
+func (m *BatchJobService) Get(selector gads.Selector) (gads.BatchJobPage, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 gads.BatchJobPage
+	var r1 error
+	return r0, r1
+}
+
+func (m *BatchJobService) GetContext(ctx context.Context, selector gads.Selector) (gads.BatchJobPage, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 gads.BatchJobPage
+	var r1 error
+	return r0, r1
+}
+
+func (m *BatchJobService) Mutate(batchJobOperations gads.BatchJobOperations) ([]gads.BatchJob, error) {
+	m.record("Mutate", batchJobOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(batchJobOperations)
+	}
+	var r0 []gads.BatchJob
+	var r1 error
+	return r0, r1
+}
+
+func (m *BatchJobService) MutateContext(ctx context.Context, batchJobOperations gads.BatchJobOperations) ([]gads.BatchJob, error) {
+	m.record("MutateContext", ctx, batchJobOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, batchJobOperations)
+	}
+	var r0 []gads.BatchJob
+	var r1 error
+	return r0, r1
+}
+
+// BudgetService is a fake gads.BudgetServicer.
+type BudgetService struct {
+	Recorder
+
+	GetFunc                     func(gads.Selector) ([]gads.Budget, int64, error)
+	GetContextFunc              func(context.Context, gads.Selector) ([]gads.Budget, int64, error)
+	MutateFunc                  func(gads.BudgetOperations) ([]gads.Budget, error)
+	MutateContextFunc           func(context.Context, gads.BudgetOperations) ([]gads.Budget, error)
+	MutateOperationsFunc        func([]gads.BudgetOperation) ([]gads.Budget, error)
+	MutateOperationsContextFunc func(context.Context, []gads.BudgetOperation) ([]gads.Budget, error)
+}
+
+func (m *BudgetService) Get(selector gads.Selector) ([]gads.Budget, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.Budget
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *BudgetService) GetContext(ctx context.Context, selector gads.Selector) ([]gads.Budget, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.Budget
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *BudgetService) Mutate(budgetOperations gads.BudgetOperations) ([]gads.Budget, error) {
+	m.record("Mutate", budgetOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(budgetOperations)
+	}
+	var r0 []gads.Budget
+	var r1 error
+	return r0, r1
+}
+
+func (m *BudgetService) MutateContext(ctx context.Context, budgetOperations gads.BudgetOperations) ([]gads.Budget, error) {
+	m.record("MutateContext", ctx, budgetOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, budgetOperations)
+	}
+	var r0 []gads.Budget
+	var r1 error
+	return r0, r1
+}
+
+func (m *BudgetService) MutateOperations(operations []gads.BudgetOperation) ([]gads.Budget, error) {
+	m.record("MutateOperations", operations)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(operations)
+	}
+	var r0 []gads.Budget
+	var r1 error
+	return r0, r1
+}
+
+func (m *BudgetService) MutateOperationsContext(ctx context.Context, operations []gads.BudgetOperation) ([]gads.Budget, error) {
+	m.record("MutateOperationsContext", ctx, operations)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.Budget
+	var r1 error
+	return r0, r1
+}
+
+// CampaignCriterionService is a fake gads.CampaignCriterionServicer.
+type CampaignCriterionService struct {
+	Recorder
+
+	GetFunc                     func(gads.Selector) (gads.CampaignCriterions, int64, error)
+	GetContextFunc              func(context.Context, gads.Selector) (gads.CampaignCriterions, int64, error)
+	MutateOperationsFunc        func([]gads.CampaignCriterionOperation) (gads.CampaignCriterions, error)
+	MutateOperationsContextFunc func(context.Context, []gads.CampaignCriterionOperation) (gads.CampaignCriterions, error)
+	MutateFunc                  func(gads.CampaignCriterionOperations) (gads.CampaignCriterions, error)
+	MutateContextFunc           func(context.Context, gads.CampaignCriterionOperations) (gads.CampaignCriterions, error)
+	QueryFunc                   func(string) (gads.CampaignCriterions, int64, error)
+	QueryContextFunc            func(context.Context, string) (gads.CampaignCriterions, int64, error)
+	MutateOperationsChunkedFunc func(context.Context, []gads.CampaignCriterionOperation, *gads.ChunkOptions) (gads.CampaignCriterions, error)
+}
+
+func (m *CampaignCriterionService) Get(selector gads.Selector) (gads.CampaignCriterions, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 gads.CampaignCriterions
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignCriterionService) GetContext(ctx context.Context, selector gads.Selector) (gads.CampaignCriterions, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 gads.CampaignCriterions
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignCriterionService) MutateOperations(operations []gads.CampaignCriterionOperation) (gads.CampaignCriterions, error) {
+	m.record("MutateOperations", operations)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(operations)
+	}
+	var r0 gads.CampaignCriterions
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignCriterionService) MutateOperationsContext(ctx context.Context, operations []gads.CampaignCriterionOperation) (gads.CampaignCriterions, error) {
+	m.record("MutateOperationsContext", ctx, operations)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, operations)
+	}
+	var r0 gads.CampaignCriterions
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignCriterionService) Mutate(campaignCriterionOperations gads.CampaignCriterionOperations) (gads.CampaignCriterions, error) {
+	m.record("Mutate", campaignCriterionOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(campaignCriterionOperations)
+	}
+	var r0 gads.CampaignCriterions
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignCriterionService) MutateContext(ctx context.Context, campaignCriterionOperations gads.CampaignCriterionOperations) (gads.CampaignCriterions, error) {
+	m.record("MutateContext", ctx, campaignCriterionOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, campaignCriterionOperations)
+	}
+	var r0 gads.CampaignCriterions
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignCriterionService) Query(query string) (gads.CampaignCriterions, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 gads.CampaignCriterions
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignCriterionService) QueryContext(ctx context.Context, query string) (gads.CampaignCriterions, int64, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 gads.CampaignCriterions
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignCriterionService) MutateOperationsChunked(ctx context.Context, operations []gads.CampaignCriterionOperation, opts *gads.ChunkOptions) (gads.CampaignCriterions, error) {
+	m.record("MutateOperationsChunked", ctx, operations, opts)
+	if m.MutateOperationsChunkedFunc != nil {
+		return m.MutateOperationsChunkedFunc(ctx, operations, opts)
+	}
+	var r0 gads.CampaignCriterions
+	var r1 error
+	return r0, r1
+}
+
+// CampaignExtensionSettingService is a fake gads.CampaignExtensionSettingServicer.
+type CampaignExtensionSettingService struct {
+	Recorder
+
+	QueryFunc                   func(string) ([]gads.CampaignExtensionSetting, int64, error)
+	QueryContextFunc            func(context.Context, string) ([]gads.CampaignExtensionSetting, int64, error)
+	MutateFunc                  func(gads.CampaignExtensionSettingOperations) ([]gads.CampaignExtensionSetting, error)
+	MutateContextFunc           func(context.Context, gads.CampaignExtensionSettingOperations) ([]gads.CampaignExtensionSetting, error)
+	MutateOperationsFunc        func([]gads.CampaignExtensionSettingOperation) ([]gads.CampaignExtensionSetting, error)
+	MutateOperationsContextFunc func(context.Context, []gads.CampaignExtensionSettingOperation) ([]gads.CampaignExtensionSetting, error)
+}
+
+func (m *CampaignExtensionSettingService) Query(query string) ([]gads.CampaignExtensionSetting, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 []gads.CampaignExtensionSetting
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignExtensionSettingService) QueryContext(ctx context.Context, query string) ([]gads.CampaignExtensionSetting, int64, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 []gads.CampaignExtensionSetting
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignExtensionSettingService) Mutate(settingsOperations gads.CampaignExtensionSettingOperations) ([]gads.CampaignExtensionSetting, error) {
+	m.record("Mutate", settingsOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(settingsOperations)
+	}
+	var r0 []gads.CampaignExtensionSetting
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignExtensionSettingService) MutateContext(ctx context.Context, settingsOperations gads.CampaignExtensionSettingOperations) ([]gads.CampaignExtensionSetting, error) {
+	m.record("MutateContext", ctx, settingsOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, settingsOperations)
+	}
+	var r0 []gads.CampaignExtensionSetting
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignExtensionSettingService) MutateOperations(operations []gads.CampaignExtensionSettingOperation) ([]gads.CampaignExtensionSetting, error) {
+	m.record("MutateOperations", operations)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(operations)
+	}
+	var r0 []gads.CampaignExtensionSetting
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignExtensionSettingService) MutateOperationsContext(ctx context.Context, operations []gads.CampaignExtensionSettingOperation) ([]gads.CampaignExtensionSetting, error) {
+	m.record("MutateOperationsContext", ctx, operations)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.CampaignExtensionSetting
+	var r1 error
+	return r0, r1
+}
+
+// CampaignService is a fake gads.CampaignServicer.
+type CampaignService struct {
+	Recorder
+
+	GetFunc                          func(gads.Selector) ([]gads.Campaign, int64, error)
+	GetContextFunc                   func(context.Context, gads.Selector) ([]gads.Campaign, int64, error)
+	MutateOperationsFunc             func([]gads.CampaignOperation) ([]gads.Campaign, error)
+	MutateOperationsContextFunc      func(context.Context, []gads.CampaignOperation) ([]gads.Campaign, error)
+	MutateFunc                       func(gads.CampaignOperations) ([]gads.Campaign, error)
+	MutateContextFunc                func(context.Context, gads.CampaignOperations) ([]gads.Campaign, error)
+	MutateLabelFunc                  func(gads.CampaignLabelOperations) ([]gads.CampaignLabel, error)
+	MutateLabelContextFunc           func(context.Context, gads.CampaignLabelOperations) ([]gads.CampaignLabel, error)
+	MutateLabelOperationsFunc        func([]gads.CampaignLabelOperation) ([]gads.CampaignLabel, error)
+	MutateLabelOperationsContextFunc func(context.Context, []gads.CampaignLabelOperation) ([]gads.CampaignLabel, error)
+	QueryFunc                        func(string) ([]gads.Campaign, int64, error)
+	QueryContextFunc                 func(context.Context, string) ([]gads.Campaign, int64, error)
+}
+
+func (m *CampaignService) Get(selector gads.Selector) ([]gads.Campaign, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.Campaign
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignService) GetContext(ctx context.Context, selector gads.Selector) ([]gads.Campaign, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.Campaign
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignService) MutateOperations(ops []gads.CampaignOperation) ([]gads.Campaign, error) {
+	m.record("MutateOperations", ops)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(ops)
+	}
+	var r0 []gads.Campaign
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignService) MutateOperationsContext(ctx context.Context, ops []gads.CampaignOperation) ([]gads.Campaign, error) {
+	m.record("MutateOperationsContext", ctx, ops)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, ops)
+	}
+	var r0 []gads.Campaign
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignService) Mutate(campaignOperations gads.CampaignOperations) ([]gads.Campaign, error) {
+	m.record("Mutate", campaignOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(campaignOperations)
+	}
+	var r0 []gads.Campaign
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignService) MutateContext(ctx context.Context, campaignOperations gads.CampaignOperations) ([]gads.Campaign, error) {
+	m.record("MutateContext", ctx, campaignOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, campaignOperations)
+	}
+	var r0 []gads.Campaign
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignService) MutateLabel(campaignLabelOperations gads.CampaignLabelOperations) ([]gads.CampaignLabel, error) {
+	m.record("MutateLabel", campaignLabelOperations)
+	if m.MutateLabelFunc != nil {
+		return m.MutateLabelFunc(campaignLabelOperations)
+	}
+	var r0 []gads.CampaignLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignService) MutateLabelContext(ctx context.Context, campaignLabelOperations gads.CampaignLabelOperations) ([]gads.CampaignLabel, error) {
+	m.record("MutateLabelContext", ctx, campaignLabelOperations)
+	if m.MutateLabelContextFunc != nil {
+		return m.MutateLabelContextFunc(ctx, campaignLabelOperations)
+	}
+	var r0 []gads.CampaignLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignService) MutateLabelOperations(operations []gads.CampaignLabelOperation) ([]gads.CampaignLabel, error) {
+	m.record("MutateLabelOperations", operations)
+	if m.MutateLabelOperationsFunc != nil {
+		return m.MutateLabelOperationsFunc(operations)
+	}
+	var r0 []gads.CampaignLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignService) MutateLabelOperationsContext(ctx context.Context, operations []gads.CampaignLabelOperation) ([]gads.CampaignLabel, error) {
+	m.record("MutateLabelOperationsContext", ctx, operations)
+	if m.MutateLabelOperationsContextFunc != nil {
+		return m.MutateLabelOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.CampaignLabel
+	var r1 error
+	return r0, r1
+}
+
+func (m *CampaignService) Query(query string) ([]gads.Campaign, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 []gads.Campaign
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignService) QueryContext(ctx context.Context, query string) ([]gads.Campaign, int64, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 []gads.Campaign
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+// CampaignSharedSetService is a fake gads.CampaignSharedSetServicer.
+type CampaignSharedSetService struct {
+	Recorder
+
+	GetFunc           func(gads.Selector) ([]gads.CampaignSharedSet, int64, error)
+	GetContextFunc    func(context.Context, gads.Selector) ([]gads.CampaignSharedSet, int64, error)
+	MutateFunc        func([]gads.CampaignSharedSetOperation) error
+	MutateContextFunc func(context.Context, []gads.CampaignSharedSetOperation) error
+}
+
+func (m *CampaignSharedSetService) Get(selector gads.Selector) ([]gads.CampaignSharedSet, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.CampaignSharedSet
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignSharedSetService) GetContext(ctx context.Context, selector gads.Selector) ([]gads.CampaignSharedSet, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.CampaignSharedSet
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *CampaignSharedSetService) Mutate(operations []gads.CampaignSharedSetOperation) error {
+	m.record("Mutate", operations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(operations)
+	}
+	var r0 error
+	return r0
+}
+
+func (m *CampaignSharedSetService) MutateContext(ctx context.Context, operations []gads.CampaignSharedSetOperation) error {
+	m.record("MutateContext", ctx, operations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, operations)
+	}
+	var r0 error
+	return r0
+}
+
+// ConstantDataService is a fake gads.ConstantDataServicer.
+type ConstantDataService struct {
+	Recorder
+
+	GetAgeRangeCriterionFunc                      func() ([]gads.AgeRangeCriterion, error)
+	GetAgeRangeCriterionContextFunc               func(context.Context) ([]gads.AgeRangeCriterion, error)
+	GetCarrierCriterionFunc                       func() ([]gads.CarrierCriterion, error)
+	GetCarrierCriterionContextFunc                func(context.Context) ([]gads.CarrierCriterion, error)
+	GetGenderCriterionFunc                        func() ([]gads.GenderCriterion, error)
+	GetGenderCriterionContextFunc                 func(context.Context) ([]gads.GenderCriterion, error)
+	GetLanguageCriterionFunc                      func() ([]gads.LanguageCriterion, error)
+	GetLanguageCriterionContextFunc               func(context.Context) ([]gads.LanguageCriterion, error)
+	GetMobileDeviceCriterionFunc                  func() ([]gads.MobileDeviceCriterion, error)
+	GetMobileDeviceCriterionContextFunc           func(context.Context) ([]gads.MobileDeviceCriterion, error)
+	GetOperatingSystemVersionCriterionFunc        func() ([]gads.OperatingSystemVersionCriterion, error)
+	GetOperatingSystemVersionCriterionContextFunc func(context.Context) ([]gads.OperatingSystemVersionCriterion, error)
+	GetProductBiddingCategoryCriterionFunc        func(gads.Selector) ([]gads.ProductBiddingCategoryData, error)
+	GetProductBiddingCategoryCriterionContextFunc func(context.Context, gads.Selector) ([]gads.ProductBiddingCategoryData, error)
+	GetUserInterestCriterionFunc                  func() ([]gads.UserInterestCriterion, error)
+	GetUserInterestCriterionContextFunc           func(context.Context) ([]gads.UserInterestCriterion, error)
+	GetVerticalCriterionFunc                      func() ([]gads.VerticalCriterion, error)
+	GetVerticalCriterionContextFunc               func(context.Context) ([]gads.VerticalCriterion, error)
+}
+
+func (m *ConstantDataService) GetAgeRangeCriterion() ([]gads.AgeRangeCriterion, error) {
+	m.record("GetAgeRangeCriterion")
+	if m.GetAgeRangeCriterionFunc != nil {
+		return m.GetAgeRangeCriterionFunc()
+	}
+	var r0 []gads.AgeRangeCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetAgeRangeCriterionContext(ctx context.Context) ([]gads.AgeRangeCriterion, error) {
+	m.record("GetAgeRangeCriterionContext", ctx)
+	if m.GetAgeRangeCriterionContextFunc != nil {
+		return m.GetAgeRangeCriterionContextFunc(ctx)
+	}
+	var r0 []gads.AgeRangeCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetCarrierCriterion() ([]gads.CarrierCriterion, error) {
+	m.record("GetCarrierCriterion")
+	if m.GetCarrierCriterionFunc != nil {
+		return m.GetCarrierCriterionFunc()
+	}
+	var r0 []gads.CarrierCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetCarrierCriterionContext(ctx context.Context) ([]gads.CarrierCriterion, error) {
+	m.record("GetCarrierCriterionContext", ctx)
+	if m.GetCarrierCriterionContextFunc != nil {
+		return m.GetCarrierCriterionContextFunc(ctx)
+	}
+	var r0 []gads.CarrierCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetGenderCriterion() ([]gads.GenderCriterion, error) {
+	m.record("GetGenderCriterion")
+	if m.GetGenderCriterionFunc != nil {
+		return m.GetGenderCriterionFunc()
+	}
+	var r0 []gads.GenderCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetGenderCriterionContext(ctx context.Context) ([]gads.GenderCriterion, error) {
+	m.record("GetGenderCriterionContext", ctx)
+	if m.GetGenderCriterionContextFunc != nil {
+		return m.GetGenderCriterionContextFunc(ctx)
+	}
+	var r0 []gads.GenderCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetLanguageCriterion() ([]gads.LanguageCriterion, error) {
+	m.record("GetLanguageCriterion")
+	if m.GetLanguageCriterionFunc != nil {
+		return m.GetLanguageCriterionFunc()
+	}
+	var r0 []gads.LanguageCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetLanguageCriterionContext(ctx context.Context) ([]gads.LanguageCriterion, error) {
+	m.record("GetLanguageCriterionContext", ctx)
+	if m.GetLanguageCriterionContextFunc != nil {
+		return m.GetLanguageCriterionContextFunc(ctx)
+	}
+	var r0 []gads.LanguageCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetMobileDeviceCriterion() ([]gads.MobileDeviceCriterion, error) {
+	m.record("GetMobileDeviceCriterion")
+	if m.GetMobileDeviceCriterionFunc != nil {
+		return m.GetMobileDeviceCriterionFunc()
+	}
+	var r0 []gads.MobileDeviceCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetMobileDeviceCriterionContext(ctx context.Context) ([]gads.MobileDeviceCriterion, error) {
+	m.record("GetMobileDeviceCriterionContext", ctx)
+	if m.GetMobileDeviceCriterionContextFunc != nil {
+		return m.GetMobileDeviceCriterionContextFunc(ctx)
+	}
+	var r0 []gads.MobileDeviceCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetOperatingSystemVersionCriterion() ([]gads.OperatingSystemVersionCriterion, error) {
+	m.record("GetOperatingSystemVersionCriterion")
+	if m.GetOperatingSystemVersionCriterionFunc != nil {
+		return m.GetOperatingSystemVersionCriterionFunc()
+	}
+	var r0 []gads.OperatingSystemVersionCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetOperatingSystemVersionCriterionContext(ctx context.Context) ([]gads.OperatingSystemVersionCriterion, error) {
+	m.record("GetOperatingSystemVersionCriterionContext", ctx)
+	if m.GetOperatingSystemVersionCriterionContextFunc != nil {
+		return m.GetOperatingSystemVersionCriterionContextFunc(ctx)
+	}
+	var r0 []gads.OperatingSystemVersionCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetProductBiddingCategoryCriterion(selector gads.Selector) ([]gads.ProductBiddingCategoryData, error) {
+	m.record("GetProductBiddingCategoryCriterion", selector)
+	if m.GetProductBiddingCategoryCriterionFunc != nil {
+		return m.GetProductBiddingCategoryCriterionFunc(selector)
+	}
+	var r0 []gads.ProductBiddingCategoryData
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetProductBiddingCategoryCriterionContext(ctx context.Context, selector gads.Selector) ([]gads.ProductBiddingCategoryData, error) {
+	m.record("GetProductBiddingCategoryCriterionContext", ctx, selector)
+	if m.GetProductBiddingCategoryCriterionContextFunc != nil {
+		return m.GetProductBiddingCategoryCriterionContextFunc(ctx, selector)
+	}
+	var r0 []gads.ProductBiddingCategoryData
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetUserInterestCriterion() ([]gads.UserInterestCriterion, error) {
+	m.record("GetUserInterestCriterion")
+	if m.GetUserInterestCriterionFunc != nil {
+		return m.GetUserInterestCriterionFunc()
+	}
+	var r0 []gads.UserInterestCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetUserInterestCriterionContext(ctx context.Context) ([]gads.UserInterestCriterion, error) {
+	m.record("GetUserInterestCriterionContext", ctx)
+	if m.GetUserInterestCriterionContextFunc != nil {
+		return m.GetUserInterestCriterionContextFunc(ctx)
+	}
+	var r0 []gads.UserInterestCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetVerticalCriterion() ([]gads.VerticalCriterion, error) {
+	m.record("GetVerticalCriterion")
+	if m.GetVerticalCriterionFunc != nil {
+		return m.GetVerticalCriterionFunc()
+	}
+	var r0 []gads.VerticalCriterion
+	var r1 error
+	return r0, r1
+}
+
+func (m *ConstantDataService) GetVerticalCriterionContext(ctx context.Context) ([]gads.VerticalCriterion, error) {
+	m.record("GetVerticalCriterionContext", ctx)
+	if m.GetVerticalCriterionContextFunc != nil {
+		return m.GetVerticalCriterionContextFunc(ctx)
+	}
+	var r0 []gads.VerticalCriterion
+	var r1 error
+	return r0, r1
+}
+
+// CustomerService is a fake gads.CustomerServicer.
+type CustomerService struct {
+	Recorder
+
+	GetCustomersFunc        func() ([]gads.Customer, error)
+	GetCustomersContextFunc func(context.Context) ([]gads.Customer, error)
+}
+
+func (m *CustomerService) GetCustomers() ([]gads.Customer, error) {
+	m.record("GetCustomers")
+	if m.GetCustomersFunc != nil {
+		return m.GetCustomersFunc()
+	}
+	var r0 []gads.Customer
+	var r1 error
+	return r0, r1
+}
+
+func (m *CustomerService) GetCustomersContext(ctx context.Context) ([]gads.Customer, error) {
+	m.record("GetCustomersContext", ctx)
+	if m.GetCustomersContextFunc != nil {
+		return m.GetCustomersContextFunc(ctx)
+	}
+	var r0 []gads.Customer
+	var r1 error
+	return r0, r1
+}
+
+// CustomerSyncService is a fake gads.CustomerSyncServicer.
+type CustomerSyncService struct {
+	Recorder
+
+	GetFunc        func(gads.CustomerSyncSelector) (gads.CustomerChangeData, error)
+	GetContextFunc func(context.Context, gads.CustomerSyncSelector) (gads.CustomerChangeData, error)
+}
+
+func (m *CustomerSyncService) Get(selector gads.CustomerSyncSelector) (gads.CustomerChangeData, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 gads.CustomerChangeData
+	var r1 error
+	return r0, r1
+}
+
+func (m *CustomerSyncService) GetContext(ctx context.Context, selector gads.CustomerSyncSelector) (gads.CustomerChangeData, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 gads.CustomerChangeData
+	var r1 error
+	return r0, r1
+}
+
+// DataService is a fake gads.DataServicer.
+type DataService struct {
+	Recorder
+
+	GetAdGroupBidLandscapeFunc                  func(gads.Selector) ([]gads.AdGroupBidLandscape, int64, error)
+	GetAdGroupBidLandscapeContextFunc           func(context.Context, gads.Selector) ([]gads.AdGroupBidLandscape, int64, error)
+	GetCampaignCriterionBidLandscapeFunc        func(gads.Selector) ([]gads.CriterionBidLandscape, int64, error)
+	GetCampaignCriterionBidLandscapeContextFunc func(context.Context, gads.Selector) ([]gads.CriterionBidLandscape, int64, error)
+	GetCriterionBidLandscapeFunc                func(gads.Selector) ([]gads.CriterionBidLandscape, int64, error)
+	GetCriterionBidLandscapeContextFunc         func(context.Context, gads.Selector) ([]gads.CriterionBidLandscape, int64, error)
+	QueryAdGroupBidLandscapeFunc                func(string) ([]gads.AdGroupBidLandscape, int64, error)
+	QueryAdGroupBidLandscapeContextFunc         func(context.Context, string) ([]gads.AdGroupBidLandscape, int64, error)
+	QueryCriterionBidLandscapeFunc              func(string) ([]gads.CriterionBidLandscape, int64, error)
+	QueryCriterionBidLandscapeContextFunc       func(context.Context, string) ([]gads.CriterionBidLandscape, int64, error)
+}
+
+func (m *DataService) GetAdGroupBidLandscape(selector gads.Selector) ([]gads.AdGroupBidLandscape, int64, error) {
+	m.record("GetAdGroupBidLandscape", selector)
+	if m.GetAdGroupBidLandscapeFunc != nil {
+		return m.GetAdGroupBidLandscapeFunc(selector)
+	}
+	var r0 []gads.AdGroupBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *DataService) GetAdGroupBidLandscapeContext(ctx context.Context, selector gads.Selector) ([]gads.AdGroupBidLandscape, int64, error) {
+	m.record("GetAdGroupBidLandscapeContext", ctx, selector)
+	if m.GetAdGroupBidLandscapeContextFunc != nil {
+		return m.GetAdGroupBidLandscapeContextFunc(ctx, selector)
+	}
+	var r0 []gads.AdGroupBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *DataService) GetCampaignCriterionBidLandscape(selector gads.Selector) ([]gads.CriterionBidLandscape, int64, error) {
+	m.record("GetCampaignCriterionBidLandscape", selector)
+	if m.GetCampaignCriterionBidLandscapeFunc != nil {
+		return m.GetCampaignCriterionBidLandscapeFunc(selector)
+	}
+	var r0 []gads.CriterionBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *DataService) GetCampaignCriterionBidLandscapeContext(ctx context.Context, selector gads.Selector) ([]gads.CriterionBidLandscape, int64, error) {
+	m.record("GetCampaignCriterionBidLandscapeContext", ctx, selector)
+	if m.GetCampaignCriterionBidLandscapeContextFunc != nil {
+		return m.GetCampaignCriterionBidLandscapeContextFunc(ctx, selector)
+	}
+	var r0 []gads.CriterionBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *DataService) GetCriterionBidLandscape(selector gads.Selector) ([]gads.CriterionBidLandscape, int64, error) {
+	m.record("GetCriterionBidLandscape", selector)
+	if m.GetCriterionBidLandscapeFunc != nil {
+		return m.GetCriterionBidLandscapeFunc(selector)
+	}
+	var r0 []gads.CriterionBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *DataService) GetCriterionBidLandscapeContext(ctx context.Context, selector gads.Selector) ([]gads.CriterionBidLandscape, int64, error) {
+	m.record("GetCriterionBidLandscapeContext", ctx, selector)
+	if m.GetCriterionBidLandscapeContextFunc != nil {
+		return m.GetCriterionBidLandscapeContextFunc(ctx, selector)
+	}
+	var r0 []gads.CriterionBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *DataService) QueryAdGroupBidLandscape(query string) ([]gads.AdGroupBidLandscape, int64, error) {
+	m.record("QueryAdGroupBidLandscape", query)
+	if m.QueryAdGroupBidLandscapeFunc != nil {
+		return m.QueryAdGroupBidLandscapeFunc(query)
+	}
+	var r0 []gads.AdGroupBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *DataService) QueryAdGroupBidLandscapeContext(ctx context.Context, query string) ([]gads.AdGroupBidLandscape, int64, error) {
+	m.record("QueryAdGroupBidLandscapeContext", ctx, query)
+	if m.QueryAdGroupBidLandscapeContextFunc != nil {
+		return m.QueryAdGroupBidLandscapeContextFunc(ctx, query)
+	}
+	var r0 []gads.AdGroupBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *DataService) QueryCriterionBidLandscape(query string) ([]gads.CriterionBidLandscape, int64, error) {
+	m.record("QueryCriterionBidLandscape", query)
+	if m.QueryCriterionBidLandscapeFunc != nil {
+		return m.QueryCriterionBidLandscapeFunc(query)
+	}
+	var r0 []gads.CriterionBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *DataService) QueryCriterionBidLandscapeContext(ctx context.Context, query string) ([]gads.CriterionBidLandscape, int64, error) {
+	m.record("QueryCriterionBidLandscapeContext", ctx, query)
+	if m.QueryCriterionBidLandscapeContextFunc != nil {
+		return m.QueryCriterionBidLandscapeContextFunc(ctx, query)
+	}
+	var r0 []gads.CriterionBidLandscape
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+// FeedService is a fake gads.FeedServicer.
+type FeedService struct {
+	Recorder
+
+	QueryFunc        func(string) ([]gads.Feed, int64, error)
+	QueryContextFunc func(context.Context, string) ([]gads.Feed, int64, error)
+}
+
+func (m *FeedService) Query(query string) ([]gads.Feed, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 []gads.Feed
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *FeedService) QueryContext(ctx context.Context, query string) ([]gads.Feed, int64, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 []gads.Feed
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+// LabelService is a fake gads.LabelServicer.
+type LabelService struct {
+	Recorder
+
+	GetFunc                     func(gads.Selector) ([]gads.Label, int64, error)
+	GetContextFunc              func(context.Context, gads.Selector) ([]gads.Label, int64, error)
+	MutateFunc                  func(gads.LabelOperations) ([]gads.Label, error)
+	MutateContextFunc           func(context.Context, gads.LabelOperations) ([]gads.Label, error)
+	MutateOperationsFunc        func([]gads.LabelOperation) ([]gads.Label, error)
+	MutateOperationsContextFunc func(context.Context, []gads.LabelOperation) ([]gads.Label, error)
+	QueryFunc                   func(string) ([]gads.Label, int64, error)
+	QueryContextFunc            func(context.Context, string) ([]gads.Label, int64, error)
+}
+
+func (m *LabelService) Get(selector gads.Selector) ([]gads.Label, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.Label
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *LabelService) GetContext(ctx context.Context, selector gads.Selector) ([]gads.Label, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.Label
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *LabelService) Mutate(labelOperations gads.LabelOperations) ([]gads.Label, error) {
+	m.record("Mutate", labelOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(labelOperations)
+	}
+	var r0 []gads.Label
+	var r1 error
+	return r0, r1
+}
+
+func (m *LabelService) MutateContext(ctx context.Context, labelOperations gads.LabelOperations) ([]gads.Label, error) {
+	m.record("MutateContext", ctx, labelOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, labelOperations)
+	}
+	var r0 []gads.Label
+	var r1 error
+	return r0, r1
+}
+
+func (m *LabelService) MutateOperations(operations []gads.LabelOperation) ([]gads.Label, error) {
+	m.record("MutateOperations", operations)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(operations)
+	}
+	var r0 []gads.Label
+	var r1 error
+	return r0, r1
+}
+
+func (m *LabelService) MutateOperationsContext(ctx context.Context, operations []gads.LabelOperation) ([]gads.Label, error) {
+	m.record("MutateOperationsContext", ctx, operations)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.Label
+	var r1 error
+	return r0, r1
+}
+
+func (m *LabelService) Query(query string) ([]gads.Label, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 []gads.Label
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *LabelService) QueryContext(ctx context.Context, query string) ([]gads.Label, int64, error) {
+	m.record("QueryContext", ctx, query)
+	if m.QueryContextFunc != nil {
+		return m.QueryContextFunc(ctx, query)
+	}
+	var r0 []gads.Label
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+// LocationCriterionService is a fake gads.LocationCriterionServicer.
+type LocationCriterionService struct {
+	Recorder
+
+	GetFunc        func(gads.Selector) (gads.LocationCriterions, error)
+	GetContextFunc func(context.Context, gads.Selector) (gads.LocationCriterions, error)
+}
+
+func (m *LocationCriterionService) Get(selector gads.Selector) (gads.LocationCriterions, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 gads.LocationCriterions
+	var r1 error
+	return r0, r1
+}
+
+func (m *LocationCriterionService) GetContext(ctx context.Context, selector gads.Selector) (gads.LocationCriterions, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 gads.LocationCriterions
+	var r1 error
+	return r0, r1
+}
+
+// ManagedCustomerService is a fake gads.ManagedCustomerServicer.
+type ManagedCustomerService struct {
+	Recorder
+
+	GetFunc                         func(gads.Selector) (gads.ManagedCustomerPage, int64, error)
+	GetContextFunc                  func(context.Context, gads.Selector) (gads.ManagedCustomerPage, int64, error)
+	MutateFunc                      func(gads.ManagedCustomerOperations) ([]gads.ManagedCustomer, error)
+	MutateContextFunc               func(context.Context, gads.ManagedCustomerOperations) ([]gads.ManagedCustomer, error)
+	MutateOperationsFunc            func([]gads.ManagedCustomerOperation) ([]gads.ManagedCustomer, error)
+	MutateOperationsContextFunc     func(context.Context, []gads.ManagedCustomerOperation) ([]gads.ManagedCustomer, error)
+	MutateLinkFunc                  func(gads.ManagedCustomerLinkOperations) ([]gads.ManagedCustomerLink, error)
+	MutateLinkContextFunc           func(context.Context, gads.ManagedCustomerLinkOperations) ([]gads.ManagedCustomerLink, error)
+	MutateLinkOperationsFunc        func([]gads.ManagedCustomerLinkOperation) ([]gads.ManagedCustomerLink, error)
+	MutateLinkOperationsContextFunc func(context.Context, []gads.ManagedCustomerLinkOperation) ([]gads.ManagedCustomerLink, error)
+}
+
+func (m *ManagedCustomerService) Get(selector gads.Selector) (gads.ManagedCustomerPage, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 gads.ManagedCustomerPage
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *ManagedCustomerService) GetContext(ctx context.Context, selector gads.Selector) (gads.ManagedCustomerPage, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 gads.ManagedCustomerPage
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *ManagedCustomerService) Mutate(managedCustomerOperations gads.ManagedCustomerOperations) ([]gads.ManagedCustomer, error) {
+	m.record("Mutate", managedCustomerOperations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(managedCustomerOperations)
+	}
+	var r0 []gads.ManagedCustomer
+	var r1 error
+	return r0, r1
+}
+
+func (m *ManagedCustomerService) MutateContext(ctx context.Context, managedCustomerOperations gads.ManagedCustomerOperations) ([]gads.ManagedCustomer, error) {
+	m.record("MutateContext", ctx, managedCustomerOperations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, managedCustomerOperations)
+	}
+	var r0 []gads.ManagedCustomer
+	var r1 error
+	return r0, r1
+}
+
+func (m *ManagedCustomerService) MutateOperations(operations []gads.ManagedCustomerOperation) ([]gads.ManagedCustomer, error) {
+	m.record("MutateOperations", operations)
+	if m.MutateOperationsFunc != nil {
+		return m.MutateOperationsFunc(operations)
+	}
+	var r0 []gads.ManagedCustomer
+	var r1 error
+	return r0, r1
+}
+
+func (m *ManagedCustomerService) MutateOperationsContext(ctx context.Context, operations []gads.ManagedCustomerOperation) ([]gads.ManagedCustomer, error) {
+	m.record("MutateOperationsContext", ctx, operations)
+	if m.MutateOperationsContextFunc != nil {
+		return m.MutateOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.ManagedCustomer
+	var r1 error
+	return r0, r1
+}
+
+func (m *ManagedCustomerService) MutateLink(managedCustomerLinkOperations gads.ManagedCustomerLinkOperations) ([]gads.ManagedCustomerLink, error) {
+	m.record("MutateLink", managedCustomerLinkOperations)
+	if m.MutateLinkFunc != nil {
+		return m.MutateLinkFunc(managedCustomerLinkOperations)
+	}
+	var r0 []gads.ManagedCustomerLink
+	var r1 error
+	return r0, r1
+}
+
+func (m *ManagedCustomerService) MutateLinkContext(ctx context.Context, managedCustomerLinkOperations gads.ManagedCustomerLinkOperations) ([]gads.ManagedCustomerLink, error) {
+	m.record("MutateLinkContext", ctx, managedCustomerLinkOperations)
+	if m.MutateLinkContextFunc != nil {
+		return m.MutateLinkContextFunc(ctx, managedCustomerLinkOperations)
+	}
+	var r0 []gads.ManagedCustomerLink
+	var r1 error
+	return r0, r1
+}
+
+func (m *ManagedCustomerService) MutateLinkOperations(operations []gads.ManagedCustomerLinkOperation) ([]gads.ManagedCustomerLink, error) {
+	m.record("MutateLinkOperations", operations)
+	if m.MutateLinkOperationsFunc != nil {
+		return m.MutateLinkOperationsFunc(operations)
+	}
+	var r0 []gads.ManagedCustomerLink
+	var r1 error
+	return r0, r1
+}
+
+func (m *ManagedCustomerService) MutateLinkOperationsContext(ctx context.Context, operations []gads.ManagedCustomerLinkOperation) ([]gads.ManagedCustomerLink, error) {
+	m.record("MutateLinkOperationsContext", ctx, operations)
+	if m.MutateLinkOperationsContextFunc != nil {
+		return m.MutateLinkOperationsContextFunc(ctx, operations)
+	}
+	var r0 []gads.ManagedCustomerLink
+	var r1 error
+	return r0, r1
+}
+
+// MediaService is a fake gads.MediaServicer.
+type MediaService struct {
+	Recorder
+
+	GetFunc           func(gads.Selector) ([]gads.Media, int64, error)
+	GetContextFunc    func(context.Context, gads.Selector) ([]gads.Media, int64, error)
+	QueryFunc         func(string) ([]gads.Media, int64, error)
+	UploadFunc        func([]gads.Media) ([]gads.Media, error)
+	UploadContextFunc func(context.Context, []gads.Media) ([]gads.Media, error)
+}
+
+func (m *MediaService) Get(selector gads.Selector) ([]gads.Media, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.Media
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *MediaService) GetContext(ctx context.Context, selector gads.Selector) ([]gads.Media, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.Media
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *MediaService) Query(query string) ([]gads.Media, int64, error) {
+	m.record("Query", query)
+	if m.QueryFunc != nil {
+		return m.QueryFunc(query)
+	}
+	var r0 []gads.Media
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *MediaService) Upload(medias []gads.Media) ([]gads.Media, error) {
+	m.record("Upload", medias)
+	if m.UploadFunc != nil {
+		return m.UploadFunc(medias)
+	}
+	var r0 []gads.Media
+	var r1 error
+	return r0, r1
+}
+
+func (m *MediaService) UploadContext(ctx context.Context, medias []gads.Media) ([]gads.Media, error) {
+	m.record("UploadContext", ctx, medias)
+	if m.UploadContextFunc != nil {
+		return m.UploadContextFunc(ctx, medias)
+	}
+	var r0 []gads.Media
+	var r1 error
+	return r0, r1
+}
+
+// ReportDefinitionService is a fake gads.ReportDefinitionServicer.
+type ReportDefinitionService struct {
+	Recorder
+
+	GetReportFieldsFunc        func(string) ([]gads.ReportDefinitionField, error)
+	GetReportFieldsContextFunc func(context.Context, string) ([]gads.ReportDefinitionField, error)
+}
+
+func (m *ReportDefinitionService) GetReportFields(report string) ([]gads.ReportDefinitionField, error) {
+	m.record("GetReportFields", report)
+	if m.GetReportFieldsFunc != nil {
+		return m.GetReportFieldsFunc(report)
+	}
+	var r0 []gads.ReportDefinitionField
+	var r1 error
+	return r0, r1
+}
+
+func (m *ReportDefinitionService) GetReportFieldsContext(ctx context.Context, report string) ([]gads.ReportDefinitionField, error) {
+	m.record("GetReportFieldsContext", ctx, report)
+	if m.GetReportFieldsContextFunc != nil {
+		return m.GetReportFieldsContextFunc(ctx, report)
+	}
+	var r0 []gads.ReportDefinitionField
+	var r1 error
+	return r0, r1
+}
+
+// ReportDownloadService is a fake gads.ReportDownloadServicer.
+type ReportDownloadService struct {
+	Recorder
+
+	GetFunc               func(gads.ReportDefinition) (interface{}, error)
+	GetContextFunc        func(context.Context, gads.ReportDefinition) (interface{}, error)
+	StreamAWQLFunc        func(string, string) (io.ReadCloser, error)
+	StreamAWQLContextFunc func(context.Context, string, string) (io.ReadCloser, error)
+	AWQLFunc              func(string, string) (interface{}, error)
+	AWQLContextFunc       func(context.Context, string, string) (interface{}, error)
+}
+
+func (m *ReportDownloadService) Get(reportDefinition gads.ReportDefinition) (interface{}, error) {
+	m.record("Get", reportDefinition)
+	if m.GetFunc != nil {
+		return m.GetFunc(reportDefinition)
+	}
+	var r0 interface{}
+	var r1 error
+	return r0, r1
+}
+
+func (m *ReportDownloadService) GetContext(ctx context.Context, reportDefinition gads.ReportDefinition) (interface{}, error) {
+	m.record("GetContext", ctx, reportDefinition)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, reportDefinition)
+	}
+	var r0 interface{}
+	var r1 error
+	return r0, r1
+}
+
+func (m *ReportDownloadService) StreamAWQL(awql string, fmt string) (io.ReadCloser, error) {
+	m.record("StreamAWQL", awql, fmt)
+	if m.StreamAWQLFunc != nil {
+		return m.StreamAWQLFunc(awql, fmt)
+	}
+	var r0 io.ReadCloser
+	var r1 error
+	return r0, r1
+}
+
+func (m *ReportDownloadService) StreamAWQLContext(ctx context.Context, awql string, fmt string) (io.ReadCloser, error) {
+	m.record("StreamAWQLContext", ctx, awql, fmt)
+	if m.StreamAWQLContextFunc != nil {
+		return m.StreamAWQLContextFunc(ctx, awql, fmt)
+	}
+	var r0 io.ReadCloser
+	var r1 error
+	return r0, r1
+}
+
+func (m *ReportDownloadService) AWQL(awql string, fmt string) (interface{}, error) {
+	m.record("AWQL", awql, fmt)
+	if m.AWQLFunc != nil {
+		return m.AWQLFunc(awql, fmt)
+	}
+	var r0 interface{}
+	var r1 error
+	return r0, r1
+}
+
+func (m *ReportDownloadService) AWQLContext(ctx context.Context, awql string, fmt string) (interface{}, error) {
+	m.record("AWQLContext", ctx, awql, fmt)
+	if m.AWQLContextFunc != nil {
+		return m.AWQLContextFunc(ctx, awql, fmt)
+	}
+	var r0 interface{}
+	var r1 error
+	return r0, r1
+}
+
+// SharedCriterionService is a fake gads.SharedCriterionServicer.
+type SharedCriterionService struct {
+	Recorder
+
+	GetFunc           func(gads.Selector) ([]gads.SharedCriterion, int64, error)
+	GetContextFunc    func(context.Context, gads.Selector) ([]gads.SharedCriterion, int64, error)
+	MutateFunc        func([]gads.SharedCriterionOperation) error
+	MutateContextFunc func(context.Context, []gads.SharedCriterionOperation) error
+}
+
+func (m *SharedCriterionService) Get(selector gads.Selector) ([]gads.SharedCriterion, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.SharedCriterion
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *SharedCriterionService) GetContext(ctx context.Context, selector gads.Selector) ([]gads.SharedCriterion, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.SharedCriterion
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *SharedCriterionService) Mutate(operations []gads.SharedCriterionOperation) error {
+	m.record("Mutate", operations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(operations)
+	}
+	var r0 error
+	return r0
+}
+
+func (m *SharedCriterionService) MutateContext(ctx context.Context, operations []gads.SharedCriterionOperation) error {
+	m.record("MutateContext", ctx, operations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, operations)
+	}
+	var r0 error
+	return r0
+}
+
+// SharedSetService is a fake gads.SharedSetServicer.
+type SharedSetService struct {
+	Recorder
+
+	GetFunc           func(gads.Selector) ([]gads.SharedSet, int64, error)
+	GetContextFunc    func(context.Context, gads.Selector) ([]gads.SharedSet, int64, error)
+	MutateFunc        func([]gads.SharedSetOperation) ([]gads.SharedSet, error)
+	MutateContextFunc func(context.Context, []gads.SharedSetOperation) ([]gads.SharedSet, error)
+}
+
+func (m *SharedSetService) Get(selector gads.Selector) ([]gads.SharedSet, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.SharedSet
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *SharedSetService) GetContext(ctx context.Context, selector gads.Selector) ([]gads.SharedSet, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.SharedSet
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *SharedSetService) Mutate(operations []gads.SharedSetOperation) ([]gads.SharedSet, error) {
+	m.record("Mutate", operations)
+	if m.MutateFunc != nil {
+		return m.MutateFunc(operations)
+	}
+	var r0 []gads.SharedSet
+	var r1 error
+	return r0, r1
+}
+
+func (m *SharedSetService) MutateContext(ctx context.Context, operations []gads.SharedSetOperation) ([]gads.SharedSet, error) {
+	m.record("MutateContext", ctx, operations)
+	if m.MutateContextFunc != nil {
+		return m.MutateContextFunc(ctx, operations)
+	}
+	var r0 []gads.SharedSet
+	var r1 error
+	return r0, r1
+}
+
+// TargetingIdeaService is a fake gads.TargetingIdeaServicer.
+type TargetingIdeaService struct {
+	Recorder
+
+	GetFunc        func(gads.TargetingIdeaSelector) ([]gads.TargetingIdeas, int64, error)
+	GetContextFunc func(context.Context, gads.TargetingIdeaSelector) ([]gads.TargetingIdeas, int64, error)
+}
+
+func (m *TargetingIdeaService) Get(selector gads.TargetingIdeaSelector) ([]gads.TargetingIdeas, int64, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.TargetingIdeas
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+func (m *TargetingIdeaService) GetContext(ctx context.Context, selector gads.TargetingIdeaSelector) ([]gads.TargetingIdeas, int64, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.TargetingIdeas
+	var r1 int64
+	var r2 error
+	return r0, r1, r2
+}
+
+// TrafficEstimatorService is a fake gads.TrafficEstimatorServicer.
+type TrafficEstimatorService struct {
+	Recorder
+
+	GetFunc        func(gads.TrafficEstimatorSelector) ([]gads.CampaignEstimate, error)
+	GetContextFunc func(context.Context, gads.TrafficEstimatorSelector) ([]gads.CampaignEstimate, error)
+}
+
+func (m *TrafficEstimatorService) Get(selector gads.TrafficEstimatorSelector) ([]gads.CampaignEstimate, error) {
+	m.record("Get", selector)
+	if m.GetFunc != nil {
+		return m.GetFunc(selector)
+	}
+	var r0 []gads.CampaignEstimate
+	var r1 error
+	return r0, r1
+}
+
+func (m *TrafficEstimatorService) GetContext(ctx context.Context, selector gads.TrafficEstimatorSelector) ([]gads.CampaignEstimate, error) {
+	m.record("GetContext", ctx, selector)
+	if m.GetContextFunc != nil {
+		return m.GetContextFunc(ctx, selector)
+	}
+	var r0 []gads.CampaignEstimate
+	var r1 error
+	return r0, r1
+}
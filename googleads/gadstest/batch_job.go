@@ -0,0 +1,90 @@
+package gadstest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+type batchJob struct {
+	uploaded []byte
+	result   string
+	ready    bool
+}
+
+// BatchJobUploadURL returns the upload URL of batch job id.  Operations
+// uploaded to it are returned by BatchJobUpload.
+func (s *Server) BatchJobUploadURL(id string) string {
+	return s.URL + batchJobPrefix + id + "/upload"
+}
+
+// BatchJobDownloadURL returns the download URL of batch job id, serving the
+// result set with SetBatchJobResult.
+func (s *Server) BatchJobDownloadURL(id string) string {
+	return s.URL + batchJobPrefix + id + "/download"
+}
+
+// BatchJobUpload returns the operations uploaded to batch job id so far.
+func (s *Server) BatchJobUpload(id string) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if job, ok := s.batchJobs[id]; ok {
+		return append([]byte(nil), job.uploaded...)
+	}
+	return nil
+}
+
+// SetBatchJobResult sets the document served by the download URL of batch
+// job id, e.g. Rval("mutate", ...).
+func (s *Server) SetBatchJobResult(id, result string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job := s.batchJob(id)
+	job.result = result
+	job.ready = true
+}
+
+func (s *Server) batchJob(id string) *batchJob {
+	job, ok := s.batchJobs[id]
+	if !ok {
+		job = &batchJob{}
+		s.batchJobs[id] = job
+	}
+	return job
+}
+
+func (s *Server) serveBatchJob(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, batchJobPrefix), "/")
+	if len(parts) != 2 {
+		http.NotFound(w, r)
+		return
+	}
+	id, kind := parts[0], parts[1]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job := s.batchJob(id)
+
+	switch {
+	case kind == "upload" && r.Method == http.MethodPost && r.Header.Get("x-goog-resumable") == "start":
+		w.Header().Set("Location", s.BatchJobUploadURL(id)+"?upload_id="+id)
+		w.WriteHeader(http.StatusCreated)
+	case kind == "upload" && r.Method == http.MethodPut:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		job.uploaded = append(job.uploaded, body...)
+		w.WriteHeader(http.StatusOK)
+	case kind == "download" && r.Method == http.MethodGet:
+		if !job.ready {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/xml")
+		w.Write([]byte(job.result))
+	default:
+		http.NotFound(w, r)
+	}
+}
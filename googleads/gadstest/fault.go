@@ -0,0 +1,109 @@
+package gadstest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// A Fault is sent as a SOAP fault carrying an ApiExceptionFault.
+type Fault struct {
+	// Status is the HTTP status code of the response, 500 when zero.
+	Status int
+	// Message is the fault string, derived from Errors when empty.
+	Message string
+	Errors  []ApiError
+}
+
+// An ApiError is one of the errors of an ApiExceptionFault.
+type ApiError struct {
+	// Type is the xsi:type of the error, e.g. "RateExceededError".
+	Type        string
+	FieldPath   string
+	Trigger     string
+	ErrorString string
+	Reason      string
+	// Fields holds additional elements of the error type, e.g.
+	// {"retryAfterSeconds": "30"} for a RateExceededError.
+	Fields map[string]string
+}
+
+func (e ApiError) errorString() string {
+	if e.ErrorString != "" {
+		return e.ErrorString
+	}
+	return e.Type + "." + e.Reason
+}
+
+func (f *Fault) Error() string {
+	if f.Message != "" || len(f.Errors) == 0 {
+		return f.Message
+	}
+	msgs := make([]string, len(f.Errors))
+	for i, e := range f.Errors {
+		msgs[i] = fmt.Sprintf("%s @ %s", e.errorString(), e.FieldPath)
+	}
+	return "[" + strings.Join(msgs, ", ") + "]"
+}
+
+// write encodes e as an element named name.
+func (e ApiError) write(buf *bytes.Buffer, name string) {
+	buf.WriteString("<" + name + ` xmlns:xsi="` + xsiNamespace + `" xsi:type="` + e.Type + `">`)
+	buf.WriteString("<fieldPath>" + xmlEscape(e.FieldPath) + "</fieldPath>")
+	buf.WriteString("<trigger>" + xmlEscape(e.Trigger) + "</trigger>")
+	buf.WriteString("<errorString>" + xmlEscape(e.errorString()) + "</errorString>")
+	buf.WriteString("<ApiError.Type>" + e.Type + "</ApiError.Type>")
+	buf.WriteString("<reason>" + xmlEscape(e.Reason) + "</reason>")
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(buf, "<%s>%s</%s>", name, xmlEscape(e.Fields[name]), name)
+	}
+	buf.WriteString("</" + name + ">")
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+func (s *Server) writeFault(w http.ResponseWriter, req *Request, f *Fault) {
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8"?>`)
+	buf.WriteString(`<soap:Envelope xmlns:soap="` + soapNamespace + `">`)
+	if req.Namespace != "" {
+		buf.WriteString(`<soap:Header><ResponseHeader xmlns="` + req.Namespace + `">`)
+		fmt.Fprintf(&buf, "<requestId>%s</requestId>", s.nextRequestId())
+		fmt.Fprintf(&buf, "<serviceName>%s</serviceName>", req.Service)
+		fmt.Fprintf(&buf, "<methodName>%s</methodName>", req.Method)
+		buf.WriteString("<operations>0</operations><responseTime>1</responseTime>")
+		buf.WriteString(`</ResponseHeader></soap:Header>`)
+	}
+	buf.WriteString(`<soap:Body><soap:Fault><faultcode>soap:Server</faultcode>`)
+	buf.WriteString("<faultstring>" + xmlEscape(f.Error()) + "</faultstring>")
+	if len(f.Errors) > 0 {
+		buf.WriteString(`<detail><ApiExceptionFault xmlns="` + req.Namespace + `">`)
+		buf.WriteString("<message>" + xmlEscape(f.Error()) + "</message>")
+		buf.WriteString("<ApplicationException.Type>ApiException</ApplicationException.Type>")
+		for _, e := range f.Errors {
+			e.write(&buf, "errors")
+		}
+		buf.WriteString("</ApiExceptionFault></detail>")
+	}
+	buf.WriteString("</soap:Fault></soap:Body></soap:Envelope>")
+
+	status := f.Status
+	if status == 0 {
+		status = http.StatusInternalServerError
+	}
+	w.Header().Set("Content-Type", "text/xml; charset=UTF-8")
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
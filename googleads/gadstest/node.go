@@ -0,0 +1,234 @@
+package gadstest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// node is a generic XML element.  The simulator keeps entities as nodes so
+// it can store whatever the client sends without knowing the Go types.
+type node struct {
+	name     string
+	attrs    []xml.Attr
+	text     string
+	children []*node
+}
+
+// parseNode parses the first element of data.
+func parseNode(data []byte) (*node, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		} else if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return decodeNode(dec, start)
+		}
+	}
+}
+
+func decodeNode(dec *xml.Decoder, start xml.StartElement) (*node, error) {
+	n := &node{name: start.Name.Local}
+	for _, a := range start.Attr {
+		// namespace declarations are written again when encoding
+		if a.Name.Space == "xmlns" || a.Name.Local == "xmlns" {
+			continue
+		}
+		n.attrs = append(n.attrs, a)
+	}
+	var text strings.Builder
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			child, err := decodeNode(dec, t)
+			if err != nil {
+				return nil, err
+			}
+			n.children = append(n.children, child)
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if len(n.children) == 0 {
+				n.text = strings.TrimSpace(text.String())
+			}
+			return n, nil
+		}
+	}
+}
+
+func (n *node) clone() *node {
+	if n == nil {
+		return nil
+	}
+	c := &node{
+		name:  n.name,
+		attrs: append([]xml.Attr(nil), n.attrs...),
+		text:  n.text,
+	}
+	for _, child := range n.children {
+		c.children = append(c.children, child.clone())
+	}
+	return c
+}
+
+// xsiType returns the xsi:type attribute of n.
+func (n *node) xsiType() string {
+	for _, a := range n.attrs {
+		if a.Name.Local == "type" && (a.Name.Space == xsiNamespace || a.Name.Space == "XMLSchema-instance") {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (n *node) child(name string) *node {
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (n *node) childrenNamed(name string) (nodes []*node) {
+	for _, c := range n.children {
+		if c.name == name {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// find returns the first element at the slash separated path below n.
+// Every element matching a step is searched, since the client splits
+// structs like budget>amount and budget>budgetId into separate elements.
+func (n *node) find(path string) *node {
+	name, rest := path, ""
+	if i := strings.IndexByte(path, '/'); i >= 0 {
+		name, rest = path[:i], path[i+1:]
+	}
+	for _, c := range n.children {
+		if c.name != name {
+			continue
+		}
+		if rest == "" {
+			return c
+		}
+		if m := c.find(rest); m != nil {
+			return m
+		}
+	}
+	return nil
+}
+
+// values returns the text of every element at path below n.
+func (n *node) values(path string) (values []string) {
+	nodes := []*node{n}
+	for _, name := range strings.Split(path, "/") {
+		var next []*node
+		for _, m := range nodes {
+			next = append(next, m.childrenNamed(name)...)
+		}
+		nodes = next
+	}
+	for _, m := range nodes {
+		values = append(values, m.text)
+	}
+	return values
+}
+
+// value returns the text of the first element at path below n.
+func (n *node) value(path string) string {
+	if m := n.find(path); m != nil {
+		return m.text
+	}
+	return ""
+}
+
+// set sets the text of the element at path below n, creating missing
+// elements.
+func (n *node) set(path, value string) {
+	for _, name := range strings.Split(path, "/") {
+		c := n.child(name)
+		if c == nil {
+			c = &node{name: name}
+			n.children = append(n.children, c)
+		}
+		n = c
+	}
+	n.text = value
+}
+
+// merge applies update to n the way a SET operation applies its operand:
+// elements of update replace the same-named children of n, except that an
+// element with children that occurs once in update is merged into the
+// first child of n with that name.  Empty elements, which the client sends
+// for unset fields, are ignored.
+func (n *node) merge(update *node) {
+	var children []*node
+	counts := map[string]int{}
+	for _, c := range update.children {
+		if c.text != "" || len(c.children) > 0 || len(c.attrs) > 0 {
+			children = append(children, c)
+			counts[c.name]++
+		}
+	}
+	replaced := map[string]bool{}
+	for _, c := range children {
+		if old := n.child(c.name); old != nil && counts[c.name] == 1 && len(c.children) > 0 && len(old.children) > 0 {
+			old.merge(c)
+			if len(c.attrs) > 0 {
+				old.attrs = append([]xml.Attr(nil), c.attrs...)
+			}
+			continue
+		}
+		if !replaced[c.name] {
+			replaced[c.name] = true
+			kept := n.children[:0]
+			for _, old := range n.children {
+				if old.name != c.name {
+					kept = append(kept, old)
+				}
+			}
+			n.children = kept
+		}
+		n.children = append(n.children, c.clone())
+	}
+}
+
+// encode writes n as name, declaring the xsi namespace where needed.
+func (n *node) encode(buf *bytes.Buffer, name string) {
+	buf.WriteString("<" + name)
+	for _, a := range n.attrs {
+		if a.Name.Space == xsiNamespace || a.Name.Space == "XMLSchema-instance" {
+			buf.WriteString(` xmlns:xsi="` + xsiNamespace + `" xsi:` + a.Name.Local + `="` + xmlEscape(a.Value) + `"`)
+		} else if a.Name.Space == "" {
+			buf.WriteString(" " + a.Name.Local + `="` + xmlEscape(a.Value) + `"`)
+		}
+	}
+	buf.WriteString(">")
+	if len(n.children) == 0 {
+		buf.WriteString(xmlEscape(n.text))
+	}
+	for _, c := range n.children {
+		c.encode(buf, c.name)
+	}
+	buf.WriteString("</" + name + ">")
+}
+
+func (n *node) String() string {
+	var buf bytes.Buffer
+	n.encode(&buf, n.name)
+	return buf.String()
+}
@@ -0,0 +1,389 @@
+package gadstest
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// selector is a parsed Selector or AWQL query.
+type selector struct {
+	fields     []string
+	predicates []predicate
+	ordering   []ordering
+	offset     int
+	limit      int // 0 means unlimited
+}
+
+type predicate struct {
+	field    string
+	operator string
+	values   []string
+}
+
+type ordering struct {
+	field      string
+	descending bool
+}
+
+// parseSelector reads the serviceSelector or selector element of a get
+// request.
+func parseSelector(get *node) selector {
+	var sel selector
+	if len(get.children) == 0 {
+		return sel
+	}
+	s := get.children[0]
+	sel.fields = s.values("fields")
+	for _, p := range s.childrenNamed("predicates") {
+		sel.predicates = append(sel.predicates, predicate{
+			field:    p.value("field"),
+			operator: p.value("operator"),
+			values:   p.values("values"),
+		})
+	}
+	for _, o := range s.childrenNamed("ordering") {
+		sel.ordering = append(sel.ordering, ordering{
+			field:      o.value("field"),
+			descending: o.value("sortOrder") == "DESCENDING",
+		})
+	}
+	if paging := s.child("paging"); paging != nil {
+		sel.offset, _ = strconv.Atoi(paging.value("startIndex"))
+		sel.limit, _ = strconv.Atoi(paging.value("numberResults"))
+	}
+	return sel
+}
+
+var awqlOperators = map[string]string{
+	"=":  "EQUALS",
+	"!=": "NOT_EQUALS",
+	">":  "GREATER_THAN",
+	">=": "GREATER_THAN_EQUALS",
+	"<":  "LESS_THAN",
+	"<=": "LESS_THAN_EQUALS",
+}
+
+// parseAWQL parses the subset of AWQL accepted by the query methods of the
+// services: SELECT, WHERE conditions joined by AND, ORDER BY and LIMIT.
+func parseAWQL(query string) (sel selector, err error) {
+	toks, err := tokenizeAWQL(query)
+	if err != nil {
+		return sel, err
+	}
+	p := &awqlParser{toks: toks}
+	if !p.keyword("SELECT") {
+		return sel, fmt.Errorf("expected SELECT")
+	}
+	for {
+		f := p.next()
+		if f == "" {
+			return sel, fmt.Errorf("expected field name")
+		}
+		sel.fields = append(sel.fields, f)
+		if !p.accept(",") {
+			break
+		}
+	}
+	if p.keyword("FROM") {
+		p.next()
+	}
+	if p.keyword("WHERE") {
+		for {
+			pred := predicate{field: p.next()}
+			op := p.next()
+			if sym, ok := awqlOperators[op]; ok {
+				op = sym
+			}
+			pred.operator = strings.ToUpper(op)
+			if p.accept("[") {
+				for !p.accept("]") {
+					if p.peek() == "" {
+						return sel, fmt.Errorf("unterminated value list")
+					}
+					if v := p.next(); v != "," {
+						pred.values = append(pred.values, unquote(v))
+					}
+				}
+			} else {
+				pred.values = []string{unquote(p.next())}
+			}
+			sel.predicates = append(sel.predicates, pred)
+			if !p.keyword("AND") {
+				break
+			}
+		}
+	}
+	if p.keyword("DURING") {
+		for p.peek() != "" && !isKeyword(p.peek(), "ORDER", "LIMIT") {
+			p.next()
+		}
+	}
+	if p.keyword("ORDER") {
+		if !p.keyword("BY") {
+			return sel, fmt.Errorf("expected BY")
+		}
+		for {
+			o := ordering{field: p.next()}
+			if p.keyword("DESC") {
+				o.descending = true
+			} else {
+				p.keyword("ASC")
+			}
+			sel.ordering = append(sel.ordering, o)
+			if !p.accept(",") {
+				break
+			}
+		}
+	}
+	if p.keyword("LIMIT") {
+		if sel.offset, err = strconv.Atoi(p.next()); err != nil {
+			return sel, fmt.Errorf("invalid LIMIT: %v", err)
+		}
+		if !p.accept(",") {
+			return sel, fmt.Errorf("expected LIMIT start, count")
+		}
+		if sel.limit, err = strconv.Atoi(p.next()); err != nil {
+			return sel, fmt.Errorf("invalid LIMIT: %v", err)
+		}
+	}
+	if rest := p.peek(); rest != "" {
+		return sel, fmt.Errorf("unexpected %q", rest)
+	}
+	return sel, nil
+}
+
+type awqlParser struct {
+	toks []string
+	pos  int
+}
+
+func (p *awqlParser) peek() string {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return ""
+}
+
+func (p *awqlParser) next() string {
+	t := p.peek()
+	if t != "" {
+		p.pos++
+	}
+	return t
+}
+
+func (p *awqlParser) accept(tok string) bool {
+	if p.peek() == tok {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *awqlParser) keyword(kw string) bool {
+	if isKeyword(p.peek(), kw) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func isKeyword(tok string, kws ...string) bool {
+	for _, kw := range kws {
+		if strings.EqualFold(tok, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+func tokenizeAWQL(s string) (toks []string, err error) {
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '\'' || c == '"':
+			j := i + 1
+			for j < len(s) && s[j] != c {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			toks = append(toks, s[i:j+1])
+			i = j + 1
+		case strings.IndexByte(",[]", c) >= 0:
+			toks = append(toks, string(c))
+			i++
+		case strings.IndexByte("=!<>", c) >= 0:
+			j := i + 1
+			if j < len(s) && s[j] == '=' {
+				j++
+			}
+			toks = append(toks, s[i:j])
+			i = j
+		default:
+			j := i
+			for j < len(s) && !unicode.IsSpace(rune(s[j])) && strings.IndexByte(",[]=!<>'\"", s[j]) < 0 {
+				j++
+			}
+			toks = append(toks, s[i:j])
+			i = j
+		}
+	}
+	return toks, nil
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		s = s[1 : len(s)-1]
+		return strings.NewReplacer(`\'`, `'`, `\"`, `"`, `\\`, `\`).Replace(s)
+	}
+	return s
+}
+
+// compareValues compares numerically when both values are numbers.
+func compareValues(a, b string) int {
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// matches reports whether an entity with the given values for the field of
+// p satisfies p.
+func (p predicate) matches(values []string) (bool, error) {
+	any := func(f func(v string) bool) bool {
+		for _, v := range values {
+			if f(v) {
+				return true
+			}
+		}
+		return false
+	}
+	in := func(v string) bool {
+		for _, w := range p.values {
+			if compareValues(v, w) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+	first := ""
+	if len(p.values) > 0 {
+		first = p.values[0]
+	}
+	lower := strings.ToLower
+	switch p.operator {
+	case "EQUALS":
+		return any(func(v string) bool { return compareValues(v, first) == 0 }), nil
+	case "NOT_EQUALS":
+		return !any(func(v string) bool { return compareValues(v, first) == 0 }), nil
+	case "IN", "CONTAINS_ANY":
+		return any(in), nil
+	case "NOT_IN", "CONTAINS_NONE":
+		return !any(in), nil
+	case "CONTAINS_ALL":
+		for _, w := range p.values {
+			if !any(func(v string) bool { return compareValues(v, w) == 0 }) {
+				return false, nil
+			}
+		}
+		return true, nil
+	case "GREATER_THAN":
+		return any(func(v string) bool { return compareValues(v, first) > 0 }), nil
+	case "GREATER_THAN_EQUALS":
+		return any(func(v string) bool { return compareValues(v, first) >= 0 }), nil
+	case "LESS_THAN":
+		return any(func(v string) bool { return compareValues(v, first) < 0 }), nil
+	case "LESS_THAN_EQUALS":
+		return any(func(v string) bool { return compareValues(v, first) <= 0 }), nil
+	case "STARTS_WITH":
+		return any(func(v string) bool { return strings.HasPrefix(v, first) }), nil
+	case "STARTS_WITH_IGNORE_CASE":
+		return any(func(v string) bool { return strings.HasPrefix(lower(v), lower(first)) }), nil
+	case "CONTAINS":
+		return any(func(v string) bool { return strings.Contains(v, first) }), nil
+	case "CONTAINS_IGNORE_CASE":
+		return any(func(v string) bool { return strings.Contains(lower(v), lower(first)) }), nil
+	case "DOES_NOT_CONTAIN":
+		return !any(func(v string) bool { return strings.Contains(v, first) }), nil
+	case "DOES_NOT_CONTAIN_IGNORE_CASE":
+		return !any(func(v string) bool { return strings.Contains(lower(v), lower(first)) }), nil
+	}
+	return false, fmt.Errorf("unsupported operator %q", p.operator)
+}
+
+// apply filters, orders and pages entities according to sel.
+func (sel selector) apply(spec *entitySpec, entities []*node) (page []*node, total int, errs []ApiError) {
+	for _, p := range sel.predicates {
+		if _, ok := spec.fields[p.field]; !ok {
+			errs = append(errs, ApiError{Type: "SelectorError", Reason: "INVALID_PREDICATE_FIELD_NAME", Trigger: p.field, FieldPath: "serviceSelector"})
+		}
+	}
+	for _, o := range sel.ordering {
+		if _, ok := spec.fields[o.field]; !ok {
+			errs = append(errs, ApiError{Type: "SelectorError", Reason: "INVALID_SORT_FIELD_NAME", Trigger: o.field, FieldPath: "serviceSelector"})
+		}
+	}
+	if errs != nil {
+		return nil, 0, errs
+	}
+
+	var matched []*node
+	for _, e := range entities {
+		ok := true
+		for _, p := range sel.predicates {
+			m, err := p.matches(e.values(spec.fields[p.field]))
+			if err != nil {
+				return nil, 0, []ApiError{{Type: "SelectorError", Reason: "INVALID_PREDICATE_OPERATOR", Trigger: p.operator, FieldPath: "serviceSelector"}}
+			}
+			if !m {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matched = append(matched, e)
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		for _, o := range sel.ordering {
+			path := spec.fields[o.field]
+			c := compareValues(matched[i].value(path), matched[j].value(path))
+			if o.descending {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	total = len(matched)
+	if sel.offset >= len(matched) {
+		return nil, total, nil
+	}
+	matched = matched[sel.offset:]
+	if sel.limit > 0 && sel.limit < len(matched) {
+		matched = matched[:sel.limit]
+	}
+	return matched, total, nil
+}
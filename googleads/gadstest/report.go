@@ -0,0 +1,111 @@
+package gadstest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// A ReportRequest is a report download received by the Server.
+type ReportRequest struct {
+	// Query is the AWQL query of the report, if any.
+	Query string
+	// Definition is the XML report definition, if any.
+	Definition string
+	// Format is the requested download format, e.g. "CSV".
+	Format string
+	// Header holds the HTTP headers, e.g. developerToken and
+	// clientCustomerId.
+	Header http.Header
+}
+
+// A ReportHandlerFunc answers a report download with the report contents or
+// an error.  A *ReportError is sent as a reportDownloadError, any other
+// error as an internal error.
+type ReportHandlerFunc func(req *ReportRequest) (string, error)
+
+// A ReportError is sent as a reportDownloadError document.
+type ReportError struct {
+	// Status is the HTTP status code, 400 when zero.
+	Status int
+	// Type is the error type and reason, e.g.
+	// "AuthorizationError.USER_PERMISSION_DENIED".
+	Type      string
+	Trigger   string
+	FieldPath string
+}
+
+func (e *ReportError) Error() string {
+	return e.Type
+}
+
+// HandleReport registers h for report downloads.
+func (s *Server) HandleReport(h ReportHandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reportHandler = h
+}
+
+// RespondReport makes every report download return report.
+func (s *Server) RespondReport(report string) {
+	s.HandleReport(func(*ReportRequest) (string, error) {
+		return report, nil
+	})
+}
+
+// ReportRequests returns the report downloads received so far, in order.
+func (s *Server) ReportRequests() []*ReportRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*ReportRequest(nil), s.reports...)
+}
+
+func (s *Server) serveReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.NotFound(w, r)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := &ReportRequest{
+		Query:      r.PostForm.Get("__rdquery"),
+		Definition: r.PostForm.Get("__rdxml"),
+		Format:     r.PostForm.Get("__fmt"),
+		Header:     r.Header.Clone(),
+	}
+
+	s.mu.Lock()
+	s.reports = append(s.reports, req)
+	h := s.reportHandler
+	s.mu.Unlock()
+
+	if h == nil {
+		writeReportError(w, &ReportError{Status: http.StatusInternalServerError, Type: "gadstest.NO_REPORT_HANDLER"})
+		return
+	}
+	report, err := h(req)
+	if err != nil {
+		reportErr, ok := err.(*ReportError)
+		if !ok {
+			reportErr = &ReportError{Status: http.StatusInternalServerError, Type: "InternalApiError.UNEXPECTED_INTERNAL_API_ERROR", Trigger: err.Error()}
+		}
+		writeReportError(w, reportErr)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	w.Write([]byte(report))
+}
+
+func writeReportError(w http.ResponseWriter, e *ReportError) {
+	status := e.Status
+	if status == 0 {
+		status = http.StatusBadRequest
+	}
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w,
+		`<?xml version="1.0" encoding="UTF-8" standalone="yes"?><reportDownloadError><ApiError><type>%s</type><trigger>%s</trigger><fieldPath>%s</fieldPath></ApiError></reportDownloadError>`,
+		xmlEscape(e.Type), xmlEscape(e.Trigger), xmlEscape(e.FieldPath),
+	)
+}
@@ -0,0 +1,320 @@
+// Package gadstest provides a fake AdWords v201809 server for tests.
+//
+// The server speaks the SOAP envelope format of the gads client, routes
+// calls by service name and SOAPAction, answers them from registered
+// handlers and records every request it receives.  It also serves the
+// report download endpoint and batch job upload and download URLs.
+//
+//	srv := gadstest.NewServer()
+//	defer srv.Close()
+//	srv.Respond("BudgetService", "get", gadstest.Rval("get", `<totalNumEntries>0</totalNumEntries>`))
+//
+//	auth := gads.Auth{
+//		Client:    srv.Client(),
+//		Endpoints: &gads.Endpoints{Host: srv.URL},
+//	}
+//	budgets, total, err := gads.NewBudgetService(&auth).Get(selector)
+package gadstest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	version        = "v201809"
+	soapNamespace  = "http://schemas.xmlsoap.org/soap/envelope/"
+	adwordsApiRoot = "https://adwords.google.com/api/adwords/"
+	reportPath     = "/api/adwords/reportdownload/" + version
+	batchJobPrefix = "/batchjob/"
+)
+
+// A Request is a SOAP call received by the Server.
+type Request struct {
+	// Service is the name of the service, e.g. "CampaignService".
+	Service string
+	// Method is the SOAPAction of the call, e.g. "get" or "mutate".
+	Method string
+	// Namespace is the XML namespace of the service, e.g.
+	// "https://adwords.google.com/api/adwords/cm/v201809".
+	Namespace string
+	// Header holds the SOAP RequestHeader sent by the client.
+	Header RequestHeader
+	// HTTPHeader holds the HTTP headers of the request.
+	HTTPHeader http.Header
+	// Envelope is the raw SOAP envelope.
+	Envelope []byte
+	// Body is the content of the SOAP Body element.
+	Body []byte
+}
+
+// RequestHeader is the SOAP header of a request.
+type RequestHeader struct {
+	UserAgent        string `xml:"userAgent"`
+	DeveloperToken   string `xml:"developerToken"`
+	ClientCustomerId string `xml:"clientCustomerId"`
+	PartialFailure   bool   `xml:"partialFailure"`
+	ValidateOnly     bool   `xml:"validateOnly"`
+}
+
+// Decode unmarshals the operation element of the request body into v.
+func (r *Request) Decode(v interface{}) error {
+	return xml.Unmarshal(r.Body, v)
+}
+
+// A HandlerFunc answers a SOAP call.  It returns the content of the
+// response Body, e.g. the output of Rval, or an error.  A *Fault error is
+// sent as a SOAP fault with its errors, any other error as a plain SOAP
+// server fault.
+type HandlerFunc func(req *Request) (string, error)
+
+// Server is a fake AdWords API server.  Its zero value is not usable, create
+// one with NewServer.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	handlers  map[string]HandlerFunc
+	requests  []*Request
+	requestId int
+
+	reportHandler ReportHandlerFunc
+	reports       []*ReportRequest
+	batchJobs     map[string]*batchJob
+}
+
+// NewServer starts a Server listening on a loopback port.  Close it when
+// done.
+func NewServer() *Server {
+	s := &Server{
+		handlers:  map[string]HandlerFunc{},
+		batchJobs: map[string]*batchJob{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func handlerKey(service, method string) string {
+	return service + "." + method
+}
+
+// Handle registers h for method of service.  An empty method matches every
+// method of the service that has no handler of its own.
+func (s *Server) Handle(service, method string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[handlerKey(service, method)] = h
+}
+
+// Respond registers a canned response body for method of service.
+func (s *Server) Respond(service, method, body string) {
+	s.Handle(service, method, func(*Request) (string, error) {
+		return body, nil
+	})
+}
+
+// Fault makes method of service fail with fault.
+func (s *Server) Fault(service, method string, fault *Fault) {
+	s.Handle(service, method, func(*Request) (string, error) {
+		return "", fault
+	})
+}
+
+// Requests returns the SOAP calls received so far, in order.
+func (s *Server) Requests() []*Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Request(nil), s.requests...)
+}
+
+// RequestsFor returns the received calls to method of service.  An empty
+// method matches every method.
+func (s *Server) RequestsFor(service, method string) (reqs []*Request) {
+	for _, r := range s.Requests() {
+		if r.Service == service && (method == "" || r.Method == method) {
+			reqs = append(reqs, r)
+		}
+	}
+	return reqs
+}
+
+// Reset forgets all handlers and recorded requests.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers = map[string]HandlerFunc{}
+	s.requests = nil
+	s.reportHandler = nil
+	s.reports = nil
+	s.batchJobs = map[string]*batchJob{}
+}
+
+// Rval wraps rval in the response element of method, e.g.
+// Rval("get", "<totalNumEntries>0</totalNumEntries>").
+func Rval(method, rval string) string {
+	return fmt.Sprintf("<%sResponse><rval>%s</rval></%sResponse>", method, rval, method)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == reportPath:
+		s.serveReport(w, r)
+	case strings.HasPrefix(r.URL.Path, batchJobPrefix):
+		s.serveBatchJob(w, r)
+	default:
+		s.serveSOAP(w, r)
+	}
+}
+
+// splitServicePath splits /api/adwords/cm/v201809/CampaignService into its
+// namespace and service name.
+func splitServicePath(path string) (namespace, service string, ok bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 5 || parts[len(parts)-2] != version {
+		return "", "", false
+	}
+	group := parts[len(parts)-3]
+	return adwordsApiRoot + group + "/" + version, parts[len(parts)-1], true
+}
+
+func (s *Server) serveSOAP(w http.ResponseWriter, r *http.Request) {
+	namespace, service, ok := splitServicePath(r.URL.Path)
+	if r.Method != http.MethodPost || !ok {
+		http.NotFound(w, r)
+		return
+	}
+	envelope, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req := &Request{
+		Service:    service,
+		Method:     r.Header.Get("SOAPAction"),
+		Namespace:  namespace,
+		HTTPHeader: r.Header.Clone(),
+		Envelope:   envelope,
+	}
+	parsed := struct {
+		Header RequestHeader `xml:"Header>RequestHeader"`
+		Body   struct {
+			Inner []byte `xml:",innerxml"`
+		} `xml:"Body"`
+	}{}
+	if err := xml.Unmarshal(envelope, &parsed); err != nil {
+		s.writeFault(w, req, &Fault{Message: "gadstest: malformed envelope: " + err.Error()})
+		return
+	}
+	req.Header = parsed.Header
+	req.Body = bytes.TrimSpace(parsed.Body.Inner)
+	if req.Method == "" {
+		req.Method = firstElement(req.Body)
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	h, ok := s.handlers[handlerKey(service, req.Method)]
+	if !ok {
+		h, ok = s.handlers[handlerKey(service, "")]
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		s.writeFault(w, req, &Fault{Message: fmt.Sprintf("gadstest: no handler for %s.%s", service, req.Method)})
+		return
+	}
+	body, err := h(req)
+	if err != nil {
+		fault, ok := err.(*Fault)
+		if !ok {
+			fault = &Fault{Message: err.Error()}
+		}
+		s.writeFault(w, req, fault)
+		return
+	}
+	s.writeEnvelope(w, req, http.StatusOK, body)
+}
+
+func firstElement(body []byte) string {
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			return start.Name.Local
+		}
+	}
+}
+
+func (s *Server) nextRequestId() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requestId++
+	return fmt.Sprintf("%016x", s.requestId)
+}
+
+// writeEnvelope sends body wrapped in a SOAP envelope with a ResponseHeader.
+func (s *Server) writeEnvelope(w http.ResponseWriter, req *Request, status int, body string) {
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8"?>`)
+	buf.WriteString(`<soap:Envelope xmlns:soap="` + soapNamespace + `">`)
+	buf.WriteString(`<soap:Header><ResponseHeader xmlns="` + req.Namespace + `">`)
+	fmt.Fprintf(&buf, "<requestId>%s</requestId>", s.nextRequestId())
+	fmt.Fprintf(&buf, "<serviceName>%s</serviceName>", req.Service)
+	fmt.Fprintf(&buf, "<methodName>%s</methodName>", req.Method)
+	fmt.Fprintf(&buf, "<operations>%d</operations>", countOperations(req.Body))
+	buf.WriteString("<responseTime>1</responseTime>")
+	buf.WriteString(`</ResponseHeader></soap:Header>`)
+	buf.WriteString(`<soap:Body>`)
+	buf.WriteString(withNamespace(body, req.Namespace))
+	buf.WriteString(`</soap:Body></soap:Envelope>`)
+
+	w.Header().Set("Content-Type", "text/xml; charset=UTF-8")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+// withNamespace makes namespace the default namespace of the first element
+// of body, unless that element declares one itself.
+func withNamespace(body, namespace string) string {
+	body = strings.TrimSpace(body)
+	end := strings.IndexByte(body, '>')
+	if !strings.HasPrefix(body, "<") || end < 0 || strings.Contains(body[:end], "xmlns=") {
+		return body
+	}
+	nameEnd := strings.IndexAny(body, " \t\n/>")
+	return body[:nameEnd] + ` xmlns="` + namespace + `"` + body[nameEnd:]
+}
+
+// countOperations counts the operations elements of a mutate request, the
+// way the API reports them in the response header.
+func countOperations(body []byte) int {
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	depth, n := 0, 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return n
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 && (t.Name.Local == "operations" || t.Name.Local == "operation") {
+				n++
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
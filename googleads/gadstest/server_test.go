@@ -0,0 +1,140 @@
+package gadstest_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	gads "github.com/denton/gads/googleads"
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func testAuth(srv *gadstest.Server) *gads.Auth {
+	return &gads.Auth{
+		CustomerId:     "123-456-7890",
+		DeveloperToken: "dev-token",
+		Client:         srv.Client(),
+		Endpoints:      &gads.Endpoints{Host: srv.URL},
+		RetryPolicy:    gads.NoRetry,
+	}
+}
+
+func TestServerRoutesAndRecords(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	srv.Respond("BudgetService", "get", gadstest.Rval("get", `
+		<totalNumEntries>1</totalNumEntries>
+		<entries><budgetId>42</budgetId><name>daily</name></entries>`))
+
+	budgets, total, err := gads.NewBudgetService(testAuth(srv)).Get(gads.Selector{
+		Fields: []string{"BudgetId", "BudgetName"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || len(budgets) != 1 || budgets[0].Id != 42 || budgets[0].Name != "daily" {
+		t.Errorf("unexpected budgets %d %#v", total, budgets)
+	}
+
+	reqs := srv.RequestsFor("BudgetService", "get")
+	if len(reqs) != 1 {
+		t.Fatalf("want 1 recorded request, got %d", len(reqs))
+	}
+	if reqs[0].Header.DeveloperToken != "dev-token" || reqs[0].Header.ClientCustomerId != "123-456-7890" {
+		t.Errorf("unexpected request header %#v", reqs[0].Header)
+	}
+	get := struct {
+		Fields []string `xml:"selector>fields"`
+	}{}
+	if err := reqs[0].Decode(&get); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(get.Fields, ",") != "BudgetId,BudgetName" {
+		t.Errorf("unexpected selector fields %v", get.Fields)
+	}
+}
+
+func TestServerFault(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	srv.Fault("CampaignService", "mutate", &gadstest.Fault{
+		Errors: []gadstest.ApiError{{
+			Type:   "RateExceededError",
+			Reason: "RATE_EXCEEDED",
+			Fields: map[string]string{
+				"rateName":          "RATE_LIMIT",
+				"rateScope":         "ACCOUNT",
+				"retryAfterSeconds": "30",
+			},
+		}},
+	})
+
+	_, err := gads.NewCampaignService(testAuth(srv)).Mutate(gads.CampaignOperations{
+		"ADD": {gads.Campaign{Name: "c"}},
+	})
+	if err == nil {
+		t.Fatal("want fault")
+	}
+	if after := gads.RetryAfter(err); after != 30*time.Second {
+		t.Errorf("want retry after 30s, got %s (%v)", after, err)
+	}
+
+	_, _, err = gads.NewCampaignService(testAuth(srv)).Get(gads.Selector{})
+	if err == nil || !strings.Contains(err.Error(), "no handler for CampaignService.get") {
+		t.Errorf("want missing handler fault, got %v", err)
+	}
+}
+
+func TestServerReportDownload(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	srv.RespondReport("CampaignId,Clicks\n1,10\n2,20\n")
+
+	rows, err := gads.NewReportDownloadService(testAuth(srv)).AWQL("SELECT CampaignId, Clicks FROM CAMPAIGN_PERFORMANCE_REPORT", "CSV")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := rows.([]map[string]string); len(got) != 2 || got[1]["Clicks"] != "20" {
+		t.Errorf("unexpected rows %v", got)
+	}
+	reqs := srv.ReportRequests()
+	if len(reqs) != 1 || reqs[0].Format != "CSV" || reqs[0].Header.Get("developerToken") != "dev-token" {
+		t.Errorf("unexpected report request %#v", reqs)
+	}
+
+	srv.HandleReport(func(*gadstest.ReportRequest) (string, error) {
+		return "", &gadstest.ReportError{Type: "AuthorizationError.USER_PERMISSION_DENIED"}
+	})
+	_, err = gads.NewReportDownloadService(testAuth(srv)).AWQL("SELECT CampaignId FROM CAMPAIGN_PERFORMANCE_REPORT", "CSV")
+	var apiErr gads.ApiError
+	if !errors.As(err, &apiErr) || apiErr.Code() != "USER_PERMISSION_DENIED" {
+		t.Errorf("want USER_PERMISSION_DENIED, got %v", err)
+	}
+}
+
+func TestServerBatchJob(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	helper := gads.NewBatchJobHelper(testAuth(srv))
+
+	err := helper.UploadBatchJobOperations(
+		[]interface{}{gads.BudgetOperations{"ADD": {gads.Budget{Name: "b", Amount: 1000000}}}},
+		gads.TemporaryUrl{Url: srv.BatchJobUploadURL("7")},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upload := string(srv.BatchJobUpload("7")); !strings.Contains(upload, "<name>b</name>") {
+		t.Errorf("unexpected upload %s", upload)
+	}
+
+	srv.SetBatchJobResult("7", gadstest.Rval("mutate", `<result><Budget><budgetId>9</budgetId></Budget></result><index>0</index>`))
+	results, err := helper.DownloadBatchJob(gads.TemporaryUrl{Url: srv.BatchJobDownloadURL("7")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Index != 0 {
+		t.Errorf("unexpected results %#v", results)
+	}
+}
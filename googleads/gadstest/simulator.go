@@ -0,0 +1,358 @@
+package gadstest
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Simulator is an in-memory AdWords account served by a Server.  It keeps
+// the budgets, campaigns, ad groups, ads, criteria, labels, shared sets and
+// feeds added through mutate calls and answers get and query calls from
+// them, so code built on the services can be tested without canned
+// responses:
+//
+//	srv := gadstest.NewServer()
+//	defer srv.Close()
+//	sim := gadstest.NewSimulator(srv)
+//
+// Mutates assign ids deterministically, apply SET operands field by field,
+// check that referenced budgets, campaigns and ad groups exist and honour
+// the partialFailure and validateOnly headers.  Batch jobs created with
+// BatchJobService run the uploaded operations against the account the
+// first time they are fetched with get.
+type Simulator struct {
+	srv *Server
+
+	mu       sync.Mutex
+	lastId   int64
+	entities map[string][]*node
+}
+
+// firstId is the id assigned to the first entity added to a Simulator.
+const firstId = 1000
+
+// NewSimulator registers handlers on srv for the simulated services and
+// returns the empty account.
+func NewSimulator(srv *Server) *Simulator {
+	sim := &Simulator{
+		srv:      srv,
+		lastId:   firstId - 1,
+		entities: map[string][]*node{},
+	}
+	for _, spec := range entitySpecs {
+		spec := spec
+		srv.Handle(spec.service, "", func(req *Request) (string, error) {
+			return sim.serve(spec, req)
+		})
+	}
+	srv.Handle(batchJobSpec.service, "", sim.serveBatchJob)
+	return sim
+}
+
+// Add adds an entity of service to the account as an ADD operation would
+// and returns its id.  entity is the XML of the operand, e.g.
+// `<operand><name>Budget</name><amount><microAmount>1000000</microAmount></amount></operand>`.
+func (sim *Simulator) Add(service, entity string) (id string, err error) {
+	spec := specFor(service)
+	if spec == nil {
+		return "", fmt.Errorf("gadstest: %s is not simulated", service)
+	}
+	operand, err := parseNode([]byte(entity))
+	if err != nil {
+		return "", err
+	}
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	tx := sim.begin()
+	result, errs := tx.apply(spec, 0, "ADD", operand)
+	if errs != nil {
+		return "", &Fault{Errors: errs}
+	}
+	sim.commit(tx)
+	return result.value(spec.idPath()), nil
+}
+
+// Entities returns the XML of the entities of service, in the order they
+// were added.
+func (sim *Simulator) Entities(service string) []string {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	var entities []string
+	for _, e := range sim.entities[service] {
+		entities = append(entities, e.String())
+	}
+	return entities
+}
+
+// txn is a copy of the account that mutate operations are applied to.  It
+// replaces entities rather than changing them, so discarding it leaves the
+// account untouched.
+type txn struct {
+	lastId   int64
+	entities map[string][]*node
+}
+
+// begin copies the account.  The caller holds sim.mu.
+func (sim *Simulator) begin() *txn {
+	tx := &txn{lastId: sim.lastId, entities: map[string][]*node{}}
+	for service, entities := range sim.entities {
+		tx.entities[service] = append([]*node(nil), entities...)
+	}
+	return tx
+}
+
+// commit makes tx the account.  The caller holds sim.mu.
+func (sim *Simulator) commit(tx *txn) {
+	sim.lastId = tx.lastId
+	sim.entities = tx.entities
+}
+
+func (tx *txn) nextId() string {
+	tx.lastId++
+	return strconv.FormatInt(tx.lastId, 10)
+}
+
+// find returns the index of the entity of spec whose keys match operand.
+func (tx *txn) find(spec *entitySpec, operand *node) int {
+	for i, e := range tx.entities[spec.service] {
+		match := true
+		for _, key := range spec.keys {
+			if e.value(key) != operand.value(key) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
+
+// exists reports whether service holds an entity that is not removed with
+// id.
+func (tx *txn) exists(service, id string) bool {
+	spec := specFor(service)
+	for _, e := range tx.entities[service] {
+		if e.value(spec.idPath()) == id && !isRemoved(spec, e) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRemoved(spec *entitySpec, e *node) bool {
+	return spec.status != "" && e.value(spec.status) == "REMOVED"
+}
+
+// operandPath returns the field path of path in the operand of operation i.
+func operandPath(i int, path string) string {
+	if path == "" {
+		return fmt.Sprintf("operations[%d].operand", i)
+	}
+	return fmt.Sprintf("operations[%d].operand.%s", i, strings.Replace(path, "/", ".", -1))
+}
+
+// apply applies operation i to tx and returns the resulting entity.
+func (tx *txn) apply(spec *entitySpec, i int, operator string, operand *node) (*node, []ApiError) {
+	if operand == nil {
+		return nil, []ApiError{{Type: "RequiredError", Reason: "REQUIRED", FieldPath: operandPath(i, "")}}
+	}
+	switch operator {
+	case "ADD":
+		return tx.add(spec, i, operand)
+	case "SET":
+		return tx.set(spec, i, operand)
+	case "REMOVE":
+		return tx.remove(spec, i, operand)
+	}
+	return nil, []ApiError{{Type: "OperatorError", Reason: "OPERATOR_NOT_SUPPORTED", Trigger: operator, FieldPath: fmt.Sprintf("operations[%d].operator", i)}}
+}
+
+func (tx *txn) add(spec *entitySpec, i int, operand *node) (*node, []ApiError) {
+	e := operand.clone()
+	e.name = spec.entity
+	var errs []ApiError
+	for _, path := range spec.required {
+		if m := e.find(path); m == nil || (m.text == "" && len(m.children) == 0) {
+			errs = append(errs, ApiError{Type: "RequiredError", Reason: "REQUIRED", FieldPath: operandPath(i, path)})
+		}
+	}
+	errs = append(errs, tx.check(spec, i, e, -1)...)
+	if errs != nil {
+		return nil, errs
+	}
+
+	e.set(spec.idPath(), tx.nextId())
+	applyDefaults(spec, e)
+	tx.entities[spec.service] = append(tx.entities[spec.service], e)
+	return e, nil
+}
+
+func (tx *txn) set(spec *entitySpec, i int, operand *node) (*node, []ApiError) {
+	j := tx.find(spec, operand)
+	if j < 0 {
+		return nil, []ApiError{notFound(i, spec.idPath(), operand.value(spec.idPath()))}
+	}
+	e := tx.entities[spec.service][j].clone()
+	e.merge(operand)
+	if errs := tx.check(spec, i, e, j); errs != nil {
+		return nil, errs
+	}
+	tx.entities[spec.service][j] = e
+	return e, nil
+}
+
+func (tx *txn) remove(spec *entitySpec, i int, operand *node) (*node, []ApiError) {
+	if spec.remove == removeUnsupported {
+		return nil, []ApiError{{Type: "OperatorError", Reason: "OPERATOR_NOT_SUPPORTED", Trigger: "REMOVE", FieldPath: fmt.Sprintf("operations[%d].operator", i)}}
+	}
+	j := tx.find(spec, operand)
+	if j < 0 {
+		return nil, []ApiError{notFound(i, spec.idPath(), operand.value(spec.idPath()))}
+	}
+	entities := tx.entities[spec.service]
+	e := entities[j]
+	if spec.remove == removeDelete {
+		tx.entities[spec.service] = append(append([]*node(nil), entities[:j]...), entities[j+1:]...)
+		return e, nil
+	}
+	e = e.clone()
+	e.set(spec.status, "REMOVED")
+	entities[j] = e
+	return e, nil
+}
+
+func notFound(i int, path, id string) ApiError {
+	return ApiError{Type: "EntityNotFound", Reason: "INVALID_ID", Trigger: id, FieldPath: operandPath(i, path)}
+}
+
+// check validates the references and unique name of e, which replaces the
+// entity at index self, or is new when self is negative.
+func (tx *txn) check(spec *entitySpec, i int, e *node, self int) (errs []ApiError) {
+	for _, ref := range spec.refs {
+		if id := e.value(ref.path); id != "" && !tx.exists(ref.service, id) {
+			errs = append(errs, notFound(i, ref.path, id))
+		}
+	}
+	if u := spec.unique; u != nil && !isRemoved(spec, e) {
+		name := e.value(u.path)
+		for j, other := range tx.entities[spec.service] {
+			if j == self || isRemoved(spec, other) {
+				continue
+			}
+			if other.value(u.path) == name && (u.scope == "" || other.value(u.scope) == e.value(u.scope)) {
+				err := u.err
+				err.Trigger = name
+				err.FieldPath = operandPath(i, u.path)
+				errs = append(errs, err)
+				break
+			}
+		}
+	}
+	return errs
+}
+
+func applyDefaults(spec *entitySpec, e *node) {
+	for path, value := range spec.defaults {
+		if e.value(path) == "" {
+			e.set(path, value)
+		}
+	}
+	// criteria repeat their xsi:type as type and use
+	if c := e.child("criterion"); c != nil && c.value("type") == "" && c.xsiType() != "" {
+		c.set("type", strings.ToUpper(c.xsiType()))
+	}
+	switch e.xsiType() {
+	case "BiddableAdGroupCriterion":
+		if e.value("criterionUse") == "" {
+			e.set("criterionUse", "BIDDABLE")
+		}
+		if e.value("userStatus") == "" {
+			e.set("userStatus", "ENABLED")
+		}
+	case "NegativeAdGroupCriterion":
+		if e.value("criterionUse") == "" {
+			e.set("criterionUse", "NEGATIVE")
+		}
+	}
+}
+
+func (sim *Simulator) serve(spec *entitySpec, req *Request) (string, error) {
+	body, err := parseNode(req.Body)
+	if err != nil {
+		return "", err
+	}
+	switch req.Method {
+	case "mutate":
+		return sim.mutate(spec, req, body)
+	case "get":
+		return sim.get(spec, req.Method, parseSelector(body))
+	case "query":
+		sel, err := parseAWQL(body.value("query"))
+		if err != nil {
+			return "", &Fault{Errors: []ApiError{{Type: "QueryError", Reason: "PARSING_FAILED", Trigger: err.Error(), FieldPath: "query"}}}
+		}
+		return sim.get(spec, req.Method, sel)
+	}
+	return "", fmt.Errorf("gadstest: %s.%s is not simulated", spec.service, req.Method)
+}
+
+func (sim *Simulator) mutate(spec *entitySpec, req *Request, body *node) (string, error) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	tx := sim.begin()
+
+	var values bytes.Buffer
+	var errs []ApiError
+	for i, op := range body.childrenNamed("operations") {
+		operand := op.child("operand")
+		result, opErrs := tx.apply(spec, i, op.value("operator"), operand)
+		if opErrs != nil {
+			errs = append(errs, opErrs...)
+			// failed operations leave an empty value in partial failure
+			// mode so values keep lining up with operations
+			placeholder := &node{}
+			if operand != nil {
+				placeholder.attrs = operand.attrs
+			}
+			placeholder.encode(&values, "value")
+			continue
+		}
+		result.encode(&values, "value")
+	}
+
+	if errs != nil && !req.Header.PartialFailure {
+		return "", &Fault{Errors: errs}
+	}
+	var partialErrors bytes.Buffer
+	for _, e := range errs {
+		e.write(&partialErrors, "partialFailureErrors")
+	}
+	// validateOnly checks the operations without returning results
+	if req.Header.ValidateOnly {
+		return Rval("mutate", partialErrors.String()), nil
+	}
+	sim.commit(tx)
+	rval := values.String() + partialErrors.String()
+	return Rval("mutate", rval), nil
+}
+
+func (sim *Simulator) get(spec *entitySpec, method string, sel selector) (string, error) {
+	sim.mu.Lock()
+	page, total, errs := sel.apply(spec, sim.entities[spec.service])
+	sim.mu.Unlock()
+	if errs != nil {
+		return "", &Fault{Errors: errs}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<totalNumEntries>%d</totalNumEntries>", total)
+	for _, e := range page {
+		e.encode(&buf, "entries")
+	}
+	return Rval(method, buf.String()), nil
+}
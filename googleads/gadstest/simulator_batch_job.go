@@ -0,0 +1,130 @@
+package gadstest
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// batchJobExpiration is the expiration reported for upload and download
+// URLs, which never expire in the simulator.
+const batchJobExpiration = "20380101 000000 UTC"
+
+func (sim *Simulator) serveBatchJob(req *Request) (string, error) {
+	body, err := parseNode(req.Body)
+	if err != nil {
+		return "", err
+	}
+	switch req.Method {
+	case "mutate":
+		return sim.mutateBatchJobs(req, body)
+	case "get":
+		sim.runBatchJobs()
+		return sim.get(batchJobSpec, req.Method, parseSelector(body))
+	}
+	return "", fmt.Errorf("gadstest: %s.%s is not simulated", batchJobSpec.service, req.Method)
+}
+
+// mutateBatchJobs creates a job for every ADD operation.
+func (sim *Simulator) mutateBatchJobs(req *Request, body *node) (string, error) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	tx := sim.begin()
+
+	var values bytes.Buffer
+	for i, op := range body.childrenNamed("operations") {
+		if op.value("operator") != "ADD" {
+			return "", &Fault{Errors: []ApiError{{Type: "OperatorError", Reason: "OPERATOR_NOT_SUPPORTED", Trigger: op.value("operator"), FieldPath: fmt.Sprintf("operations[%d].operator", i)}}}
+		}
+		id := tx.nextId()
+		job := &node{name: "BatchJob"}
+		job.set("id", id)
+		job.set("status", "ACTIVE")
+		job.set("uploadUrl/url", sim.srv.BatchJobUploadURL(id))
+		job.set("uploadUrl/expiration", batchJobExpiration)
+		tx.entities[batchJobSpec.service] = append(tx.entities[batchJobSpec.service], job)
+		job.encode(&values, "value")
+	}
+	if req.Header.ValidateOnly {
+		return Rval("mutate", ""), nil
+	}
+	sim.commit(tx)
+	return Rval("mutate", values.String()), nil
+}
+
+// runBatchJobs runs the operations uploaded to active jobs.  Each operation
+// succeeds or fails on its own, like in partial failure mode.
+func (sim *Simulator) runBatchJobs() {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	jobs := sim.entities[batchJobSpec.service]
+	for i, job := range jobs {
+		if job.value("status") != "ACTIVE" {
+			continue
+		}
+		id := job.value("id")
+		upload := sim.srv.BatchJobUpload(id)
+		if len(upload) == 0 {
+			continue
+		}
+
+		job = job.clone()
+		var results bytes.Buffer
+		results.WriteString("<mutateResponse>")
+		executed, succeeded := 0, 0
+		if mutate, err := parseNode(upload); err != nil {
+			job.set("processingErrors/fieldPath", "")
+			job.set("processingErrors/trigger", err.Error())
+			job.set("processingErrors/errorString", "BatchJobProcessingError.INVALID_XML")
+			job.set("processingErrors/reason", "INVALID_XML")
+		} else {
+			for j, op := range mutate.childrenNamed("operations") {
+				executed++
+				if sim.runBatchJobOperation(&results, j, op) {
+					succeeded++
+				}
+			}
+		}
+		results.WriteString("</mutateResponse>")
+		sim.srv.SetBatchJobResult(id, results.String())
+
+		job.set("status", "DONE")
+		job.set("downloadUrl/url", sim.srv.BatchJobDownloadURL(id))
+		job.set("downloadUrl/expiration", batchJobExpiration)
+		job.set("progressStats/numOperationsExecuted", strconv.Itoa(executed))
+		job.set("progressStats/numOperationsSucceeded", strconv.Itoa(succeeded))
+		job.set("progressStats/estimatedPercentExecuted", "100")
+		job.set("progressStats/numResultsWritten", strconv.Itoa(executed))
+		sim.entities[batchJobSpec.service][i] = job
+	}
+}
+
+// runBatchJobOperation applies operation i of a batch job and writes its
+// result.  The caller holds sim.mu.
+func (sim *Simulator) runBatchJobOperation(results *bytes.Buffer, i int, op *node) bool {
+	var errs []ApiError
+	var result *node
+	if spec := specForOperation(op.xsiType()); spec == nil {
+		errs = []ApiError{{Type: "BatchJobError", Reason: "UNKNOWN", Trigger: op.xsiType(), FieldPath: fmt.Sprintf("operations[%d]", i)}}
+	} else {
+		tx := sim.begin()
+		if result, errs = tx.apply(spec, i, op.value("operator"), op.child("operand")); errs == nil {
+			sim.commit(tx)
+		}
+	}
+
+	results.WriteString("<rval>")
+	if errs != nil {
+		results.WriteString("<errorList>")
+		for _, e := range errs {
+			e.write(results, "errors")
+		}
+		results.WriteString("</errorList>")
+	} else {
+		results.WriteString("<result>")
+		result.encode(results, result.name)
+		results.WriteString("</result>")
+	}
+	fmt.Fprintf(results, "<index>%d</index></rval>", i)
+	return errs == nil
+}
@@ -0,0 +1,207 @@
+package gadstest_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	gads "github.com/denton/gads/googleads"
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func TestSimulatorCampaigns(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	gadstest.NewSimulator(srv)
+	auth := testAuth(srv)
+
+	budgets, err := gads.NewBudgetService(auth).Mutate(gads.BudgetOperations{
+		"ADD": {{Name: "daily", Amount: 1000000, Delivery: "STANDARD"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(budgets) != 1 || budgets[0].Id != 1000 || budgets[0].Status != "ENABLED" {
+		t.Fatalf("unexpected budgets %#v", budgets)
+	}
+
+	cs := gads.NewCampaignService(auth)
+	_, err = cs.Mutate(gads.CampaignOperations{
+		"ADD": {{Name: "orphan", BudgetId: 42}},
+	})
+	var faults *gads.ErrorsType
+	if !errors.As(err, &faults) || !strings.Contains(err.Error(), "operations[0].operand.budget.budgetId") {
+		t.Fatalf("expected EntityNotFound for the budget, got %v", err)
+	}
+
+	var ops []gads.Campaign
+	for _, name := range []string{"b", "c", "a"} {
+		ops = append(ops, gads.Campaign{Name: name, BudgetId: budgets[0].Id, AdvertisingChannelType: "SEARCH"})
+	}
+	campaigns, err := cs.Mutate(gads.CampaignOperations{"ADD": ops})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(campaigns) != 3 || campaigns[0].Id != 1001 || campaigns[2].Id != 1003 {
+		t.Fatalf("unexpected campaigns %#v", campaigns)
+	}
+
+	_, err = cs.Mutate(gads.CampaignOperations{
+		"SET": {{Id: campaigns[1].Id, Status: "PAUSED"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page, total, err := cs.Get(gads.Selector{
+		Fields:     []string{"Id", "Name", "Status"},
+		Predicates: []gads.Predicate{{Field: "Status", Operator: "IN", Values: []string{"ENABLED", "PAUSED"}}},
+		Ordering:   []gads.OrderBy{{Field: "Name", SortOrder: "ASCENDING"}},
+		Paging:     &gads.Paging{Offset: 1, Limit: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 3 || len(page) != 1 || page[0].Name != "b" {
+		t.Fatalf("unexpected page %d %#v", total, page)
+	}
+
+	paused, _, err := cs.Query("SELECT Id, Name WHERE Status = 'PAUSED' ORDER BY Id DESC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paused) != 1 || paused[0].Name != "c" || paused[0].BudgetId != budgets[0].Id {
+		t.Errorf("unexpected query result %#v", paused)
+	}
+
+	_, _, err = cs.Get(gads.Selector{
+		Fields:     []string{"Id"},
+		Predicates: []gads.Predicate{{Field: "Bogus", Operator: "EQUALS", Values: []string{"1"}}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "INVALID_PREDICATE_FIELD_NAME") {
+		t.Errorf("expected a selector error, got %v", err)
+	}
+}
+
+func TestSimulatorPartialFailureAndValidateOnly(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	sim := gadstest.NewSimulator(srv)
+	budgetId, err := sim.Add("BudgetService", `<operand><name>b</name><amount><microAmount>1</microAmount></amount></operand>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	campaignId, err := sim.Add("CampaignService", `<operand><name>c</name><budget><budgetId>`+budgetId+`</budgetId></budget></operand>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := strconv.ParseInt(campaignId, 10, 64)
+
+	auth := testAuth(srv)
+	auth.ValidateOnly = true
+	if _, err := gads.NewAdGroupService(auth).Mutate(gads.AdGroupOperations{
+		"ADD": {{CampaignId: id, Name: "ag"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(sim.Entities("AdGroupService")); n != 0 {
+		t.Fatalf("validateOnly added %d ad groups", n)
+	}
+
+	auth.ValidateOnly = false
+	auth.PartialFailure = true
+	adGroups, err := gads.NewAdGroupService(auth).Mutate(gads.AdGroupOperations{
+		"ADD": {{CampaignId: id, Name: "ok"}, {CampaignId: 1, Name: "bad"}, {CampaignId: id, Name: "ok"}},
+	})
+	var pf *gads.PartialFailureError
+	if !errors.As(err, &pf) || len(pf.Failed()) != 2 {
+		t.Fatalf("expected 2 failed operations, got %v", err)
+	}
+	if len(adGroups) != 3 || adGroups[0].Id == 0 || adGroups[1].Id != 0 || adGroups[2].Id != 0 {
+		t.Fatalf("unexpected ad groups %#v", adGroups)
+	}
+	if n := len(sim.Entities("AdGroupService")); n != 1 {
+		t.Fatalf("expected 1 ad group, got %d", n)
+	}
+
+	criteria, err := gads.NewAdGroupCriterionService(auth).Mutate(gads.AdGroupCriterionOperations{
+		"ADD": {
+			gads.BiddableAdGroupCriterion{
+				AdGroupId: adGroups[0].Id,
+				Criterion: gads.KeywordCriterion{Text: "shoes", MatchType: "EXACT"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyword, ok := criteria[0].(gads.BiddableAdGroupCriterion)
+	if !ok || keyword.CriterionUse != "BIDDABLE" || keyword.UserStatus != "ENABLED" {
+		t.Fatalf("unexpected criteria %#v", criteria)
+	}
+	if kc, ok := keyword.Criterion.(gads.KeywordCriterion); !ok || kc.Id == 0 || kc.Text != "shoes" {
+		t.Errorf("unexpected keyword %#v", keyword.Criterion)
+	}
+}
+
+func TestSimulatorBatchJob(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	sim := gadstest.NewSimulator(srv)
+	budgetId, err := sim.Add("BudgetService", `<operand><name>b</name><amount><microAmount>1</microAmount></amount></operand>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := strconv.ParseInt(budgetId, 10, 64)
+	auth := testAuth(srv)
+
+	jobs, err := gads.NewBatchJobService(auth).Mutate(gads.BatchJobOperations{
+		BatchJobOperations: []gads.BatchJobOperation{{Operator: "ADD"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].UploadUrl == nil {
+		t.Fatalf("unexpected jobs %#v", jobs)
+	}
+
+	helper := gads.NewBatchJobHelper(auth)
+	err = helper.UploadBatchJobOperations([]interface{}{
+		gads.CampaignOperations{"ADD": {{Name: "batch", BudgetId: id}, {Name: "no budget"}}},
+	}, *jobs[0].UploadUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page, err := gads.NewBatchJobService(auth).Get(gads.Selector{
+		Fields:     []string{"Id", "Status", "DownloadUrl"},
+		Predicates: []gads.Predicate{{Field: "Id", Operator: "EQUALS", Values: []string{strconv.FormatInt(jobs[0].Id, 10)}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.BatchJobs) != 1 || page.BatchJobs[0].Status != "DONE" || page.BatchJobs[0].DownloadUrl == nil {
+		t.Fatalf("unexpected job %#v", page)
+	}
+	if stats := page.BatchJobs[0].ProgressStats; stats == nil || stats.NumOperationsSucceeded != 1 {
+		t.Errorf("unexpected progress %#v", stats)
+	}
+
+	results, err := helper.DownloadBatchJob(*page.BatchJobs[0].DownloadUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %#v", results)
+	}
+	if c, ok := results[0].Result.(gads.Campaign); !ok || c.Name != "batch" || c.Id == 0 {
+		t.Errorf("unexpected result %#v", results[0])
+	}
+	if len(results[1].ErrorList) == 0 || results[1].ErrorList[0].Errors.Reason != "REQUIRED" {
+		t.Errorf("expected a RequiredError, got %#v", results[1])
+	}
+	if n := len(sim.Entities("CampaignService")); n != 1 {
+		t.Errorf("expected 1 campaign, got %d", n)
+	}
+}
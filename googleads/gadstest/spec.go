@@ -0,0 +1,256 @@
+package gadstest
+
+// removeMode is what a REMOVE operation does to an entity.
+type removeMode int
+
+const (
+	// removeStatus sets the status of the entity to REMOVED.
+	removeStatus removeMode = iota
+	// removeDelete deletes the entity.
+	removeDelete
+	// removeUnsupported rejects the operator; the entity is removed by
+	// setting its status to REMOVED instead.
+	removeUnsupported
+)
+
+// A reference is an element of an entity holding the id of an entity of
+// another service.
+type reference struct {
+	path    string
+	service string
+}
+
+// uniqueName makes path unique among the entities that are not removed and
+// share the value at scope, if any.
+type uniqueName struct {
+	scope string
+	path  string
+	err   ApiError
+}
+
+// entitySpec describes how the simulator stores the entities of a service.
+type entitySpec struct {
+	service string
+	// entity is the element of a batch job result holding the entity,
+	// e.g. "Campaign", and operation the xsi:type of its operations.
+	entity    string
+	operation string
+	// keys are the paths identifying an entity, the last one is assigned
+	// by ADD.
+	keys     []string
+	status   string
+	remove   removeMode
+	required []string
+	unique   *uniqueName
+	refs     []reference
+	defaults map[string]string
+	// fields maps selector field names to paths.
+	fields map[string]string
+}
+
+func (spec *entitySpec) idPath() string {
+	return spec.keys[len(spec.keys)-1]
+}
+
+var entitySpecs = []*entitySpec{
+	{
+		service:   "BudgetService",
+		entity:    "Budget",
+		operation: "BudgetOperation",
+		keys:      []string{"budgetId"},
+		status:    "status",
+		remove:    removeStatus,
+		required:  []string{"name", "amount/microAmount"},
+		unique:    &uniqueName{path: "name", err: ApiError{Type: "BudgetError", Reason: "DUPLICATE_NAME"}},
+		defaults:  map[string]string{"status": "ENABLED", "period": "DAILY", "deliveryMethod": "STANDARD"},
+		fields: map[string]string{
+			"BudgetId":                 "budgetId",
+			"BudgetName":               "name",
+			"Amount":                   "amount/microAmount",
+			"BudgetStatus":             "status",
+			"Period":                   "period",
+			"DeliveryMethod":           "deliveryMethod",
+			"BudgetReferenceCount":     "referenceCount",
+			"IsBudgetExplicitlyShared": "isExplicitlyShared",
+		},
+	},
+	{
+		service:   "CampaignService",
+		entity:    "Campaign",
+		operation: "CampaignOperation",
+		keys:      []string{"id"},
+		status:    "status",
+		remove:    removeUnsupported,
+		required:  []string{"name", "budget/budgetId"},
+		unique:    &uniqueName{path: "name", err: ApiError{Type: "CampaignError", Reason: "DUPLICATE_CAMPAIGN_NAME"}},
+		refs:      []reference{{"budget/budgetId", "BudgetService"}},
+		defaults:  map[string]string{"status": "ENABLED", "servingStatus": "SERVING"},
+		fields: map[string]string{
+			"Id":                        "id",
+			"Name":                      "name",
+			"Status":                    "status",
+			"ServingStatus":             "servingStatus",
+			"StartDate":                 "startDate",
+			"EndDate":                   "endDate",
+			"BudgetId":                  "budget/budgetId",
+			"Amount":                    "budget/amount/microAmount",
+			"AdvertisingChannelType":    "advertisingChannelType",
+			"AdvertisingChannelSubType": "advertisingChannelSubType",
+			"BiddingStrategyType":       "biddingStrategyConfiguration/biddingStrategyType",
+			"Labels":                    "labels/id",
+			"TrackingUrlTemplate":       "trackingUrlTemplate",
+		},
+	},
+	{
+		service:   "AdGroupService",
+		entity:    "AdGroup",
+		operation: "AdGroupOperation",
+		keys:      []string{"id"},
+		status:    "status",
+		remove:    removeUnsupported,
+		required:  []string{"campaignId", "name"},
+		unique:    &uniqueName{scope: "campaignId", path: "name", err: ApiError{Type: "AdGroupServiceError", Reason: "DUPLICATE_ADGROUP_NAME"}},
+		refs:      []reference{{"campaignId", "CampaignService"}},
+		defaults:  map[string]string{"status": "ENABLED"},
+		fields: map[string]string{
+			"Id":                  "id",
+			"CampaignId":          "campaignId",
+			"CampaignName":        "campaignName",
+			"Name":                "name",
+			"Status":              "status",
+			"AdGroupType":         "adGroupType",
+			"Labels":              "labels/id",
+			"TrackingUrlTemplate": "trackingUrlTemplate",
+		},
+	},
+	{
+		service:   "AdGroupAdService",
+		entity:    "AdGroupAd",
+		operation: "AdGroupAdOperation",
+		keys:      []string{"adGroupId", "ad/id"},
+		status:    "status",
+		remove:    removeDelete,
+		required:  []string{"adGroupId", "ad"},
+		refs:      []reference{{"adGroupId", "AdGroupService"}},
+		defaults:  map[string]string{"status": "ENABLED"},
+		fields: map[string]string{
+			"AdGroupId":         "adGroupId",
+			"Id":                "ad/id",
+			"Status":            "status",
+			"Url":               "ad/url",
+			"DisplayUrl":        "ad/displayUrl",
+			"CreativeFinalUrls": "ad/finalUrls",
+			"HeadlinePart1":     "ad/headlinePart1",
+			"HeadlinePart2":     "ad/headlinePart2",
+			"Description":       "ad/description",
+			"Labels":            "labels/id",
+		},
+	},
+	{
+		service:   "AdGroupCriterionService",
+		entity:    "AdGroupCriterion",
+		operation: "AdGroupCriterionOperation",
+		keys:      []string{"adGroupId", "criterion/id"},
+		status:    "userStatus",
+		remove:    removeDelete,
+		required:  []string{"adGroupId", "criterion"},
+		refs:      []reference{{"adGroupId", "AdGroupService"}},
+		fields: map[string]string{
+			"AdGroupId":        "adGroupId",
+			"Id":               "criterion/id",
+			"CriterionUse":     "criterionUse",
+			"CriteriaType":     "criterion/type",
+			"KeywordText":      "criterion/text",
+			"KeywordMatchType": "criterion/matchType",
+			"Status":           "userStatus",
+			"CpcBid":           "biddingStrategyConfiguration/bids/bid/microAmount",
+			"Labels":           "labels/id",
+		},
+	},
+	{
+		service:   "LabelService",
+		entity:    "Label",
+		operation: "LabelOperation",
+		keys:      []string{"id"},
+		status:    "status",
+		remove:    removeStatus,
+		required:  []string{"name"},
+		unique:    &uniqueName{path: "name", err: ApiError{Type: "LabelError", Reason: "DUPLICATE_NAME"}},
+		defaults:  map[string]string{"status": "ENABLED"},
+		fields: map[string]string{
+			"LabelId":     "id",
+			"LabelName":   "name",
+			"LabelStatus": "status",
+		},
+	},
+	{
+		service:   "SharedSetService",
+		entity:    "SharedSet",
+		operation: "SharedSetOperation",
+		keys:      []string{"sharedSetId"},
+		status:    "status",
+		remove:    removeStatus,
+		required:  []string{"name", "type"},
+		unique:    &uniqueName{path: "name", err: ApiError{Type: "SharedSetError", Reason: "DUPLICATE_NAME"}},
+		defaults:  map[string]string{"status": "ENABLED"},
+		fields: map[string]string{
+			"SharedSetId":    "sharedSetId",
+			"Name":           "name",
+			"Type":           "type",
+			"Status":         "status",
+			"MemberCount":    "memberCount",
+			"ReferenceCount": "referenceCount",
+		},
+	},
+	{
+		service:   "FeedService",
+		entity:    "Feed",
+		operation: "FeedOperation",
+		keys:      []string{"id"},
+		status:    "status",
+		remove:    removeStatus,
+		required:  []string{"name"},
+		unique:    &uniqueName{path: "name", err: ApiError{Type: "FeedError", Reason: "FEED_NAME_ALREADY_EXISTS"}},
+		defaults:  map[string]string{"status": "ENABLED", "origin": "USER"},
+		fields: map[string]string{
+			"Id":         "id",
+			"Name":       "name",
+			"FeedStatus": "status",
+			"Origin":     "origin",
+			"Attributes": "attributes/name",
+		},
+	},
+}
+
+var batchJobSpec = &entitySpec{
+	service: "BatchJobService",
+	keys:    []string{"id"},
+	status:  "status",
+	remove:  removeUnsupported,
+	fields: map[string]string{
+		"Id":               "id",
+		"Status":           "status",
+		"DownloadUrl":      "downloadUrl/url",
+		"UploadUrl":        "uploadUrl/url",
+		"ProcessingErrors": "processingErrors/reason",
+		"ProgressStats":    "progressStats/numOperationsExecuted",
+	},
+}
+
+func specFor(service string) *entitySpec {
+	for _, spec := range entitySpecs {
+		if spec.service == service {
+			return spec
+		}
+	}
+	return nil
+}
+
+func specForOperation(operation string) *entitySpec {
+	for _, spec := range entitySpecs {
+		if spec.operation == operation {
+			return spec
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package v201809
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+)
+
+// A Call is one SOAP request made by a service.
+type Call struct {
+	Service ServiceUrl
+	// Action is the method called, e.g. "mutate".
+	Action string
+	// Request is the marshalled SOAP envelope sent to the API.
+	Request []byte
+}
+
+// A Response is the answer to a Call.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	// Body is the raw response, normally a SOAP envelope.
+	Body []byte
+	// Meta is the ResponseHeader of the envelope, set once the response has
+	// been decoded.
+	Meta ResponseMeta
+	// Cached reports whether the response was served from the cache.
+	Cached bool
+
+	decoded   bool
+	payload   []byte
+	memCached bool
+}
+
+// An Invoker makes a Call.
+type Invoker func(ctx context.Context, call *Call) (*Response, error)
+
+// An Interceptor wraps every call made with an Auth.  It may change the
+// call before passing it on to next, change or replace the response and
+// error next returns, or answer the call itself without calling next:
+//
+//	auth.Interceptors = append(auth.Interceptors, func(ctx context.Context, call *gads.Call, next gads.Invoker) (*gads.Response, error) {
+//		resp, err := next(ctx, call)
+//		log.Printf("%s.%s: %v", call.Service.Name, call.Action, err)
+//		return resp, err
+//	})
+//
+// The error returned by next is the decoded fault of the response, if any.
+// A Response returned without calling next is decoded once it leaves the
+// chain.  Interceptors see every attempt of retried calls.
+type Interceptor func(ctx context.Context, call *Call, next Invoker) (*Response, error)
+
+// invoker chains the interceptors of a, then the built-in ones that log,
+// record stats, decode the response and serve it from the cache, around
+// send.
+func (a *Auth) invoker() Invoker {
+	interceptors := append([]Interceptor{}, a.Interceptors...)
+	if a.logger() != nil {
+		interceptors = append(interceptors, a.logInterceptor)
+	}
+	interceptors = append(interceptors, a.statInterceptor, decodeInterceptor)
+	if cfg := a.cacheConfig(); cfg != nil {
+		interceptors = append(interceptors, a.cacheInterceptor(cfg))
+	}
+	invoke := a.send
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoke
+		invoke = func(ctx context.Context, call *Call) (*Response, error) {
+			return interceptor(ctx, call, next)
+		}
+	}
+	return invoke
+}
+
+// decodeInterceptor decodes the SOAP envelope of the response and turns
+// faults and unexpected statuses into errors.
+func decodeInterceptor(ctx context.Context, call *Call, next Invoker) (*Response, error) {
+	resp, err := next(ctx, call)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+	return resp, resp.decode()
+}
+
+// decode reads the ResponseHeader and SOAP body of r and returns the error
+// they describe.
+func (r *Response) decode() error {
+	type soapRespBody struct {
+		Response []byte `xml:",innerxml"`
+	}
+
+	soapResp := struct {
+		XMLName xml.Name     `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
+		Header  ResponseMeta `xml:"Header>ResponseHeader"`
+		Body    soapRespBody `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
+	}{}
+
+	r.decoded = true
+	err := xml.Unmarshal(r.Body, &soapResp)
+	if err != nil {
+		if r.StatusCode != http.StatusOK {
+			return newHTTPError(r.StatusCode, r.Header, r.Body)
+		}
+		return err
+	}
+	r.Meta = soapResp.Header
+	r.payload = soapResp.Body.Response
+
+	if r.StatusCode == 400 || r.StatusCode == 401 || r.StatusCode == 403 || r.StatusCode == 405 ||
+		r.StatusCode == 500 {
+		fault := Fault{}
+		err = xml.Unmarshal(soapResp.Body.Response, &fault)
+		if err != nil {
+			r.payload = nil
+			return err
+		}
+
+		for i := range fault.Errors.ApiExceptionFaults {
+			switch fault.Errors.ApiExceptionFaults[i].ErrorsType {
+			case "AuthenticationError",
+				"RateExceededError",
+				"DatabaseError",
+				"InternalApiError":
+				return &baseError{
+					code:    fault.Errors.ApiExceptionFaults[i].Reason,
+					origErr: &fault.Errors,
+				}
+			}
+		}
+
+		if fault.Errors.ApiExceptionFaults == nil {
+			return errors.New(fault.FaultString)
+		}
+
+		return &fault.Errors
+	}
+	if r.StatusCode != http.StatusInternalServerError && isTransientStatus(r.StatusCode) {
+		r.payload = nil
+		return newHTTPError(r.StatusCode, r.Header, r.Body)
+	}
+	return nil
+}
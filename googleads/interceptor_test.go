@@ -0,0 +1,78 @@
+package v201809
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func TestInterceptorsObserveAndModify(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	sim := gadstest.NewSimulator(srv)
+	auth := simulatorAuth(srv)
+
+	var order []string
+	var seen []*Response
+	auth.Interceptors = []Interceptor{
+		func(ctx context.Context, call *Call, next Invoker) (*Response, error) {
+			order = append(order, "outer")
+			resp, err := next(ctx, call)
+			if call.Service.Name != "BudgetService" || call.Action != "mutate" || !bytes.Contains(call.Request, []byte("renamed")) {
+				t.Errorf("unexpected call %s.%s %s", call.Service.Name, call.Action, call.Request)
+			}
+			seen = append(seen, resp)
+			return resp, err
+		},
+		func(ctx context.Context, call *Call, next Invoker) (*Response, error) {
+			order = append(order, "inner")
+			call.Request = bytes.Replace(call.Request, []byte("original"), []byte("renamed"), 1)
+			return next(ctx, call)
+		},
+	}
+
+	_, err := NewBudgetService(auth).Mutate(BudgetOperations{"ADD": {{Name: "original", Amount: 1}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(order, ",") != "outer,inner" {
+		t.Errorf("unexpected order %v", order)
+	}
+	if len(seen) != 1 || seen[0].StatusCode != 200 || seen[0].Meta.Operations != 1 || len(seen[0].Body) == 0 {
+		t.Errorf("unexpected responses %#v", seen)
+	}
+	if budgets := sim.Entities("BudgetService"); len(budgets) != 1 || !strings.Contains(budgets[0], "renamed") {
+		t.Errorf("unexpected budgets %v", budgets)
+	}
+}
+
+func TestInterceptorsShortCircuit(t *testing.T) {
+	auth, calls := cannedAuth(cannedResponse{200, ""})
+	auth.RetryPolicy = NoRetry
+	auth.Interceptors = []Interceptor{
+		func(ctx context.Context, call *Call, next Invoker) (*Response, error) {
+			return &Response{StatusCode: 500, Body: []byte(internalApiErrorFault)}, nil
+		},
+	}
+
+	_, _, err := NewBudgetService(auth).Get(Selector{Fields: []string{"BudgetId"}})
+	var internal InternalApiError
+	if !errors.As(err, &internal) {
+		t.Fatalf("want the InternalApiError of the canned response, got %v", err)
+	}
+	if *calls != 0 {
+		t.Errorf("want no request sent, got %d", *calls)
+	}
+
+	injected := errors.New("injected")
+	auth.Interceptors[0] = func(ctx context.Context, call *Call, next Invoker) (*Response, error) {
+		return nil, injected
+	}
+	if _, _, err := NewBudgetService(auth).Get(Selector{Fields: []string{"BudgetId"}}); err != injected {
+		t.Errorf("want the injected error, got %v", err)
+	}
+}
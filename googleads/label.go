@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -28,7 +29,8 @@ func NewTextLabel(name string) Label {
 	}
 }
 
-// LabelOperations is a map of operations to perform on Label's
+// LabelOperations is a map of operations to perform on Label's.  Mutate
+// sends them in the order given in the package doc.
 type LabelOperations map[string][]Label
 
 // Get returns an array of Label's and the total number of Label's matching
@@ -56,8 +58,15 @@ type LabelOperations map[string][]Label
 //     https://developers.google.com/adwords/api/docs/reference/v201409/LabelService#get
 //
 func (s LabelService) Get(selector Selector) (labels []Label, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s LabelService) GetContext(ctx context.Context, selector Selector) (labels []Label, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		labelServiceUrl,
 		"get",
 		struct {
@@ -110,24 +119,45 @@ func (s LabelService) Get(selector Selector) (labels []Label, totalCount int64,
 //     https://developers.google.com/adwords/api/docs/reference/v201409/LabelService#mutate
 //
 func (s *LabelService) Mutate(labelOperations LabelOperations) (labels []Label, err error) {
-	type labelOperation struct {
-		Action string `xml:"operator"`
-		Label  Label  `xml:"operand"`
-	}
-	operations := []labelOperation{}
-	for action, labels := range labelOperations {
-		for _, label := range labels {
+	return s.MutateContext(context.Background(), labelOperations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *LabelService) MutateContext(ctx context.Context, labelOperations LabelOperations) (labels []Label, err error) {
+	operations := []LabelOperation{}
+	for _, action := range sortedActions(labelOperations) {
+		for _, label := range labelOperations[action] {
 			operations = append(operations,
-				labelOperation{
+				LabelOperation{
 					Action: action,
 					Label:  label,
 				},
 			)
 		}
 	}
+	return s.MutateOperationsContext(ctx, operations)
+}
+
+// LabelOperation is an operation of MutateOperations, for instance the
+// "ADD" of one label.
+type LabelOperation struct {
+	Action string `xml:"operator"`
+	Label  Label  `xml:"operand"`
+}
+
+// MutateOperations is like Mutate but sends the operations in the order
+// given.
+func (s *LabelService) MutateOperations(operations []LabelOperation) (labels []Label, err error) {
+	return s.MutateOperationsContext(context.Background(), operations)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *LabelService) MutateOperationsContext(ctx context.Context, operations []LabelOperation) (labels []Label, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []labelOperation `xml:"operations"`
+		Ops     []LabelOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseUrl,
@@ -135,18 +165,19 @@ func (s *LabelService) Mutate(labelOperations LabelOperations) (labels []Label,
 		},
 		Ops: operations,
 	}
-	respBody, err := s.Auth.request(labelServiceUrl, "mutate", mutation)
+	respBody, meta, err := s.Auth.requestMeta(ctx, labelServiceUrl, "mutate", mutation)
 	if err != nil {
 		return labels, err
 	}
 	mutateResp := struct {
-		Labels []Label `xml:"rval>value"`
+		Labels               []Label   `xml:"rval>value"`
+		PartialFailureErrors apiErrors `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return labels, err
 	}
-	return mutateResp.Labels, err
+	return mutateResp.Labels, partialFailure(meta.RequestId, operations, mutateResp.Labels, mutateResp.PartialFailureErrors)
 }
 
 // Query is not yet implemented
@@ -156,8 +187,15 @@ func (s *LabelService) Mutate(labelOperations LabelOperations) (labels []Label,
 //     https://developers.google.com/adwords/api/docs/reference/v201506/LabelService#query
 //
 func (s *LabelService) Query(query string) (labels []Label, totalCount int64, err error) {
+	return s.QueryContext(context.Background(), query)
+}
+
+// QueryContext is like Query but takes a context that controls the
+// request lifetime.
+func (s *LabelService) QueryContext(ctx context.Context, query string) (labels []Label, totalCount int64, err error) {
 
 	respBody, err := s.Auth.request(
+		ctx,
 		labelServiceUrl,
 		"query",
 		AWQLQuery{
@@ -185,3 +223,21 @@ func (s *LabelService) Query(query string) (labels []Label, totalCount int64, er
 	return getResp.Labels, getResp.Size, err
 
 }
+
+// LabelPages iterates over pages of labels, see Pager.
+type LabelPages struct {
+	*Pager
+}
+
+// Labels returns the current page.
+func (p LabelPages) Labels() []Label {
+	return p.Page().([]Label)
+}
+
+// Pages returns an iterator over the labels matching selector.
+func (s LabelService) Pages(ctx context.Context, selector Selector, opts *PageOptions) LabelPages {
+	return LabelPages{newPager(ctx, selector, opts, "LabelId", "Id", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
+}
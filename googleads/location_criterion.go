@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -23,8 +24,15 @@ type LocationCriterion struct {
 type LocationCriterions []LocationCriterion
 
 func (s *LocationCriterionService) Get(selector Selector) (locationCriterions LocationCriterions, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *LocationCriterionService) GetContext(ctx context.Context, selector Selector) (locationCriterions LocationCriterions, err error) {
 	selector.XMLName = xml.Name{baseUrl, "selector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		locationCriterionServiceUrl,
 		"get",
 		struct {
@@ -0,0 +1,220 @@
+package v201809
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// LogLevel is the severity of a log entry.
+type LogLevel int
+
+const (
+	LevelDebug LogLevel = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	}
+	return "LEVEL(" + strconv.Itoa(int(l)) + ")"
+}
+
+// A Field is a named value attached to a log entry.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// A Logger receives the log entries of the calls made with an Auth.  Every
+// call is logged with the fields service, method, customerId, requestId,
+// latency and bytes; request and response bodies are logged at LevelDebug.
+// Implementations must be safe for concurrent use.
+type Logger interface {
+	Log(level LogLevel, msg string, fields ...Field)
+}
+
+// DefaultMaxLogBodySize is the number of bytes of request and response
+// bodies logged when LogConfig.MaxBodySize is zero.
+const DefaultMaxLogBodySize = 16 * 1024
+
+// LogConfig controls what the calls of an Auth log.
+type LogConfig struct {
+	// MaxBodySize truncates the logged bodies, DefaultMaxLogBodySize is
+	// used when 0.  Bodies are not logged at all when negative.
+	MaxBodySize int
+	// NoRedact logs bodies as they are sent and received, including
+	// developer tokens, bearer tokens and hashed user list members.
+	NoRedact bool
+}
+
+// NewTextLogger returns a Logger writing entries of level and above to w as
+// lines of key=value pairs.
+func NewTextLogger(w io.Writer, level LogLevel) Logger {
+	return &textLogger{w: w, level: level}
+}
+
+type textLogger struct {
+	mu    sync.Mutex
+	w     io.Writer
+	level LogLevel
+}
+
+func (l *textLogger) Log(level LogLevel, msg string, fields ...Field) {
+	if level < l.level {
+		return
+	}
+	line := formatEntry(level, msg, fields)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(l.w, "%s %s\n", time.Now().Format(time.RFC3339), line)
+}
+
+// testLogger logs to the log of a test.
+type testLogger struct {
+	t *testing.T
+}
+
+func (l testLogger) Log(level LogLevel, msg string, fields ...Field) {
+	l.t.Log(formatEntry(level, msg, fields))
+}
+
+func formatEntry(level LogLevel, msg string, fields []Field) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "level=%s msg=%s", level, quoteLogValue(msg))
+	for _, f := range fields {
+		fmt.Fprintf(&b, " %s=%s", f.Key, quoteLogValue(fmt.Sprint(f.Value)))
+	}
+	return b.String()
+}
+
+func quoteLogValue(s string) string {
+	if s == "" || strings.ContainsAny(s, " \t\r\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+var debugLogger = NewTextLogger(os.Stdout, LevelDebug)
+
+// logger returns the Logger of a.  Without one, calls are logged to the test
+// of a.Testing, or to stdout when the DEBUG environment variable is set.
+func (a *Auth) logger() Logger {
+	switch {
+	case a.Logger != nil:
+		return a.Logger
+	case a.Testing != nil:
+		return testLogger{a.Testing}
+	case os.Getenv("DEBUG") != "":
+		return debugLogger
+	}
+	return nil
+}
+
+func (a *Auth) log(level LogLevel, msg string, fields ...Field) {
+	if l := a.logger(); l != nil {
+		l.Log(level, msg, fields...)
+	}
+}
+
+// logBody returns body as logged by a, or false if bodies are not logged.
+func (a *Auth) logBody(body []byte) (string, bool) {
+	max := a.LogConfig.MaxBodySize
+	if max < 0 {
+		return "", false
+	}
+	if max == 0 {
+		max = DefaultMaxLogBodySize
+	}
+	if !a.LogConfig.NoRedact {
+		body = redact(body)
+	}
+	if len(body) > max {
+		return fmt.Sprintf("%s...(%d bytes truncated)", body[:max], len(body)-max), true
+	}
+	return string(body), true
+}
+
+var (
+	// redactedElements matches the text of the elements holding credentials
+	// and personal data of user list members.
+	redactedElements = regexp.MustCompile(`(<(?:[\w-]+:)?(?:developerToken|hashedEmail|hashedPhoneNumber|hashedFirstName|hashedLastName|mobileId|members)(?:\s[^>]*)?>)[^<]+(</)`)
+	bearerTokens     = regexp.MustCompile(`(?i)(bearer\s+)[\w.~+/=-]+`)
+	oauthTokens      = regexp.MustCompile(`("(?:access|refresh)_token"\s*:\s*")[^"]*`)
+)
+
+const redacted = "REDACTED"
+
+// redact hides developer tokens, OAuth tokens and hashed user list members
+// in body.
+func redact(body []byte) []byte {
+	body = redactedElements.ReplaceAll(body, []byte("${1}"+redacted+"${2}"))
+	body = bearerTokens.ReplaceAll(body, []byte("${1}"+redacted))
+	return oauthTokens.ReplaceAll(body, []byte("${1}"+redacted))
+}
+
+// logInterceptor logs every call made with a.
+func (a *Auth) logInterceptor(ctx context.Context, call *Call, next Invoker) (*Response, error) {
+	fields := []Field{
+		{Key: "service", Value: call.Service.Name},
+		{Key: "method", Value: call.Action},
+		{Key: "customerId", Value: a.CustomerId},
+	}
+	if body, ok := a.logBody(call.Request); ok {
+		a.log(LevelDebug, "request", append(fields, Field{Key: "body", Value: body})...)
+	}
+
+	start := time.Now()
+	resp, err := next(ctx, call)
+	fields = append(fields, Field{Key: "latency", Value: time.Since(start)})
+	if resp != nil {
+		fields = append(fields,
+			Field{Key: "requestId", Value: resp.Meta.RequestId},
+			Field{Key: "status", Value: resp.StatusCode},
+			Field{Key: "bytes", Value: len(resp.Body)},
+		)
+		if body, ok := a.logBody(resp.Body); ok {
+			a.log(LevelDebug, "response", append(fields, Field{Key: "body", Value: body})...)
+		}
+	}
+	if err != nil {
+		a.log(LevelWarn, "call failed", append(fields, Field{Key: "error", Value: err})...)
+	} else {
+		a.log(LevelInfo, "call", fields...)
+	}
+	return resp, err
+}
+
+// logTransfer logs a request made outside of the SOAP services, such as
+// the upload and download of batch job operations.
+func (a *Auth) logTransfer(msg string, reqBody []byte, status int, respBody []byte) {
+	fields := []Field{
+		{Key: "customerId", Value: a.CustomerId},
+		{Key: "status", Value: status},
+		{Key: "bytes", Value: len(respBody)},
+	}
+	if body, ok := a.logBody(reqBody); ok && len(reqBody) > 0 {
+		fields = append(fields, Field{Key: "request", Value: body})
+	}
+	if body, ok := a.logBody(respBody); ok {
+		fields = append(fields, Field{Key: "body", Value: body})
+	}
+	a.log(LevelDebug, msg, fields...)
+}
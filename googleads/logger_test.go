@@ -0,0 +1,89 @@
+package v201809
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+type logEntry struct {
+	level  LogLevel
+	msg    string
+	fields map[string]interface{}
+}
+
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []logEntry
+}
+
+func (l *recordingLogger) Log(level LogLevel, msg string, fields ...Field) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e := logEntry{level: level, msg: msg, fields: map[string]interface{}{}}
+	for _, f := range fields {
+		e.fields[f.Key] = f.Value
+	}
+	l.entries = append(l.entries, e)
+}
+
+func TestLoggerFieldsAndRedaction(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	gadstest.NewSimulator(srv)
+	auth := simulatorAuth(srv)
+	auth.DeveloperToken = "secret-dev-token"
+	logger := &recordingLogger{}
+	auth.Logger = logger
+
+	if _, err := NewBudgetService(auth).Mutate(BudgetOperations{"ADD": {{Name: "b", Amount: 1}}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(logger.entries) != 3 {
+		t.Fatalf("want request, response and call entries, got %#v", logger.entries)
+	}
+	request, response, call := logger.entries[0], logger.entries[1], logger.entries[2]
+	if request.level != LevelDebug || response.level != LevelDebug || call.level != LevelInfo {
+		t.Errorf("unexpected levels %v %v %v", request.level, response.level, call.level)
+	}
+	body := request.fields["body"].(string)
+	if strings.Contains(body, "secret-dev-token") || !strings.Contains(body, "<developerToken>REDACTED</developerToken>") {
+		t.Errorf("developer token not redacted in %s", body)
+	}
+	for _, key := range []string{"service", "method", "customerId", "requestId", "latency", "bytes", "status"} {
+		if _, ok := call.fields[key]; !ok {
+			t.Errorf("call entry without %s: %#v", key, call.fields)
+		}
+	}
+	if call.fields["service"] != "BudgetService" || call.fields["requestId"] == "" {
+		t.Errorf("unexpected fields %#v", call.fields)
+	}
+
+	logger.entries = nil
+	auth.LogConfig = LogConfig{MaxBodySize: 10}
+	auth.PartialFailure = false
+	NewCampaignService(auth).Mutate(CampaignOperations{"ADD": {{Name: "c"}}})
+	if body := logger.entries[0].fields["body"].(string); len(body) > 40 || !strings.HasSuffix(body, "bytes truncated)") {
+		t.Errorf("body not truncated: %s", body)
+	}
+	if last := logger.entries[len(logger.entries)-1]; last.level != LevelWarn || last.fields["error"] == nil {
+		t.Errorf("want a failed call entry, got %#v", last)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	for in, want := range map[string]string{
+		`<ns:developerToken>abc</ns:developerToken>`:                                          `<ns:developerToken>REDACTED</ns:developerToken>`,
+		`<members>0c7e6a405862e402eb76a70f8a26fc732d07c32931e9fae9ab1582911d2e8a3b</members>`: `<members>REDACTED</members>`,
+		`<members><hashedEmail>1234</hashedEmail></members>`:                                  `<members><hashedEmail>REDACTED</hashedEmail></members>`,
+		`Authorization: Bearer ya29.a0-AfH6_token`:                                            `Authorization: Bearer REDACTED`,
+		`{"access_token": "ya29", "expires_in": 3599}`:                                        `{"access_token": "REDACTED", "expires_in": 3599}`,
+		`<name>kept</name>`: `<name>kept</name>`,
+	} {
+		if got := string(redact([]byte(in))); got != want {
+			t.Errorf("redact(%s) = %s, want %s", in, got, want)
+		}
+	}
+}
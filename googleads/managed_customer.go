@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -24,7 +25,13 @@ type ManagedCustomerLink struct {
 	IsHidden               bool   `xml:isHidden"`
 }
 
+// ManagedCustomerOperations maps operators to the managed customers they
+// apply to, sent by Mutate in the order given in the package doc.
 type ManagedCustomerOperations map[string][]ManagedCustomer
+
+// ManagedCustomerLinkOperations maps operators to the managed customer
+// links they apply to, sent by MutateLink in the order given in the package
+// doc.
 type ManagedCustomerLinkOperations map[string][]ManagedCustomerLink
 
 type ManagedCustomerPage struct {
@@ -48,9 +55,19 @@ func NewManagedCustomerService(auth *Auth) *ManagedCustomerService {
 
 func (s *ManagedCustomerService) Get(
 	selector Selector,
+) (managedCustomerPage ManagedCustomerPage, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *ManagedCustomerService) GetContext(
+	ctx context.Context,
+	selector Selector,
 ) (managedCustomerPage ManagedCustomerPage, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseMcmUrl, "serviceSelector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		managedCustomerServiceUrl,
 		"get",
 		struct {
@@ -78,26 +95,48 @@ func (s *ManagedCustomerService) Get(
 func (s *ManagedCustomerService) Mutate(
 	managedCustomerOperations ManagedCustomerOperations,
 ) (managedCustomers []ManagedCustomer, err error) {
-	type managedCustomerOperation struct {
-		Action          string          `xml:"https://adwords.google.com/api/adwords/cm/v201809 operator"`
-		ManagedCustomer ManagedCustomer `xml:"operand"`
-	}
+	return s.MutateContext(context.Background(), managedCustomerOperations)
+}
 
-	operations := []managedCustomerOperation{}
-	for action, managedCustomers := range managedCustomerOperations {
-		for _, managedCustomer := range managedCustomers {
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s *ManagedCustomerService) MutateContext(
+	ctx context.Context,
+	managedCustomerOperations ManagedCustomerOperations,
+) (managedCustomers []ManagedCustomer, err error) {
+	operations := []ManagedCustomerOperation{}
+	for _, action := range sortedActions(managedCustomerOperations) {
+		for _, managedCustomer := range managedCustomerOperations[action] {
 			operations = append(operations,
-				managedCustomerOperation{
+				ManagedCustomerOperation{
 					Action:          action,
 					ManagedCustomer: managedCustomer,
 				},
 			)
 		}
 	}
+	return s.MutateOperationsContext(ctx, operations)
+}
+
+// ManagedCustomerOperation is an operation of MutateOperations, for
+// instance the "ADD" of one managed customer.
+type ManagedCustomerOperation struct {
+	Action          string          `xml:"https://adwords.google.com/api/adwords/cm/v201809 operator"`
+	ManagedCustomer ManagedCustomer `xml:"operand"`
+}
 
+// MutateOperations is like Mutate but sends the operations in the order
+// given.
+func (s *ManagedCustomerService) MutateOperations(operations []ManagedCustomerOperation) (managedCustomers []ManagedCustomer, err error) {
+	return s.MutateOperationsContext(context.Background(), operations)
+}
+
+// MutateOperationsContext is like MutateOperations but takes a context that controls the
+// request lifetime.
+func (s *ManagedCustomerService) MutateOperationsContext(ctx context.Context, operations []ManagedCustomerOperation) (managedCustomers []ManagedCustomer, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []managedCustomerOperation `xml:"operations"`
+		Ops     []ManagedCustomerOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseMcmUrl,
@@ -106,7 +145,8 @@ func (s *ManagedCustomerService) Mutate(
 		Ops: operations,
 	}
 
-	respBody, err := s.Auth.request(
+	respBody, meta, err := s.Auth.requestMeta(
+		ctx,
 		managedCustomerServiceUrl,
 		"mutate",
 		mutation,
@@ -116,39 +156,62 @@ func (s *ManagedCustomerService) Mutate(
 	}
 
 	mutateResp := struct {
-		ManagedCustomers []ManagedCustomer `xml:"rval>value"`
+		ManagedCustomers     []ManagedCustomer `xml:"rval>value"`
+		PartialFailureErrors apiErrors         `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return managedCustomers, err
 	}
 
-	return mutateResp.ManagedCustomers, err
+	return mutateResp.ManagedCustomers, partialFailure(meta.RequestId, operations, mutateResp.ManagedCustomers, mutateResp.PartialFailureErrors)
 }
 
 func (s *ManagedCustomerService) MutateLink(
 	managedCustomerLinkOperations ManagedCustomerLinkOperations,
 ) (managedCustomerLinks []ManagedCustomerLink, err error) {
-	type managedCustomerOperation struct {
-		Action              string              `xml:"https://adwords.google.com/api/adwords/cm/v201809 operator"`
-		ManagedCustomerLink ManagedCustomerLink `xml:"operand"`
-	}
+	return s.MutateLinkContext(context.Background(), managedCustomerLinkOperations)
+}
 
-	operations := []managedCustomerOperation{}
-	for action, managedCustomerLinks := range managedCustomerLinkOperations {
-		for _, managedCustomer := range managedCustomerLinks {
+// MutateLinkContext is like MutateLink but takes a context that controls the
+// request lifetime.
+func (s *ManagedCustomerService) MutateLinkContext(
+	ctx context.Context,
+	managedCustomerLinkOperations ManagedCustomerLinkOperations,
+) (managedCustomerLinks []ManagedCustomerLink, err error) {
+	operations := []ManagedCustomerLinkOperation{}
+	for _, action := range sortedActions(managedCustomerLinkOperations) {
+		for _, managedCustomer := range managedCustomerLinkOperations[action] {
 			operations = append(operations,
-				managedCustomerOperation{
+				ManagedCustomerLinkOperation{
 					Action:              action,
 					ManagedCustomerLink: managedCustomer,
 				},
 			)
 		}
 	}
+	return s.MutateLinkOperationsContext(ctx, operations)
+}
+
+// ManagedCustomerLinkOperation is an operation of MutateLinkOperations,
+// for instance the "ADD" of one managed customer link.
+type ManagedCustomerLinkOperation struct {
+	Action              string              `xml:"https://adwords.google.com/api/adwords/cm/v201809 operator"`
+	ManagedCustomerLink ManagedCustomerLink `xml:"operand"`
+}
+
+// MutateLinkOperations is like MutateLink but sends the operations in the
+// order given.
+func (s *ManagedCustomerService) MutateLinkOperations(operations []ManagedCustomerLinkOperation) (managedCustomerLinks []ManagedCustomerLink, err error) {
+	return s.MutateLinkOperationsContext(context.Background(), operations)
+}
 
+// MutateLinkOperationsContext is like MutateLinkOperations but takes a context that controls the
+// request lifetime.
+func (s *ManagedCustomerService) MutateLinkOperationsContext(ctx context.Context, operations []ManagedCustomerLinkOperation) (managedCustomerLinks []ManagedCustomerLink, err error) {
 	mutation := struct {
 		XMLName xml.Name
-		Ops     []managedCustomerOperation `xml:"operations"`
+		Ops     []ManagedCustomerLinkOperation `xml:"operations"`
 	}{
 		XMLName: xml.Name{
 			Space: baseMcmUrl,
@@ -157,7 +220,8 @@ func (s *ManagedCustomerService) MutateLink(
 		Ops: operations,
 	}
 
-	respBody, err := s.Auth.request(
+	respBody, meta, err := s.Auth.requestMeta(
+		ctx,
 		managedCustomerServiceUrl,
 		"mutateLink",
 		mutation,
@@ -168,11 +232,30 @@ func (s *ManagedCustomerService) MutateLink(
 
 	mutateResp := struct {
 		ManagedCustomerLinks []ManagedCustomerLink `xml:"rval>value"`
+		PartialFailureErrors apiErrors             `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &mutateResp)
 	if err != nil {
 		return managedCustomerLinks, err
 	}
 
-	return mutateResp.ManagedCustomerLinks, err
+	return mutateResp.ManagedCustomerLinks, partialFailure(meta.RequestId, operations, mutateResp.ManagedCustomerLinks, mutateResp.PartialFailureErrors)
+}
+
+// ManagedCustomerPages iterates over pages of managed customers, see Pager.
+type ManagedCustomerPages struct {
+	*Pager
+}
+
+// ManagedCustomerPage returns the current page.
+func (p ManagedCustomerPages) ManagedCustomerPage() ManagedCustomerPage {
+	return p.Page().(ManagedCustomerPage)
+}
+
+// Pages returns an iterator over the managed customers matching selector.
+func (s *ManagedCustomerService) Pages(ctx context.Context, selector Selector, opts *PageOptions) ManagedCustomerPages {
+	return ManagedCustomerPages{newPager(ctx, selector, opts, "CustomerId", "CustomerId", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page.ManagedCustomers, totalCount, err
+	})}
 }
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/xml"
 )
@@ -74,8 +75,15 @@ func NewVideo(mediaType string) (image Media) {
 }
 
 func (s *MediaService) Get(selector Selector) (medias []Media, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *MediaService) GetContext(ctx context.Context, selector Selector) (medias []Media, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "serviceSelector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		mediaServiceUrl,
 		"get",
 		struct {
@@ -108,6 +116,12 @@ func (s *MediaService) Query(query string) (medias []Media, totalCount int64, er
 }
 
 func (s *MediaService) Upload(medias []Media) (uploadedMedias []Media, err error) {
+	return s.UploadContext(context.Background(), medias)
+}
+
+// UploadContext is like Upload but takes a context that controls the
+// request lifetime.
+func (s *MediaService) UploadContext(ctx context.Context, medias []Media) (uploadedMedias []Media, err error) {
 	upload := struct {
 		XMLName xml.Name
 		Medias  []Media `xml:"media"`
@@ -118,7 +132,7 @@ func (s *MediaService) Upload(medias []Media) (uploadedMedias []Media, err error
 		},
 		Medias: medias,
 	}
-	respBody, err := s.Auth.request(mediaServiceUrl, "upload", upload)
+	respBody, err := s.Auth.request(ctx, mediaServiceUrl, "upload", upload)
 	if err != nil {
 		return uploadedMedias, err
 	}
@@ -131,3 +145,21 @@ func (s *MediaService) Upload(medias []Media) (uploadedMedias []Media, err error
 	}
 	return uploadResp.Medias, err
 }
+
+// MediaPages iterates over pages of media, see Pager.
+type MediaPages struct {
+	*Pager
+}
+
+// Medias returns the current page.
+func (p MediaPages) Medias() []Media {
+	return p.Page().([]Media)
+}
+
+// Pages returns an iterator over the media matching selector.
+func (s *MediaService) Pages(ctx context.Context, selector Selector, opts *PageOptions) MediaPages {
+	return MediaPages{newPager(ctx, selector, opts, "MediaId", "Id", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
+}
@@ -2,19 +2,23 @@ package v201809
 
 import (
 	"encoding/json"
+	"errors"
 	"io/ioutil"
+	"net/http"
+	"sync"
 	"time"
 
-	"golang.org/x/net/context"
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/jwt"
 )
 
 type AuthConfig struct {
-	file         string             `json:"-"`
-	OAuth2Config *oauth2.Config     `json:"oauth2.Config"`
-	OAuth2Token  *oauth2.Token      `json:"oauth2.Token"`
-	tokenSource  oauth2.TokenSource `json:"-"`
-	Auth         Auth               `json:"gads.Auth"`
+	file         string         `json:"-"`
+	OAuth2Config *oauth2.Config `json:"oauth2.Config"`
+	OAuth2Token  *oauth2.Token  `json:"oauth2.Token"`
+	tokenSource  *TokenSource   `json:"-"`
+	jwtConfig    *jwt.Config    `json:"-"`
+	Auth         Auth           `json:"gads.Auth"`
 }
 
 type OAuthConfigArgs struct {
@@ -25,6 +29,9 @@ type OAuthConfigArgs struct {
 type OAuthTokenArgs struct {
 	AccessToken  string
 	RefreshToken string
+	// Expiry is when AccessToken expires.  When zero the access token is
+	// taken as expired and refreshed before its first use.
+	Expiry time.Time
 }
 
 type Credentials struct {
@@ -33,8 +40,17 @@ type Credentials struct {
 	Auth   Auth
 }
 
+// ErrNoConfigFile is returned by AuthConfig.Save when the AuthConfig was not
+// loaded from a file.
+var ErrNoConfigFile = errors.New("gads: no config file to save to, use SaveAs")
+
+// ErrServiceAccountConfig is returned by AuthConfig.Save and SaveAs for the
+// AuthConfigs of service accounts, whose key the JSON file cannot hold.
+var ErrServiceAccountConfig = errors.New("gads: service account configs cannot be saved")
+
+// NewCredentialsFromFile loads an AuthConfig from the JSON file pathToFile.
+// The tokens refreshed by its client are written back to the file.
 func NewCredentialsFromFile(pathToFile string) (ac AuthConfig, err error) {
-	ctx := context.TODO()
 	data, err := ioutil.ReadFile(pathToFile)
 	if err != nil {
 		return ac, err
@@ -43,45 +59,43 @@ func NewCredentialsFromFile(pathToFile string) (ac AuthConfig, err error) {
 		return ac, err
 	}
 	ac.file = pathToFile
-	ac.tokenSource = ac.OAuth2Config.TokenSource(ctx, ac.OAuth2Token)
-	ac.Auth.Client = ac.OAuth2Config.Client(ctx, ac.OAuth2Token)
-	return ac, err
+	return ac, ac.SetTokenStore(configFileTokenStore(pathToFile))
 }
 
+// NewCredentialsFromJSON loads an AuthConfig from JSON.  Refreshed tokens
+// are kept in memory, see SetTokenStore to persist them.
 func NewCredentialsFromJSON(data string) (ac AuthConfig, err error) {
-	ctx := context.TODO()
 	if err := json.Unmarshal([]byte(data), &ac); err != nil {
 		return ac, err
 	}
-	ac.tokenSource = ac.OAuth2Config.TokenSource(ctx, ac.OAuth2Token)
-	ac.Auth.Client = ac.OAuth2Config.Client(ctx, ac.OAuth2Token)
-	return ac, err
+	return ac, ac.SetTokenStore(nil)
 }
 
+// NewCredentialsFromParams returns an AuthConfig of creds.  Refreshed tokens
+// are kept in memory, see SetTokenStore to persist them.
 func NewCredentialsFromParams(
 	creds Credentials,
 ) (config AuthConfig, err error) {
 	var gcfg AuthConfig
 
-	expiresAt, _ := time.Parse(
-		time.RFC3339,
-		"2015-07-28T14:51:53.543430418-04:00",
-	)
-
-	// Create a token
+	// Create a token, expired unless told otherwise so that it is
+	// refreshed before its first use.
+	expiry := creds.Token.Expiry
+	if expiry.IsZero() {
+		expiry = time.Now()
+	}
 	gcfg.OAuth2Token = &oauth2.Token{
 		AccessToken:  creds.Token.AccessToken,
 		TokenType:    "Bearer",
 		RefreshToken: creds.Token.RefreshToken,
-		Expiry:       expiresAt,
+		Expiry:       expiry,
 	}
-	ctx := context.TODO()
 
 	gcfg.OAuth2Config = &oauth2.Config{
 		ClientID:     creds.Config.ClientID,
 		ClientSecret: creds.Config.ClientSecret,
 		Scopes: []string{
-			"https://adwords.google.com/api/adwords",
+			adwordsScope,
 		},
 		Endpoint: oauth2.Endpoint{
 			AuthURL:  "https://accounts.google.com/o/oauth2/auth",
@@ -92,34 +106,138 @@ func NewCredentialsFromParams(
 	gcfg.Auth = Auth{
 		CustomerId:     creds.Auth.CustomerId,
 		DeveloperToken: creds.Auth.DeveloperToken,
-		Client:         gcfg.OAuth2Config.Client(ctx, gcfg.OAuth2Token),
 	}
 
-	return gcfg, nil
+	return gcfg, gcfg.SetTokenStore(nil)
 }
 
-// Save writes the contents of AuthConfig back to the JSON file it was
-// loaded from.
-func (c AuthConfig) Save() error {
-	configData, err := json.MarshalIndent(&c, "", "    ")
+// SetTokenStore makes the client of c save the tokens it refreshes to store,
+// and start from the token stored there, if any, rather than OAuth2Token.
+// A nil store keeps them in memory.
+//
+// It replaces c.Auth.Client, so it must be called before the services are
+// created from c.Auth.
+func (c *AuthConfig) SetTokenStore(store TokenStore) error {
+	var source *TokenSource
+	var err error
+	if c.jwtConfig != nil {
+		source, err = newTokenSource(jwtRefresh(c.jwtConfig), c.OAuth2Token, store)
+	} else {
+		source, err = NewTokenSource(c.OAuth2Config, c.OAuth2Token, store)
+	}
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(c.file, configData, 0600)
+	c.tokenSource = source
+	c.OAuth2Token = source.current()
+	c.Auth.Client = newContextClient(source)
+	return nil
+}
+
+// Save writes the contents of AuthConfig, with its latest token, back to
+// the JSON file it was loaded from.  It returns ErrNoConfigFile when the
+// AuthConfig was not loaded from a file, and ErrServiceAccountConfig for
+// service accounts.
+func (c *AuthConfig) Save() error {
+	if c.jwtConfig != nil {
+		return ErrServiceAccountConfig
+	}
+	if c.file == "" {
+		return ErrNoConfigFile
+	}
+	return c.SaveAs(c.file)
 }
 
-// Token implements oauth2.TokenSource interface and store updates to
-// config file.
-func (c AuthConfig) Token() (token *oauth2.Token, err error) {
-	// use cached token
-	if c.OAuth2Token.Valid() {
-		return c.OAuth2Token, err
+// SaveAs writes the contents of AuthConfig, with its latest token, to the
+// JSON file path, which Save writes to from then on.  The AuthConfigs of
+// service accounts return ErrServiceAccountConfig.
+func (c *AuthConfig) SaveAs(path string) error {
+	if c.jwtConfig != nil {
+		return ErrServiceAccountConfig
 	}
+	sourceMu.Lock()
+	source := c.tokenSource
+	sourceMu.Unlock()
+	if source != nil {
+		c.OAuth2Token = source.current()
+	}
+	configData, err := json.MarshalIndent(c, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(path, configData); err != nil {
+		return err
+	}
+	c.file = path
+	return nil
+}
+
+// Token implements the oauth2.TokenSource interface, refreshing the token
+// when it has expired and saving the new one to the token store.  It is
+// safe for concurrent use.  OAuth2Token is left as it is, SaveAs writes the
+// latest token.
+func (c *AuthConfig) Token() (*oauth2.Token, error) {
+	source, err := c.source()
+	if err != nil {
+		return nil, err
+	}
+	return source.Token()
+}
+
+// sourceMu guards the token source made by AuthConfig.source on first use.
+var sourceMu sync.Mutex
+
+// source returns the token source of c, keeping the tokens in memory when
+// SetTokenStore was not called.
+func (c *AuthConfig) source() (*TokenSource, error) {
+	sourceMu.Lock()
+	defer sourceMu.Unlock()
+	if c.tokenSource == nil {
+		if err := c.SetTokenStore(nil); err != nil {
+			return nil, err
+		}
+	}
+	return c.tokenSource, nil
+}
+
+// LastSaveError returns the error of the token store saving the last token
+// refreshed, see TokenSource.LastSaveError.
+func (c *AuthConfig) LastSaveError() error {
+	sourceMu.Lock()
+	source := c.tokenSource
+	sourceMu.Unlock()
+	if source == nil {
+		return nil
+	}
+	return source.LastSaveError()
+}
+
+// contextTransport authorizes requests like oauth2.Transport, but refreshes
+// an expired token with the context of the request being sent.  Cancelling
+// a call therefore also cancels the token refresh it is waiting on.
+type contextTransport struct {
+	source *TokenSource
+	base   http.RoundTripper
+}
+
+func newContextClient(source *TokenSource) *http.Client {
+	return &http.Client{
+		Transport: &contextTransport{
+			source: source,
+			base:   http.DefaultTransport,
+		},
+	}
+}
 
-	// get new token from tokens source and store
-	c.OAuth2Token, err = c.tokenSource.Token()
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.TokenContext(req.Context())
 	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
 		return nil, err
 	}
-	return token, c.Save()
+	authReq := req.Clone(req.Context())
+	token.SetAuthHeader(authReq)
+	return t.base.RoundTrip(authReq)
 }
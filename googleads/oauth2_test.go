@@ -0,0 +1,183 @@
+package v201809
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenServer is an OAuth2 token endpoint rotating the refresh token on
+// every refresh.
+func tokenServer(refreshes *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(refreshes, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"access%d","refresh_token":"refresh%d","token_type":"Bearer","expires_in":3600}`, n, n)
+	}))
+}
+
+func TestTokenSourceRefreshesOnce(t *testing.T) {
+	var refreshes int32
+	srv := tokenServer(&refreshes)
+	defer srv.Close()
+
+	store := FileTokenStore{Path: filepath.Join(t.TempDir(), "token.json")}
+	config := &oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL}}
+	source, err := NewTokenSource(config, &oauth2.Token{RefreshToken: "refresh0"}, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := source.Token(); err != nil || token.AccessToken != "access1" {
+				t.Errorf("unexpected token %v %v", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if refreshes != 1 {
+		t.Errorf("want 1 refresh, got %d", refreshes)
+	}
+
+	stored, err := store.LoadToken()
+	if err != nil || stored.RefreshToken != "refresh1" {
+		t.Fatalf("want the rotated token stored, got %v %v", stored, err)
+	}
+	// A new source starts from the stored token rather than the stale one.
+	source, _ = NewTokenSource(config, &oauth2.Token{RefreshToken: "refresh0"}, store)
+	if token, _ := source.Token(); token.AccessToken != "access1" || refreshes != 1 {
+		t.Errorf("want the stored token, got %v after %d refreshes", token, refreshes)
+	}
+}
+
+func TestNewCredentialsFromFileSavesTokens(t *testing.T) {
+	var refreshes int32
+	srv := tokenServer(&refreshes)
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	data, _ := json.Marshal(AuthConfig{
+		OAuth2Config: &oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL}},
+		OAuth2Token:  &oauth2.Token{AccessToken: "access0", RefreshToken: "refresh0", Expiry: time.Now().Add(-time.Hour)},
+		Auth:         Auth{CustomerId: "1"},
+	})
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	config, err := NewCredentialsFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer access1" {
+			t.Errorf("unexpected Authorization %q", got)
+		}
+	}))
+	defer api.Close()
+	req, _ := http.NewRequest("GET", api.URL, nil)
+	resp, err := config.Auth.Client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	saved, err := NewCredentialsFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.OAuth2Token.RefreshToken != "refresh1" || saved.Auth.CustomerId != "1" {
+		t.Errorf("unexpected saved config %+v", saved)
+	}
+	if token, err := config.Token(); err != nil || token.AccessToken != "access1" || refreshes != 1 {
+		t.Errorf("want the refreshed token, got %v %v", token, err)
+	}
+}
+
+func TestAuthConfigTokenConcurrent(t *testing.T) {
+	var refreshes int32
+	srv := tokenServer(&refreshes)
+	defer srv.Close()
+
+	config := &AuthConfig{
+		OAuth2Config: &oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL}},
+		OAuth2Token:  &oauth2.Token{RefreshToken: "refresh0"},
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := config.Token(); err != nil || token.AccessToken != "access1" {
+				t.Errorf("unexpected token %v %v", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if refreshes != 1 {
+		t.Errorf("want 1 refresh, got %d", refreshes)
+	}
+}
+
+func TestTokenStoreErrorKeepsRequests(t *testing.T) {
+	var refreshes int32
+	srv := tokenServer(&refreshes)
+	defer srv.Close()
+
+	config := AuthConfig{
+		OAuth2Config: &oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL}},
+		OAuth2Token:  &oauth2.Token{RefreshToken: "refresh0"},
+	}
+	if err := config.SetTokenStore(TokenStoreFunc(func(*oauth2.Token) error {
+		return errors.New("disk full")
+	})); err != nil {
+		t.Fatal(err)
+	}
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer access1" {
+			t.Errorf("unexpected Authorization %q", got)
+		}
+	}))
+	defer api.Close()
+	req, _ := http.NewRequest("GET", api.URL, nil)
+	resp, err := config.Auth.Client.Do(req)
+	if err != nil {
+		t.Fatalf("want the request sent despite the store, got %v", err)
+	}
+	resp.Body.Close()
+	if err := config.LastSaveError(); err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("want the error of the store, got %v", err)
+	}
+}
+
+func TestSaveWithoutFile(t *testing.T) {
+	config, err := NewCredentialsFromParams(Credentials{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := config.Save(); err != ErrNoConfigFile {
+		t.Errorf("want ErrNoConfigFile, got %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := config.SaveAs(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.Save(); err != nil {
+		t.Errorf("want Save to write %s, got %v", path, err)
+	}
+}
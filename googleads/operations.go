@@ -0,0 +1,38 @@
+package v201809
+
+import (
+	"reflect"
+	"sort"
+)
+
+// actionOrder is the rank of the operators in the requests of the
+// map-based Mutate methods.  Operators not listed come last, sorted by
+// name.
+var actionOrder = map[string]int{
+	"ADD":    0,
+	"SET":    1,
+	"REMOVE": 2,
+}
+
+// sortedActions returns the operators of operations, a map of operator to
+// operands such as CampaignOperations, in the order the map-based Mutate
+// methods send them, described in the package doc.
+func sortedActions(operations interface{}) []string {
+	keys := reflect.ValueOf(operations).MapKeys()
+	actions := make([]string, len(keys))
+	for i, k := range keys {
+		actions[i] = k.String()
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		ri, iKnown := actionOrder[actions[i]]
+		rj, jKnown := actionOrder[actions[j]]
+		switch {
+		case iKnown && jKnown:
+			return ri < rj
+		case iKnown != jKnown:
+			return iKnown
+		}
+		return actions[i] < actions[j]
+	})
+	return actions
+}
@@ -0,0 +1,102 @@
+package v201809
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func TestMutateOperationOrder(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	srv.Respond("LabelService", "mutate", gadstest.Rval("mutate", ""))
+	srv.Respond("AdGroupAdService", "mutate", gadstest.Rval("mutate", ""))
+	auth := simulatorAuth(srv)
+
+	operator := regexp.MustCompile(`<operator>(\w+)</operator>`)
+	operators := func(body []byte) (ops []string) {
+		for _, m := range operator.FindAllSubmatch(body, -1) {
+			ops = append(ops, string(m[1]))
+		}
+		return ops
+	}
+
+	for i := 0; i < 10; i++ {
+		_, err := NewLabelService(auth).Mutate(LabelOperations{
+			"REMOVE": {NewTextLabel("r1"), NewTextLabel("r2")},
+			"SET":    {NewTextLabel("s")},
+			"ADD":    {NewTextLabel("a1"), NewTextLabel("a2")},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []string{"ADD", "ADD", "SET", "REMOVE", "REMOVE"}
+	for _, req := range srv.RequestsFor("LabelService", "mutate") {
+		if got := operators(req.Body); !reflect.DeepEqual(got, want) {
+			t.Fatalf("want %v, got %v", want, got)
+		}
+	}
+
+	_, err := NewAdGroupAdService(auth).MutateOperations([]AdGroupAdOperation{
+		{Action: "REMOVE", AdGroupAd: ExpandedTextAd{AdGroupId: 1, Id: 2}},
+		{Action: "ADD", AdGroupAd: ExpandedTextAd{AdGroupId: 1, HeadlinePart1: "h"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqs := srv.RequestsFor("AdGroupAdService", "mutate")
+	if got := operators(reqs[0].Body); !reflect.DeepEqual(got, []string{"REMOVE", "ADD"}) {
+		t.Errorf("want the given order, got %v", got)
+	}
+	if !regexp.MustCompile(`<operand>\s*<adGroupId>1</adGroupId>\s*<ad [^>]*type="ExpandedTextAd"`).Match(reqs[0].Body) {
+		t.Errorf("unexpected operand in %s", reqs[0].Body)
+	}
+
+	if got := sortedActions(map[string][]int{"REMOVE": nil, "b": nil, "ADD": nil, "a": nil, "SET": nil}); !reflect.DeepEqual(got, []string{"ADD", "SET", "REMOVE", "a", "b"}) {
+		t.Errorf("unexpected order %v", got)
+	}
+}
+
+func TestBatchJobOperationOrder(t *testing.T) {
+	var bodies [][]byte
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.Header().Set("Location", srv.URL+"/upload")
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, body)
+	}))
+	defer srv.Close()
+
+	helper := NewBatchJobHelper(&Auth{})
+	for i := 0; i < 10; i++ {
+		err := helper.UploadBatchJobOperations([]interface{}{BudgetOperations{
+			"REMOVE": {{Id: 1}, {Id: 2}},
+			"SET":    {{Id: 3}},
+			"ADD":    {{Name: "a1"}, {Name: "a2"}},
+		}}, TemporaryUrl{Url: srv.URL})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	operand := regexp.MustCompile(`<operator[^>]*>(\w+)</operator>\s*<operand[^>]*>\s*<(budgetId|name)[^>]*>(\w+)<`)
+	want := []string{"ADD a1", "ADD a2", "SET 3", "REMOVE 1", "REMOVE 2"}
+	for _, body := range bodies {
+		var got []string
+		for _, m := range operand.FindAllSubmatch(body, -1) {
+			got = append(got, string(m[1])+" "+string(m[3]))
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("want %v, got %v in %s", want, got, body)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package v201809
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+)
+
+const (
+	// MaxOffset is the highest start index plus page size the API accepts
+	// in a selector.
+	MaxOffset = 100000
+	// DefaultPageSize is the page size of a Pager without one.
+	DefaultPageSize = 500
+)
+
+// ErrOffsetLimit is returned by a Pager that reaches MaxOffset without an
+// id field to page on, e.g. for criteria whose ids are not unique.  The
+// predicates of the selector must then be narrowed.
+var ErrOffsetLimit = errors.New("gads: paging offset limit reached")
+
+// PageOptions configure a Pager.
+type PageOptions struct {
+	// PageSize is the number of entries requested per call,
+	// DefaultPageSize when zero.
+	PageSize int64
+	// Prefetch requests the next page while the current one is being
+	// used.
+	Prefetch bool
+}
+
+// pageFetcher gets a page of entries.  entries is the slice of entities in
+// page.
+type pageFetcher func(ctx context.Context, selector Selector) (page, entries interface{}, totalCount int64, err error)
+
+type pageResult struct {
+	page, entries interface{}
+	totalCount    int64
+	err           error
+}
+
+// A Pager iterates over the pages of entities matching a selector:
+//
+//	pages := campaignService.Pages(ctx, gads.Selector{Fields: []string{"Id", "Name"}}, nil)
+//	defer pages.Close()
+//	for pages.Next() {
+//		for _, campaign := range pages.Campaigns() {
+//			...
+//		}
+//	}
+//	if err := pages.Err(); err != nil {
+//		...
+//	}
+//
+// Unless the selector has its own ordering, entities are ordered by id and,
+// once MaxOffset is reached, paging goes on with a predicate on the ids
+// greater than the last one seen.
+type Pager struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	selector Selector
+	pageSize int64
+	prefetch bool
+	fetch    pageFetcher
+	// idField is the selector field to page on past maxOffset, goField
+	// the matching field of the entities.
+	idField, goField string
+	maxOffset        int64
+	// pointPaging is set for bid landscapes, whose paging counts landscape
+	// points rather than entries, a landscape spanning pages.  Their
+	// totalNumEntries is unreliable and is ignored.
+	pointPaging bool
+
+	offset     int64
+	minId      string
+	more       bool
+	pending    chan pageResult
+	current    pageResult
+	totalCount int64
+	err        error
+}
+
+func newPager(ctx context.Context, selector Selector, opts *PageOptions, idField, goField string, fetch pageFetcher) *Pager {
+	if opts == nil {
+		opts = &PageOptions{}
+	}
+	p := &Pager{
+		selector:   selector,
+		pageSize:   opts.PageSize,
+		prefetch:   opts.Prefetch,
+		fetch:      fetch,
+		idField:    idField,
+		goField:    goField,
+		maxOffset:  MaxOffset,
+		more:       true,
+		totalCount: -1,
+	}
+	if p.pageSize <= 0 {
+		p.pageSize = DefaultPageSize
+	}
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	if p.selector.Paging != nil {
+		p.offset = p.selector.Paging.Offset
+	}
+	if idField != "" {
+		switch {
+		case len(selector.Ordering) == 0:
+			p.selector.Ordering = []OrderBy{{Field: idField, SortOrder: "ASCENDING"}}
+		case len(selector.Ordering) > 1 || selector.Ordering[0] != (OrderBy{idField, "ASCENDING"}):
+			p.idField = ""
+		}
+	}
+	if p.idField != "" && !containsString(selector.Fields, idField) {
+		p.selector.Fields = append(append([]string{}, selector.Fields...), idField)
+	}
+	return p
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// pageSelector returns the selector of the next page.
+func (p *Pager) pageSelector() Selector {
+	sel := p.selector
+	sel.Paging = &Paging{Offset: p.offset, Limit: p.pageSize}
+	if p.minId != "" {
+		sel.Predicates = append(append([]Predicate{}, sel.Predicates...),
+			Predicate{Field: p.idField, Operator: "GREATER_THAN", Values: []string{p.minId}})
+	}
+	return sel
+}
+
+func (p *Pager) get() pageResult {
+	if p.offset+p.pageSize > p.maxOffset {
+		return pageResult{err: ErrOffsetLimit}
+	}
+	var r pageResult
+	r.page, r.entries, r.totalCount, r.err = p.fetch(p.ctx, p.pageSelector())
+	return r
+}
+
+// advance moves past the page r and reports whether there may be more.
+func (p *Pager) advance(r pageResult) bool {
+	if p.pointPaging {
+		n := landscapePoints(r.entries)
+		p.offset += n
+		return n >= p.pageSize
+	}
+	n := int64(reflect.ValueOf(r.entries).Len())
+	p.offset += p.pageSize
+	if n < p.pageSize || p.offset >= r.totalCount {
+		return false
+	}
+	if p.idField != "" && p.offset+p.pageSize > p.maxOffset {
+		if id, ok := lastId(r.entries, p.goField); ok {
+			p.minId, p.offset = id, 0
+		}
+	}
+	return true
+}
+
+// landscapePoints returns the number of landscape points of the bid
+// landscapes in entries.
+func landscapePoints(entries interface{}) int64 {
+	var n int64
+	v := reflect.ValueOf(entries)
+	for i := 0; i < v.Len(); i++ {
+		n += int64(v.Index(i).FieldByName("LandscapePoints").Len())
+	}
+	return n
+}
+
+// lastId returns the goField of the last entity of entries.
+func lastId(entries interface{}, goField string) (string, bool) {
+	v := reflect.ValueOf(entries)
+	e := v.Index(v.Len() - 1)
+	for e.Kind() == reflect.Interface || e.Kind() == reflect.Ptr {
+		e = e.Elem()
+	}
+	if e.Kind() != reflect.Struct {
+		return "", false
+	}
+	id := e.FieldByName(goField)
+	if id.Kind() != reflect.Int64 {
+		return "", false
+	}
+	return strconv.FormatInt(id.Int(), 10), true
+}
+
+// Next gets the next page and reports whether there is one.  It releases
+// the context of the Pager when there is none.
+func (p *Pager) Next() bool {
+	if !p.next() {
+		p.cancel()
+		return false
+	}
+	return true
+}
+
+func (p *Pager) next() bool {
+	if p.err != nil || !p.more {
+		return false
+	}
+	var r pageResult
+	if p.pending != nil {
+		r = <-p.pending
+		p.pending = nil
+	} else {
+		r = p.get()
+	}
+	if r.err != nil {
+		p.err = r.err
+		return false
+	}
+	if p.totalCount < 0 {
+		p.totalCount = r.totalCount
+	}
+	if reflect.ValueOf(r.entries).Len() == 0 {
+		p.more = false
+		return false
+	}
+	p.current = r
+	p.more = p.advance(r)
+	if p.more && p.prefetch {
+		p.pending = make(chan pageResult, 1)
+		go func(pending chan pageResult) {
+			pending <- p.get()
+		}(p.pending)
+	}
+	return true
+}
+
+// Page returns the current page, as returned by the Get method of the
+// service.
+func (p *Pager) Page() interface{} {
+	return p.current.page
+}
+
+// TotalCount returns the number of entities matching the selector, as of
+// the first page.
+func (p *Pager) TotalCount() int64 {
+	return p.totalCount
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// Close stops a prefetch in flight.  It need not be called once Next
+// returns false.
+func (p *Pager) Close() {
+	p.cancel()
+}
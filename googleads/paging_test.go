@@ -0,0 +1,106 @@
+package v201809
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func TestPagerIdRanges(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	gadstest.NewSimulator(srv)
+	auth := simulatorAuth(srv)
+	budgets := NewBudgetService(auth)
+	for i := 0; i < 7; i++ {
+		if _, err := budgets.Mutate(BudgetOperations{"ADD": {{Name: fmt.Sprintf("budget %d", i), Amount: 1}}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, prefetch := range []bool{false, true} {
+		before := len(srv.RequestsFor("BudgetService", "get"))
+		pages := budgets.Pages(context.Background(), Selector{Fields: []string{"BudgetName"}}, &PageOptions{PageSize: 2, Prefetch: prefetch})
+		pages.maxOffset = 4
+		var ids []int64
+		for pages.Next() {
+			for _, b := range pages.Budgets() {
+				if len(ids) > 0 && b.Id <= ids[len(ids)-1] {
+					t.Errorf("prefetch %v: budget %d out of order after %v", prefetch, b.Id, ids)
+				}
+				ids = append(ids, b.Id)
+			}
+		}
+		if err := pages.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if pages.ctx.Err() == nil {
+			t.Errorf("prefetch %v: context not released once Next returned false", prefetch)
+		}
+		if len(ids) != 7 || pages.TotalCount() != 7 {
+			t.Errorf("prefetch %v: want 7 budgets, got %v of %d", prefetch, ids, pages.TotalCount())
+		}
+		requests := srv.RequestsFor("BudgetService", "get")[before:]
+		if len(requests) != 4 {
+			t.Errorf("prefetch %v: want 4 requests, got %d", prefetch, len(requests))
+		}
+		if body := string(requests[len(requests)-1].Body); !strings.Contains(body, "GREATER_THAN") {
+			t.Errorf("prefetch %v: want an id predicate past the offset limit, got %s", prefetch, body)
+		}
+	}
+
+	pages := budgets.Pages(context.Background(), Selector{
+		Fields:   []string{"BudgetId", "BudgetName"},
+		Ordering: []OrderBy{{"BudgetName", "DESCENDING"}},
+	}, &PageOptions{PageSize: 2})
+	pages.maxOffset = 4
+	n := 0
+	for pages.Next() {
+		n += len(pages.Budgets())
+	}
+	if !errors.Is(pages.Err(), ErrOffsetLimit) || n != 4 {
+		t.Errorf("want ErrOffsetLimit after 4 budgets, got %v after %d", pages.Err(), n)
+	}
+	if pages.ctx.Err() == nil {
+		t.Error("context not released after an error")
+	}
+}
+
+func TestPagerBidLandscapePoints(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	var offsets []int64
+	srv.Handle("DataService", "getCriterionBidLandscape", func(req *gadstest.Request) (string, error) {
+		var get struct {
+			Offset int64 `xml:"serviceSelector>paging>startIndex"`
+		}
+		if err := req.Decode(&get); err != nil {
+			return "", err
+		}
+		offsets = append(offsets, get.Offset)
+		// A single landscape of 3 points, of which a page holds 2.
+		points := map[int64]string{
+			0: `<landscapePoints><clicks>1</clicks></landscapePoints><landscapePoints><clicks>2</clicks></landscapePoints>`,
+			2: `<landscapePoints><clicks>3</clicks></landscapePoints>`,
+		}[get.Offset]
+		return gadstest.Rval("getCriterionBidLandscape", `<totalNumEntries>1</totalNumEntries><entries><criterionId>7</criterionId>`+points+`</entries>`), nil
+	})
+
+	pages := NewDataService(simulatorAuth(srv)).CriterionBidLandscapePages(context.Background(), Selector{Fields: []string{"Bid"}}, &PageOptions{PageSize: 2})
+	var points int
+	for pages.Next() {
+		for _, l := range pages.CriterionBidLandscapes() {
+			points += len(l.LandscapePoints)
+		}
+	}
+	if err := pages.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if points != 3 || len(offsets) != 2 || offsets[1] != 2 {
+		t.Errorf("want 3 points from offsets 0 and 2, got %d from %v", points, offsets)
+	}
+}
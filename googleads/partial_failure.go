@@ -0,0 +1,222 @@
+package v201809
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// An OperationResult is the outcome of one operation of a mutate call.
+type OperationResult struct {
+	// Index is the position of the operation in the request.
+	Index    int
+	Operator string
+	// Operand is the entity the operation was sent with.
+	Operand interface{}
+	// Value is the entity returned for the operation, nil when it failed.
+	Value  interface{}
+	Errors []error
+}
+
+// Failed reports whether the operation failed.
+func (r OperationResult) Failed() bool {
+	return len(r.Errors) > 0
+}
+
+// PartialFailureError is returned by mutate calls made with
+// Auth.PartialFailure when some of the operations failed.  The other
+// operations are applied and the call still returns their entities; the
+// entries of the failed operations are zero values.
+//
+//	campaigns, err := campaignService.MutateOperations(ops)
+//	var pf *gads.PartialFailureError
+//	if errors.As(err, &pf) {
+//		for _, r := range pf.Failed() {
+//			log.Printf("%s %v: %v", r.Operator, r.Operand, r.Errors)
+//		}
+//	}
+type PartialFailureError struct {
+	// Results holds the outcome of every operation, in request order.
+	Results []OperationResult
+	// Errors holds every error reported, including those whose field path
+	// names no operation.
+	Errors []error
+	// RequestId is the id the API answered the request with, empty for
+	// the merged errors of several requests, see ChunkError.
+	RequestId string
+}
+
+func (e *PartialFailureError) Error() string {
+	failed := e.Failed()
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	msg := fmt.Sprintf("%d of %d operations failed: %s", len(failed), len(e.Results), strings.Join(msgs, ", "))
+	if e.RequestId != "" {
+		msg += fmt.Sprintf(" (request id %s)", e.RequestId)
+	}
+	return msg
+}
+
+// Is reports whether one of the errors of e matches target.
+func (e *PartialFailureError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error of e that matches target.
+func (e *PartialFailureError) As(target interface{}) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Failed returns the results of the operations that failed.
+func (e *PartialFailureError) Failed() (failed []OperationResult) {
+	for _, r := range e.Results {
+		if r.Failed() {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// OperationErrors returns the errors of operation i, nil when it succeeded.
+func (e *PartialFailureError) OperationErrors(i int) []error {
+	if i < 0 || i >= len(e.Results) {
+		return nil
+	}
+	return e.Results[i].Errors
+}
+
+// apiErrors decodes the partialFailureErrors of a mutate response.
+type apiErrors []error
+
+func (errs *apiErrors) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	e, _, err := decodeApiError(dec, start)
+	if err != nil {
+		return err
+	}
+	*errs = append(*errs, e)
+	return nil
+}
+
+var operationIndexRegexp = regexp.MustCompile(`^operations\[(\d+)\]`)
+
+// operationIndex returns the index of the operation named by the field path
+// of err, or -1.
+func operationIndex(err error) int {
+	e, ok := err.(TypedApiError)
+	if !ok {
+		return -1
+	}
+	m := operationIndexRegexp.FindStringSubmatch(e.Entity().FieldPath)
+	if m == nil {
+		return -1
+	}
+	i, _ := strconv.Atoi(m[1])
+	return i
+}
+
+// shiftOperationIndex returns err with the operation index of its field
+// path moved by offset, e.g. to operations[5] from operations[3] for an
+// offset of 2.  Errors without an operation index are returned as is.
+func shiftOperationIndex(err error, offset int) error {
+	if offset == 0 || operationIndex(err) < 0 || reflect.TypeOf(err).Kind() != reflect.Struct {
+		return err
+	}
+	v := reflect.New(reflect.TypeOf(err)).Elem()
+	v.Set(reflect.ValueOf(err))
+	entity := v
+	if v.Type() != reflect.TypeOf(EntityError{}) {
+		entity = v.FieldByName("EntityError")
+	}
+	e := entity.Addr().Interface().(*EntityError)
+	e.FieldPath = operationIndexRegexp.ReplaceAllStringFunc(e.FieldPath, func(m string) string {
+		i, _ := strconv.Atoi(operationIndexRegexp.FindStringSubmatch(m)[1])
+		return fmt.Sprintf("operations[%d]", i+offset)
+	})
+	elements := make([]FieldPathElement, len(e.FieldPathElements))
+	copy(elements, e.FieldPathElements)
+	if len(elements) > 0 && elements[0].Field == "operations" && elements[0].Index != nil {
+		i := *elements[0].Index + offset
+		elements[0].Index = &i
+	}
+	e.FieldPathElements = elements
+	return v.Interface().(error)
+}
+
+// partialFailure pairs the operations of a mutate call with the values
+// returned for them and the errors in errs.  ops is a slice of operation
+// structs whose operator and operand are tagged as such, values the slice
+// of returned entities or nil, requestId the id of the response.  It
+// returns nil when errs is empty.
+func partialFailure(requestId string, ops interface{}, values interface{}, errs apiErrors) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	opsValue := reflect.ValueOf(ops)
+	valuesValue := reflect.ValueOf(values)
+	pf := &PartialFailureError{
+		Results:   make([]OperationResult, opsValue.Len()),
+		Errors:    errs,
+		RequestId: requestId,
+	}
+	for i := range pf.Results {
+		r := &pf.Results[i]
+		r.Index = i
+		r.Operator, r.Operand = operatorAndOperand(opsValue.Index(i))
+	}
+	for _, err := range errs {
+		if i := operationIndex(err); i >= 0 && i < len(pf.Results) {
+			pf.Results[i].Errors = append(pf.Results[i].Errors, err)
+		}
+	}
+	for i := range pf.Results {
+		if r := &pf.Results[i]; !r.Failed() && valuesValue.IsValid() && i < valuesValue.Len() {
+			r.Value = valuesValue.Index(i).Interface()
+		}
+	}
+	return pf
+}
+
+// operatorAndOperand returns the fields of an operation struct tagged
+// operator and operand.
+func operatorAndOperand(op reflect.Value) (operator string, operand interface{}) {
+	for op.Kind() == reflect.Interface || op.Kind() == reflect.Ptr {
+		op = op.Elem()
+	}
+	if op.Kind() != reflect.Struct {
+		return "", nil
+	}
+	t := op.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("xml"), ",")[0]
+		if j := strings.LastIndex(name, " "); j >= 0 {
+			name = name[j+1:]
+		}
+		switch name {
+		case "operator":
+			operator = op.Field(i).String()
+		case "operand":
+			operand = op.Field(i).Interface()
+			// AdGroupAdService wraps each ad in an AdGroupAds
+			if ads, ok := operand.(AdGroupAds); ok && len(ads) == 1 {
+				operand = ads[0]
+			}
+		}
+	}
+	return operator, operand
+}
@@ -0,0 +1,109 @@
+package v201809
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func simulatorAuth(srv *gadstest.Server) *Auth {
+	return &Auth{
+		CustomerId:     "123-456-7890",
+		DeveloperToken: "dev-token",
+		PartialFailure: true,
+		Client:         srv.Client(),
+		Endpoints:      &Endpoints{Host: srv.URL},
+		RetryPolicy:    NoRetry,
+	}
+}
+
+func TestPartialFailureCampaigns(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	sim := gadstest.NewSimulator(srv)
+	budgetId, err := sim.Add("BudgetService", `<operand><name>b</name><amount><microAmount>1</microAmount></amount></operand>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := strconv.ParseInt(budgetId, 10, 64)
+
+	campaigns, err := NewCampaignService(simulatorAuth(srv)).MutateOperations([]CampaignOperation{
+		{Action: "ADD", Campaign: Campaign{Name: "one", BudgetId: id}},
+		{Action: "ADD", Campaign: Campaign{Name: "two", BudgetId: 42}},
+		{Action: "ADD", Campaign: Campaign{Name: "three", BudgetId: id}},
+	})
+	var pf *PartialFailureError
+	if !errors.As(err, &pf) {
+		t.Fatalf("expected a PartialFailureError, got %v", err)
+	}
+	if len(campaigns) != 3 || campaigns[0].Id == 0 || campaigns[2].Id == 0 {
+		t.Fatalf("unexpected campaigns %#v", campaigns)
+	}
+	if len(campaigns[1].Errors) != 1 || campaigns[0].Errors != nil {
+		t.Errorf("unexpected campaign errors %v %v", campaigns[0].Errors, campaigns[1].Errors)
+	}
+
+	failed := pf.Failed()
+	if len(failed) != 1 || failed[0].Index != 1 || failed[0].Operator != "ADD" || failed[0].Value != nil {
+		t.Fatalf("unexpected failures %#v", failed)
+	}
+	if c, ok := failed[0].Operand.(Campaign); !ok || c.Name != "two" {
+		t.Errorf("unexpected operand %#v", failed[0].Operand)
+	}
+	var e EntityNotFound
+	if !errors.As(failed[0].Errors[0], &e) || e.FieldPath != "operations[1].operand.budget.budgetId" || e.Reason != "INVALID_ID" {
+		t.Errorf("unexpected error %#v", failed[0].Errors[0])
+	}
+	if c, ok := pf.Results[2].Value.(Campaign); !ok || c.Name != "three" {
+		t.Errorf("unexpected result %#v", pf.Results[2])
+	}
+}
+
+func TestPartialFailureAdGroupCriteria(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	sim := gadstest.NewSimulator(srv)
+	budgetId, _ := sim.Add("BudgetService", `<operand><name>b</name><amount><microAmount>1</microAmount></amount></operand>`)
+	campaignId, _ := sim.Add("CampaignService", `<operand><name>c</name><budget><budgetId>`+budgetId+`</budgetId></budget></operand>`)
+	adGroupId, err := sim.Add("AdGroupService", `<operand><name>ag</name><campaignId>`+campaignId+`</campaignId></operand>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, _ := strconv.ParseInt(adGroupId, 10, 64)
+
+	criteria, err := NewAdGroupCriterionService(simulatorAuth(srv)).MutateOperations([]AdGroupCriterionOperation{
+		{Action: "ADD", AdGroupCriterion: BiddableAdGroupCriterion{AdGroupId: 1, Criterion: KeywordCriterion{Text: "lost", MatchType: "EXACT"}}},
+		{Action: "ADD", AdGroupCriterion: BiddableAdGroupCriterion{AdGroupId: id, Criterion: KeywordCriterion{Text: "found", MatchType: "EXACT"}}},
+	})
+	var pf *PartialFailureError
+	if !errors.As(err, &pf) {
+		t.Fatalf("expected a PartialFailureError, got %v", err)
+	}
+	if len(criteria) != 2 {
+		t.Fatalf("unexpected criteria %#v", criteria)
+	}
+	if errs := pf.OperationErrors(0); len(errs) != 1 {
+		t.Errorf("expected an error for operation 0, got %v", errs)
+	}
+	if pf.OperationErrors(1) != nil {
+		t.Errorf("unexpected errors for operation 1: %v", pf.OperationErrors(1))
+	}
+	if _, ok := pf.Results[1].Value.(BiddableAdGroupCriterion); !ok {
+		t.Errorf("unexpected result %#v", pf.Results[1])
+	}
+}
+
+func TestPartialFailureNone(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	gadstest.NewSimulator(srv)
+
+	budgets, err := NewBudgetService(simulatorAuth(srv)).Mutate(BudgetOperations{
+		"ADD": {{Name: "b", Amount: 1}},
+	})
+	if err != nil || len(budgets) != 1 {
+		t.Fatalf("unexpected result %#v %v", budgets, err)
+	}
+}
@@ -0,0 +1,168 @@
+package v201809
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Profiles are named sets of credentials, read from a YAML file holding a
+// mapping of profiles, each with the keys of the adwords section of
+// googleads.yaml.  A profile extends another with the extends key, taking
+// the settings it does not set from it, e.g. to share an OAuth2 client
+// between the profiles of several manager accounts:
+//
+//	profiles:
+//	  client:
+//	    client_id: 1234.apps.googleusercontent.com
+//	    client_secret: secret
+//	  agency:
+//	    extends: client
+//	    developer_token: AAAA
+//	    refresh_token: 1/agency
+//	    client_customer_id: 111-111-1111
+//	  brand:
+//	    extends: client
+//	    developer_token: BBBB
+//	    refresh_token: 1/brand
+//
+// The command-line tools take the name of a profile with -profile.
+type Profiles struct {
+	path     string
+	profiles map[string]map[string]string
+
+	mu      sync.Mutex
+	configs map[string]*AuthConfig
+}
+
+// DefaultProfilesFile returns the path profiles are read from by default,
+// gads/profiles.yaml in the user configuration directory, e.g.
+// ~/.config/gads/profiles.yaml.
+func DefaultProfilesFile() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "gads", "profiles.yaml")
+}
+
+// LoadProfiles reads the profiles file path, $ADWORDS_PROFILES_FILE or
+// DefaultProfilesFile when empty.
+func LoadProfiles(path string) (*Profiles, error) {
+	if path == "" {
+		path = os.Getenv(EnvProfilesFile)
+	}
+	if path == "" {
+		path = DefaultProfilesFile()
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	values, err := parseYAML(f)
+	if err != nil {
+		return nil, fmt.Errorf("gads: %s: %v", path, err)
+	}
+	p := &Profiles{path: path, profiles: map[string]map[string]string{}, configs: map[string]*AuthConfig{}}
+	for k, v := range values {
+		parts := strings.SplitN(k, ".", 3)
+		if len(parts) != 3 || parts[0] != "profiles" {
+			return nil, fmt.Errorf("gads: %s: unexpected key %s", path, k)
+		}
+		if p.profiles[parts[1]] == nil {
+			p.profiles[parts[1]] = map[string]string{}
+		}
+		p.profiles[parts[1]][parts[2]] = v
+	}
+	return p, nil
+}
+
+// Names returns the names of the profiles, sorted.
+func (p *Profiles) Names() []string {
+	var names []string
+	for name := range p.profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// settings returns the settings of LoadConfig of the profile name, with
+// those of the profiles it extends.
+func (p *Profiles) settings(name string) (map[string]string, error) {
+	values, err := p.resolve(name, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	settings := map[string]string{}
+	for k, v := range values {
+		key, ok := yamlKeys["adwords."+k]
+		if !ok {
+			return nil, fmt.Errorf("gads: %s: unknown key %s of profile %s", p.path, k, name)
+		}
+		settings[key] = v
+	}
+	return settings, nil
+}
+
+func (p *Profiles) resolve(name string, seen map[string]bool) (map[string]string, error) {
+	profile, ok := p.profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("gads: %s: no profile %s", p.path, name)
+	}
+	if seen[name] {
+		return nil, fmt.Errorf("gads: %s: profile %s extends itself", p.path, name)
+	}
+	seen[name] = true
+	values := map[string]string{}
+	if base := profile["extends"]; base != "" {
+		var err error
+		if values, err = p.resolve(base, seen); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range profile {
+		if k != "extends" {
+			values[k] = v
+		}
+	}
+	return values, nil
+}
+
+// Config returns the AuthConfig of the profile name.  The configs of a
+// profile share their token source, so it is refreshed once for all.
+func (p *Profiles) Config(name string) (AuthConfig, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if ac, ok := p.configs[name]; ok {
+		return *ac, nil
+	}
+	settings, err := p.settings(name)
+	if err != nil {
+		return AuthConfig{}, err
+	}
+	ac, err := newAuthConfig(AuthConfig{}, settings, false)
+	if err != nil {
+		return ac, fmt.Errorf("%v (profile %s)", err, name)
+	}
+	p.configs[name] = &ac
+	return ac, nil
+}
+
+// Auth returns the Auth of the profile name acting on the account
+// clientCustomerId, or on the client customer ID of the profile when
+// empty.
+func (p *Profiles) Auth(name, clientCustomerId string) (Auth, error) {
+	ac, err := p.Config(name)
+	if err != nil {
+		return Auth{}, err
+	}
+	if clientCustomerId != "" {
+		ac.Auth.CustomerId = clientCustomerId
+	}
+	return ac.Auth, nil
+}
@@ -0,0 +1,71 @@
+package v201809
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testProfiles = `profiles:
+  client:
+    client_id: id
+    client_secret: secret
+  agency:
+    extends: client
+    developer_token: AAAA
+    refresh_token: agency
+    client_customer_id: 111-111-1111
+  brand:
+    extends: agency
+    developer_token: BBBB
+  loop:
+    extends: loop
+`
+
+func TestProfiles(t *testing.T) {
+	clearConfigEnv(t)
+	path := filepath.Join(t.TempDir(), "profiles.yaml")
+	ioutil.WriteFile(path, []byte(testProfiles), 0600)
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := profiles.Names(); !reflect.DeepEqual(names, []string{"agency", "brand", "client", "loop"}) {
+		t.Errorf("unexpected names %v", names)
+	}
+
+	brand, err := profiles.Config("brand")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if brand.Auth.DeveloperToken != "BBBB" || brand.Auth.CustomerId != "111-111-1111" ||
+		brand.OAuth2Config.ClientID != "id" || brand.OAuth2Token.RefreshToken != "agency" {
+		t.Errorf("unexpected config %+v %+v %+v", brand.Auth, brand.OAuth2Config, brand.OAuth2Token)
+	}
+
+	first, _ := profiles.Auth("agency", "")
+	second, err := profiles.Auth("agency", "222-222-2222")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.CustomerId != "111-111-1111" || second.CustomerId != "222-222-2222" || first.Client != second.Client {
+		t.Errorf("want a shared client for both accounts, got %+v and %+v", first, second)
+	}
+
+	for name, want := range map[string]string{"loop": "extends itself", "client": "no developer token", "none": "no profile"} {
+		if _, err := profiles.Config(name); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("profile %s: want %q, got %v", name, want, err)
+		}
+	}
+
+	setenv(t, EnvProfilesFile, path)
+	config, err := LoadConfig(ConfigOptions{Profile: "agency", ClientCustomerId: "333"})
+	if err != nil || config.Auth.DeveloperToken != "AAAA" || config.Auth.CustomerId != "333" {
+		t.Errorf("unexpected config %+v %v", config.Auth, err)
+	}
+	if _, err := LoadConfig(ConfigOptions{Profile: "agency", ConfigFile: "googleads.yaml"}); err == nil {
+		t.Error("want an error for both a profile and a config file")
+	}
+}
@@ -0,0 +1,203 @@
+package v201809
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A Rate is the limit of a token bucket: PerSecond calls on average, with
+// bursts of up to Burst calls.  The zero Rate is unlimited.
+type Rate struct {
+	PerSecond float64
+	Burst     int
+}
+
+// DefaultRateExceededPause is how long a RateLimiter pauses a bucket when a
+// RateExceededError does not say when to retry.
+const DefaultRateExceededPause = 30 * time.Second
+
+// RateLimiter spaces out the SOAP and report calls of the Auths sharing it,
+// with a token bucket per developer token and per CustomerId.  When the API
+// answers with a RateExceededError, the bucket of its scope is paused for
+// RetryAfterSeconds, so that every goroutine sharing the limiter slows down
+// together.  It is safe for concurrent use, and must not be copied once
+// used.
+type RateLimiter struct {
+	// Developer limits the calls made with a developer token.
+	Developer Rate
+	// Customer limits the calls made for a customer.
+	Customer Rate
+	// Services overrides Customer by service name, e.g. "CampaignService"
+	// or "ReportDownloadService".
+	Services map[string]Rate
+	// Pause is used instead of DefaultRateExceededPause when non zero.
+	Pause time.Duration
+
+	mu      sync.Mutex
+	buckets map[rateKey]*bucket
+	now     func() time.Time
+}
+
+type rateKey struct {
+	scope, key string
+}
+
+// bucket is a token bucket.  Tokens go negative as calls are reserved
+// ahead of time, so that waiting callers are spread out rather than woken
+// up together.
+type bucket struct {
+	tokens float64
+	last   time.Time
+	// paused holds calls back until then, after a RateExceededError.
+	paused time.Time
+}
+
+// reserve takes a token from b and returns how long to wait before using
+// it.
+func (b *bucket) reserve(now time.Time, rate Rate) time.Duration {
+	start := now
+	if b.paused.After(start) {
+		start = b.paused
+	}
+	if rate.PerSecond <= 0 {
+		return start.Sub(now)
+	}
+	burst := float64(rate.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	if b.last.IsZero() {
+		b.tokens, b.last = burst, start
+	}
+	if start.After(b.last) {
+		b.tokens += start.Sub(b.last).Seconds() * rate.PerSecond
+		if b.tokens > burst {
+			b.tokens = burst
+		}
+		b.last = start
+	}
+	b.tokens--
+	wait := b.last.Sub(now)
+	if b.tokens < 0 {
+		wait += time.Duration(-b.tokens / rate.PerSecond * float64(time.Second))
+	}
+	return wait
+}
+
+func (l *RateLimiter) bucket(scope, key string) *bucket {
+	if l.buckets == nil {
+		l.buckets = map[rateKey]*bucket{}
+	}
+	b, ok := l.buckets[rateKey{scope, key}]
+	if !ok {
+		b = &bucket{}
+		l.buckets[rateKey{scope, key}] = b
+	}
+	return b
+}
+
+func (l *RateLimiter) time() time.Time {
+	if l.now != nil {
+		return l.now()
+	}
+	return time.Now()
+}
+
+func (l *RateLimiter) customerRate(service string) Rate {
+	if rate, ok := l.Services[service]; ok {
+		return rate
+	}
+	return l.Customer
+}
+
+// reserve takes a token from the developer and customer buckets and
+// returns how long to wait before calling service.
+func (l *RateLimiter) reserve(developerToken, customerId, service string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.time()
+	wait := l.bucket("developer", developerToken).reserve(now, l.Developer)
+	if _, ok := l.Services[service]; ok {
+		// services with their own rate get their own bucket, still
+		// subject to the pauses of the customer
+		if customer := l.bucket("customer", customerId); customer.paused.After(now.Add(wait)) {
+			wait = customer.paused.Sub(now)
+		}
+		customerId += "/" + service
+	}
+	if w := l.bucket("customer", customerId).reserve(now, l.customerRate(service)); w > wait {
+		wait = w
+	}
+	return wait
+}
+
+// Wait blocks until a call to service may be made for customerId with
+// developerToken, or until ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context, developerToken, customerId, service string) error {
+	if wait := l.reserve(developerToken, customerId, service); wait > 0 {
+		return sleepContext(ctx, wait)
+	}
+	return ctx.Err()
+}
+
+// Observe pauses the buckets concerned by the RateExceededErrors in err.
+// Errors of DEVELOPER scope pause the developer token, the others the
+// customer.
+func (l *RateLimiter) Observe(developerToken, customerId string, err error) {
+	if err == nil {
+		return
+	}
+	for _, rate := range rateExceededErrors(err) {
+		pause := time.Duration(rate.RetryAfterSeconds) * time.Second
+		if pause == 0 {
+			pause = l.Pause
+		}
+		if pause == 0 {
+			pause = DefaultRateExceededPause
+		}
+		l.mu.Lock()
+		b := l.bucket("customer", customerId)
+		if rate.RateScope == "DEVELOPER" {
+			b = l.bucket("developer", developerToken)
+		}
+		if until := l.time().Add(pause); until.After(b.paused) {
+			b.paused = until
+		}
+		l.mu.Unlock()
+	}
+}
+
+// rateExceededErrors returns the RateExceededErrors in err, including
+// those of report downloads, which only carry a type.
+func rateExceededErrors(err error) (rates []RateExceededError) {
+	for _, e := range apiErrorsOf(err) {
+		if rate, ok := e.(RateExceededError); ok {
+			rates = append(rates, rate)
+		}
+	}
+	var apiErr ApiError
+	if errors.As(err, &apiErr) && strings.HasPrefix(apiErr.Type, "RateExceededError") {
+		rates = append(rates, RateExceededError{})
+	}
+	return rates
+}
+
+// waitRate waits for the RateLimiter of a, if any, to allow a call to
+// service.
+func (a *Auth) waitRate(ctx context.Context, service string) error {
+	if a.RateLimiter == nil {
+		return nil
+	}
+	return a.RateLimiter.Wait(ctx, a.DeveloperToken, a.CustomerId, service)
+}
+
+// observeRate reports the outcome of a call to the RateLimiter of a, if
+// any.
+func (a *Auth) observeRate(err error) {
+	if a.RateLimiter != nil {
+		a.RateLimiter.Observe(a.DeveloperToken, a.CustomerId, err)
+	}
+}
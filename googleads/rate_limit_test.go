@@ -0,0 +1,74 @@
+package v201809
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterBuckets(t *testing.T) {
+	now := time.Unix(1000, 0)
+	l := &RateLimiter{
+		Developer: Rate{PerSecond: 10, Burst: 2},
+		Customer:  Rate{PerSecond: 1, Burst: 1},
+		Services:  map[string]Rate{"ReportDownloadService": {}},
+		now:       func() time.Time { return now },
+	}
+
+	for i, want := range []time.Duration{0, time.Second, 2 * time.Second} {
+		if wait := l.reserve("token", "1", "CampaignService"); wait != want {
+			t.Errorf("call %d: want wait %s, got %s", i, want, wait)
+		}
+	}
+	if wait := l.reserve("token", "2", "CampaignService"); wait != 200*time.Millisecond {
+		t.Errorf("want the developer bucket to be shared, got %s", wait)
+	}
+	if wait := l.reserve("token", "1", "ReportDownloadService"); wait != 300*time.Millisecond {
+		t.Errorf("want reports limited by the developer bucket only, got %s", wait)
+	}
+
+	now = now.Add(time.Minute)
+	l.Observe("token", "1", &ErrorsType{ApiExceptionFaults: []ApiExceptionFault{{
+		Errors: []interface{}{RateExceededError{RateScope: "ACCOUNT", RetryAfterSeconds: 30}},
+	}}})
+	if wait := l.reserve("token", "1", "ReportDownloadService"); wait != 30*time.Second {
+		t.Errorf("want the customer paused for 30s, got %s", wait)
+	}
+	if wait := l.reserve("token", "2", "CampaignService"); wait != 0 {
+		t.Errorf("want other customers unaffected, got %s", wait)
+	}
+
+	l.Observe("token", "1", &ErrorsType{ApiExceptionFaults: []ApiExceptionFault{{
+		Errors: []interface{}{RateExceededError{RateScope: "DEVELOPER"}},
+	}}})
+	if wait := l.reserve("token", "2", "CampaignService"); wait != DefaultRateExceededPause {
+		t.Errorf("want the developer token paused for %s, got %s", DefaultRateExceededPause, wait)
+	}
+	if wait := l.reserve("other", "3", "CampaignService"); wait != 0 {
+		t.Errorf("want other developer tokens unaffected, got %s", wait)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx, "token", "2", "CampaignService"); !errors.Is(err, context.Canceled) {
+		t.Errorf("want context.Canceled, got %v", err)
+	}
+}
+
+func TestRateLimiterAdaptsToRateExceeded(t *testing.T) {
+	auth, calls := cannedAuth(cannedResponse{500, rateExceededFault})
+	auth.RetryPolicy = NoRetry
+	auth.RateLimiter = &RateLimiter{}
+	budgets := NewBudgetService(auth)
+	budgets.Get(Selector{Fields: []string{"BudgetId"}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, _, err := budgets.GetContext(ctx, Selector{Fields: []string{"BudgetId"}}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want the call held back until the deadline, got %v", err)
+	}
+	if *calls != 1 {
+		t.Errorf("want 1 call sent, got %d", *calls)
+	}
+}
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 )
 
@@ -45,7 +46,14 @@ func NewReportDefinitionService(auth *Auth) *ReportDefinitionService {
 }
 
 func (s *ReportDefinitionService) GetReportFields(report string) (fields []ReportDefinitionField, err error) {
+	return s.GetReportFieldsContext(context.Background(), report)
+}
+
+// GetReportFieldsContext is like GetReportFields but takes a context that controls the
+// request lifetime.
+func (s *ReportDefinitionService) GetReportFieldsContext(ctx context.Context, report string) (fields []ReportDefinitionField, err error) {
 	respBody, err := s.Auth.request(
+		ctx,
 		reportDefinitionServiceUrl,
 		"get",
 		struct {
@@ -2,9 +2,11 @@ package v201809
 
 import (
 	"bytes"
+	"context"
 	"encoding/csv"
 	"encoding/xml"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
@@ -46,6 +48,12 @@ func NewReportDownloadService(auth *Auth) *ReportDownloadService {
 }
 
 func (s *ReportDownloadService) Get(reportDefinition ReportDefinition) (res interface{}, err error) {
+	return s.GetContext(context.Background(), reportDefinition)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *ReportDownloadService) GetContext(ctx context.Context, reportDefinition ReportDefinition) (res interface{}, err error) {
 	reportDefinition.Selector.XMLName = xml.Name{baseUrl, "selector"}
 	repDef := reportDefinitionXml{
 		ReportDefinition: &reportDefinition,
@@ -60,46 +68,36 @@ func (s *ReportDownloadService) Get(reportDefinition ReportDefinition) (res inte
 	}
 	form := url.Values{}
 	form.Add("__rdxml", string(body))
-	resp, err := s.makeRequest(form)
+	report, err := s.download(ctx, form)
 	if err != nil {
-		return res, err
+		return nil, err
 	}
-	defer resp.Body.Close()
+	defer report.Close()
 
-	if resp.StatusCode != 200 {
-		dec := xml.NewDecoder(resp.Body)
-		el := &ReportDownloadError{}
-		if err := dec.Decode(el); err != nil {
-			return nil, err
-		}
-		return nil, el.ApiError
-	}
-	return parseReport(resp.Body)
+	return parseReport(report)
 }
 
 func (s *ReportDownloadService) StreamAWQL(awql string, fmt string) (io.ReadCloser, error) {
+	return s.StreamAWQLContext(context.Background(), awql, fmt)
+}
+
+// StreamAWQLContext is like StreamAWQL but takes a context that controls
+// the request and the lifetime of the returned body.
+func (s *ReportDownloadService) StreamAWQLContext(ctx context.Context, awql string, fmt string) (io.ReadCloser, error) {
 	form := url.Values{}
 	form.Add("__rdquery", awql)
 	form.Add("__fmt", fmt)
-	resp, err := s.makeRequest(form)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != 200 {
-		dec := xml.NewDecoder(resp.Body)
-		el := &ReportDownloadError{}
-		if err := dec.Decode(el); err != nil {
-			return nil, err
-		}
-		return nil, el.ApiError
-	}
-
-	return resp.Body, nil
+	return s.download(ctx, form)
 }
 
 func (s *ReportDownloadService) AWQL(awql string, fmt string) (interface{}, error) {
-	body, err := s.StreamAWQL(awql, fmt)
+	return s.AWQLContext(context.Background(), awql, fmt)
+}
+
+// AWQLContext is like AWQL but takes a context that controls the
+// request lifetime.
+func (s *ReportDownloadService) AWQLContext(ctx context.Context, awql string, fmt string) (interface{}, error) {
+	body, err := s.StreamAWQLContext(ctx, awql, fmt)
 	if err != nil {
 		return nil, err
 	}
@@ -108,9 +106,47 @@ func (s *ReportDownloadService) AWQL(awql string, fmt string) (interface{}, erro
 	return parseReport(body)
 }
 
+// download posts form to the report download endpoint, retrying transient
+// failures according to the Auth's RetryPolicy, and returns the report body
+// of the first successful attempt.
+func (s *ReportDownloadService) download(ctx context.Context, form url.Values) (report io.ReadCloser, err error) {
+	err = s.Auth.retry(ctx, func() error {
+		resp, err := s.makeRequest(ctx, form)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			defer resp.Body.Close()
+			return reportDownloadError(resp)
+		}
+		report = resp.Body
+		return nil
+	})
+	return report, err
+}
+
+func reportDownloadError(resp *http.Response) error {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	el := &ReportDownloadError{}
+	if err := xml.Unmarshal(body, el); err != nil {
+		return newHTTPError(resp.StatusCode, resp.Header, body)
+	}
+	return el.ApiError
+}
+
 // Make our http request using the given form (re-usable for either XML or AWQL)
-func (s *ReportDownloadService) makeRequest(form url.Values) (res *http.Response, err error) {
-	req, err := http.NewRequest("POST", reportDownloadServiceUrl.Url, bytes.NewBufferString(form.Encode()))
+func (s *ReportDownloadService) makeRequest(ctx context.Context, form url.Values) (res *http.Response, err error) {
+	if err := s.Auth.waitRate(ctx, "ReportDownloadService"); err != nil {
+		return res, err
+	}
+	endpoint, err := s.Auth.endpoint(reportDownloadServiceUrl)
+	if err != nil {
+		return res, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBufferString(form.Encode()))
 	if err != nil {
 		return res, err
 	}
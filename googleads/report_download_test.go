@@ -53,7 +53,7 @@ func TestReportDownloadAuthError(t *testing.T) {
 
 func TestReportQueryStream(t *testing.T) {
 	query := `SELECT  AccountDescriptiveName, AdvertisingChannelType, Clicks, ConversionValue, Cost, Impressions, Device, ExternalCustomerId, DayOfWeek, CampaignId  FROM CAMPAIGN_PERFORMANCE_REPORT  DURING YESTERDAY`
-	config := getTestConfig()
+	config := getTestConfig(t)
 	svc := NewReportDownloadService(&config.Auth)
 
 	report, err := svc.StreamAWQL(query, "CSV")
@@ -0,0 +1,60 @@
+package v201809
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResponseMeta is the ResponseHeader the API returns with every call.
+// Google support asks for the RequestId when investigating a call.
+type ResponseMeta struct {
+	RequestId   string `xml:"requestId"`
+	ServiceName string `xml:"serviceName"`
+	MethodName  string `xml:"methodName"`
+	// Operations is the number of operations the call was charged for.
+	Operations int64 `xml:"operations"`
+	// ResponseTime is the time the API took to process the call, in
+	// milliseconds.
+	ResponseTime int64 `xml:"responseTime"`
+}
+
+type responseMetaKey struct{}
+
+// WithResponseMeta returns a context that makes the Context variants of the
+// service calls store the ResponseMeta of their last response in meta:
+//
+//	var meta gads.ResponseMeta
+//	campaigns, err := cs.MutateContext(gads.WithResponseMeta(ctx, &meta), ops)
+//	log.Printf("request %s cost %d operations", meta.RequestId, meta.Operations)
+//
+// The Chunked mutate methods store that of their last chunk, with the
+// operations of all chunks.  Auth.OnResponse receives the ResponseMeta of
+// every call instead.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, meta)
+}
+
+// responseMeta hands meta to the hook of a and the ResponseMeta of ctx.
+func (a *Auth) responseMeta(ctx context.Context, meta ResponseMeta) {
+	if m, ok := ctx.Value(responseMetaKey{}).(*ResponseMeta); ok {
+		*m = meta
+	}
+	if a.OnResponse != nil {
+		a.OnResponse(meta)
+	}
+}
+
+// RequestIdError wraps the errors of calls the API answered with the id of
+// the request.
+type RequestIdError struct {
+	RequestId string
+	Err       error
+}
+
+func (e *RequestIdError) Error() string {
+	return fmt.Sprintf("%v (request id %s)", e.Err, e.RequestId)
+}
+
+func (e *RequestIdError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,72 @@
+package v201809
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func TestResponseMeta(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	gadstest.NewSimulator(srv)
+	auth := simulatorAuth(srv)
+	var hooked []ResponseMeta
+	auth.OnResponse = func(meta ResponseMeta) {
+		hooked = append(hooked, meta)
+	}
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(context.Background(), &meta)
+	_, err := NewBudgetService(auth).MutateContext(ctx, BudgetOperations{
+		"ADD": {{Name: "a", Amount: 1}, {Name: "b", Amount: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.RequestId == "" || meta.ServiceName != "BudgetService" || meta.MethodName != "mutate" || meta.Operations != 2 {
+		t.Errorf("unexpected meta %#v", meta)
+	}
+	if len(hooked) != 1 || hooked[0] != meta {
+		t.Errorf("unexpected hook calls %#v", hooked)
+	}
+	if id := GetStat().ServiceStat["BudgetService"].LastRequestId; id != meta.RequestId {
+		t.Errorf("want request id %s in the stats, got %s", meta.RequestId, id)
+	}
+
+	auth.PartialFailure = false
+	_, err = NewCampaignService(auth).MutateContext(ctx, CampaignOperations{"ADD": {{Name: "c"}}})
+	var reqErr *RequestIdError
+	if !errors.As(err, &reqErr) || reqErr.RequestId != meta.RequestId || meta.ServiceName != "CampaignService" {
+		t.Fatalf("want the error of request %s, got %#v", meta.RequestId, err)
+	}
+	var required RequiredError
+	if !errors.As(err, &required) {
+		t.Errorf("want a RequiredError, got %v", err)
+	}
+}
+
+func TestResponseMetaPartialFailure(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	gadstest.NewSimulator(srv)
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(context.Background(), &meta)
+	_, err := NewAdGroupCriterionService(simulatorAuth(srv)).MutateOperationsContext(ctx, []AdGroupCriterionOperation{
+		{Action: "ADD", AdGroupCriterion: BiddableAdGroupCriterion{AdGroupId: 1, Criterion: KeywordCriterion{Text: "lost", MatchType: "EXACT"}}},
+	})
+	var pf *PartialFailureError
+	if !errors.As(err, &pf) {
+		t.Fatalf("expected a PartialFailureError, got %v", err)
+	}
+	if meta.RequestId == "" || pf.RequestId != meta.RequestId {
+		t.Errorf("want the partial failure of request %s, got %q", meta.RequestId, pf.RequestId)
+	}
+	if !strings.Contains(err.Error(), "(request id "+meta.RequestId+")") {
+		t.Errorf("want the request id in %q", err.Error())
+	}
+}
@@ -0,0 +1,233 @@
+package v201809
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A RetryPolicy decides whether a failed call is tried again and how long
+// to wait before doing so.
+type RetryPolicy interface {
+	// Backoff is called after attempt number attempt (starting at 1) failed
+	// with err, elapsed after the first attempt started.  It returns the
+	// delay before the next attempt, or false to give up and return err.
+	Backoff(attempt int, elapsed time.Duration, err error) (time.Duration, bool)
+}
+
+// ExponentialBackoff is a RetryPolicy that doubles (or multiplies by
+// Multiplier) the delay after every failed attempt, randomized by Jitter.
+// Zero fields take their value from DefaultRetryPolicy.
+type ExponentialBackoff struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	// Jitter is the fraction, between 0 and 1, by which each delay is
+	// randomly shortened or lengthened.
+	Jitter float64
+	// MaxAttempts caps the total number of attempts, including the first.
+	MaxAttempts int
+	// MaxElapsedTime stops retrying once the next attempt would start this
+	// long after the first one.
+	MaxElapsedTime time.Duration
+	// Retryable classifies errors, IsRetryable is used when nil.
+	Retryable func(error) bool
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// DefaultRetryPolicy is used by an Auth without a RetryPolicy.
+var DefaultRetryPolicy = &ExponentialBackoff{
+	InitialInterval: 5 * time.Second,
+	MaxInterval:     time.Minute,
+	Multiplier:      2,
+	Jitter:          0.2,
+	MaxAttempts:     4,
+	MaxElapsedTime:  5 * time.Minute,
+}
+
+// NoRetry is a RetryPolicy that never retries.
+var NoRetry RetryPolicy = noRetry{}
+
+type noRetry struct{}
+
+func (noRetry) Backoff(int, time.Duration, error) (time.Duration, bool) {
+	return 0, false
+}
+
+func (b *ExponentialBackoff) Backoff(attempt int, elapsed time.Duration, err error) (time.Duration, bool) {
+	retryable := b.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+	if !retryable(err) {
+		return 0, false
+	}
+	maxAttempts := b.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if attempt >= maxAttempts {
+		return 0, false
+	}
+
+	initial, max, multiplier := b.InitialInterval, b.MaxInterval, b.Multiplier
+	if initial == 0 {
+		initial = DefaultRetryPolicy.InitialInterval
+	}
+	if max == 0 {
+		max = DefaultRetryPolicy.MaxInterval
+	}
+	if multiplier == 0 {
+		multiplier = DefaultRetryPolicy.Multiplier
+	}
+	delay := float64(initial)
+	for i := 1; i < attempt && delay < float64(max); i++ {
+		delay *= multiplier
+	}
+	if delay > float64(max) {
+		delay = float64(max)
+	}
+	if b.Jitter > 0 {
+		delay += delay * b.Jitter * (2*b.random() - 1)
+	}
+	wait := time.Duration(delay)
+	if after := RetryAfter(err); after > wait {
+		wait = after
+	}
+
+	maxElapsed := b.MaxElapsedTime
+	if maxElapsed == 0 {
+		maxElapsed = DefaultRetryPolicy.MaxElapsedTime
+	}
+	if elapsed+wait > maxElapsed {
+		return 0, false
+	}
+	return wait, true
+}
+
+func (b *ExponentialBackoff) random() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.rand == nil {
+		b.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return b.rand.Float64()
+}
+
+// IsRetryable reports whether err is a transient failure worth retrying:
+// rate limits, internal and database errors returned by the API, HTTP 429
+// and 5xx responses and network errors.  Context cancellation is never
+// retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return isTransientStatus(httpErr.StatusCode)
+	}
+
+	var faults *ErrorsType
+	if errors.As(err, &faults) {
+		for _, fault := range faults.ApiExceptionFaults {
+			for _, e := range fault.Errors {
+				switch e.(type) {
+				case RateExceededError, InternalApiError, DatabaseError:
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	// report downloads describe the error as ErrorType.REASON
+	var apiErr ApiError
+	if errors.As(err, &apiErr) {
+		switch strings.SplitN(apiErr.Type, ".", 2)[0] {
+		case "RateExceededError", "InternalApiError", "DatabaseError":
+			return true
+		}
+		return false
+	}
+
+	// the http client wraps everything in *url.Error, including failed
+	// token refreshes, so only look at what it wraps
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// RetryAfter returns the longest delay the server asked for in err, either
+// through RateExceededError.RetryAfterSeconds or a Retry-After header.
+func RetryAfter(err error) (d time.Duration) {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		d = httpErr.RetryAfter
+	}
+	var faults *ErrorsType
+	if errors.As(err, &faults) {
+		for _, fault := range faults.ApiExceptionFaults {
+			for _, e := range fault.Errors {
+				if rate, ok := e.(RateExceededError); ok {
+					if after := time.Duration(rate.RetryAfterSeconds) * time.Second; after > d {
+						d = after
+					}
+				}
+			}
+		}
+	}
+	return d
+}
+
+func (a *Auth) retryPolicy() RetryPolicy {
+	if a.RetryPolicy != nil {
+		return a.RetryPolicy
+	}
+	return DefaultRetryPolicy
+}
+
+// retry calls fn until it succeeds, the retry policy gives up or ctx is
+// done.  fn must be safe to call more than once.  Failures are reported to
+// the RateLimiter of a.
+func (a *Auth) retry(ctx context.Context, fn func() error) error {
+	policy := a.retryPolicy()
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		a.observeRate(err)
+		wait, ok := policy.Backoff(attempt, time.Since(start), err)
+		if !ok {
+			return err
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// sleepContext pauses for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
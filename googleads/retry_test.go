@@ -0,0 +1,150 @@
+package v201809
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const rateExceededFault = `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
+  <soap:Body>
+    <soap:Fault>
+      <faultcode>soap:Server</faultcode>
+      <faultstring>[RateExceededError &lt;rateName=RATE_LIMIT, rateKey=null, rateScope=ACCOUNT, retryAfterSeconds=30&gt;]</faultstring>
+      <detail>
+        <ApiExceptionFault xmlns="https://adwords.google.com/api/adwords/cm/v201809">
+          <message>[RateExceededError &lt;rateName=RATE_LIMIT, rateKey=null, rateScope=ACCOUNT, retryAfterSeconds=30&gt;]</message>
+          <ApplicationException.Type>ApiException</ApplicationException.Type>
+          <errors xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="RateExceededError">
+            <fieldPath></fieldPath>
+            <trigger></trigger>
+            <errorString>RateExceededError.RATE_EXCEEDED</errorString>
+            <ApiError.Type>RateExceededError</ApiError.Type>
+            <reason>RATE_EXCEEDED</reason>
+            <rateName>RATE_LIMIT</rateName>
+            <rateScope>ACCOUNT</rateScope>
+            <retryAfterSeconds>30</retryAfterSeconds>
+          </errors>
+        </ApiExceptionFault>
+      </detail>
+    </soap:Fault>
+  </soap:Body>
+</soap:Envelope>`
+
+type cannedResponse struct {
+	status int
+	body   string
+}
+
+// cannedAuth returns an Auth whose client answers with responses in turn,
+// repeating the last one, and a pointer to the number of calls made.
+func cannedAuth(responses ...cannedResponse) (*Auth, *int) {
+	calls := 0
+	return &Auth{
+		Client: httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			resp := responses[len(responses)-1]
+			if calls < len(responses) {
+				resp = responses[calls]
+			}
+			calls++
+			return &http.Response{
+				StatusCode: resp.status,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(resp.body)),
+			}, nil
+		}),
+	}, &calls
+}
+
+type recordingPolicy struct {
+	waits []time.Duration
+}
+
+func (p *recordingPolicy) Backoff(attempt int, elapsed time.Duration, err error) (time.Duration, bool) {
+	wait, ok := (&ExponentialBackoff{
+		InitialInterval: time.Millisecond,
+		MaxAttempts:     3,
+	}).Backoff(attempt, elapsed, err)
+	p.waits = append(p.waits, wait)
+	return 0, ok
+}
+
+func TestRetryHonorsRetryAfterSeconds(t *testing.T) {
+	auth, calls := cannedAuth(cannedResponse{500, rateExceededFault})
+	policy := &recordingPolicy{}
+	auth.RetryPolicy = policy
+
+	_, _, err := NewBudgetService(auth).Get(Selector{Fields: []string{"BudgetId"}})
+	var faults *ErrorsType
+	if !errors.As(err, &faults) {
+		t.Fatalf("want *ErrorsType, got %#v", err)
+	}
+	if *calls != 3 {
+		t.Errorf("want 3 attempts, got %d", *calls)
+	}
+	for _, wait := range policy.waits[:2] {
+		if wait != 30*time.Second {
+			t.Errorf("want retry after 30s, got %s", wait)
+		}
+	}
+}
+
+func TestRetryStopsOnPermanentError(t *testing.T) {
+	auth, calls := cannedAuth(cannedResponse{500, strings.Replace(internalApiErrorFault, `xsi:type="InternalApiError"`, `xsi:type="AuthenticationError"`, 1)})
+	auth.RetryPolicy = &ExponentialBackoff{InitialInterval: time.Millisecond}
+
+	if _, _, err := NewBudgetService(auth).Get(Selector{Fields: []string{"BudgetId"}}); err == nil {
+		t.Fatal("want error")
+	}
+	if *calls != 1 {
+		t.Errorf("want 1 attempt, got %d", *calls)
+	}
+}
+
+func TestRetryRecoversFromServiceUnavailable(t *testing.T) {
+	ok := `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><getResponse xmlns="https://adwords.google.com/api/adwords/cm/v201809"><rval><totalNumEntries>1</totalNumEntries><entries><budgetId>7</budgetId></entries></rval></getResponse></soap:Body></soap:Envelope>`
+	auth, calls := cannedAuth(
+		cannedResponse{http.StatusServiceUnavailable, "<html>Service Unavailable</html>"},
+		cannedResponse{http.StatusOK, ok},
+	)
+	auth.RetryPolicy = &ExponentialBackoff{InitialInterval: time.Millisecond}
+
+	budgets, _, err := NewBudgetService(auth).GetContext(context.Background(), Selector{Fields: []string{"BudgetId"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 || len(budgets) != 1 || budgets[0].Id != 7 {
+		t.Errorf("want budget 7 after 2 attempts, got %v after %d", budgets, *calls)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := &ExponentialBackoff{
+		InitialInterval: time.Second,
+		MaxInterval:     5 * time.Second,
+		MaxAttempts:     10,
+		MaxElapsedTime:  time.Minute,
+	}
+	err := &HTTPError{StatusCode: http.StatusBadGateway}
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if got, ok := b.Backoff(attempt+1, 0, err); !ok || got != want {
+			t.Errorf("attempt %d: want %s, got %s %v", attempt+1, want, got, ok)
+		}
+	}
+	if _, ok := b.Backoff(10, 0, err); ok {
+		t.Error("retried past MaxAttempts")
+	}
+	if _, ok := b.Backoff(2, 59*time.Second, err); ok {
+		t.Error("retried past MaxElapsedTime")
+	}
+	if _, ok := b.Backoff(1, 0, &HTTPError{StatusCode: http.StatusBadRequest}); ok {
+		t.Error("retried a 400")
+	}
+	if _, ok := b.Backoff(1, 0, context.Canceled); ok {
+		t.Error("retried a cancelled context")
+	}
+}
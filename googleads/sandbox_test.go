@@ -15,25 +15,29 @@ const (
 	TARGETING_IDEA_LIMIT = 100
 )
 
-func getTestConfig() AuthConfig {
-
-	creds := Credentials{
-		Config: OAuthConfigArgs{
-			ClientID:     os.Getenv("ADWORDS_CLIENT_ID"),
-			ClientSecret: os.Getenv("ADWORDS_CLIENT_SECRET"),
-		},
-		Token: OAuthTokenArgs{
-			AccessToken:  os.Getenv("ADWORDS_ACCESS_TOKEN"),
-			RefreshToken: os.Getenv("ADWORDS_REFRESH_TOKEN"),
-		},
-		Auth: Auth{
-			CustomerId:     os.Getenv("ADWORDS_TEST_ACCOUNT"),
-			DeveloperToken: os.Getenv("ADWORDS_DEVELOPER_TOKEN"),
-			PartialFailure: true,
-		},
+// getTestConfig returns the config of the sandbox account of the
+// environment, skipping the test when it has none.  The default config
+// files are not read, lest the mutating tests run against a production
+// account.
+func getTestConfig(t *testing.T) AuthConfig {
+	if os.Getenv("ADWORDS_TEST_ACCOUNT") == "" || os.Getenv("ADWORDS_DEVELOPER_TOKEN") == "" {
+		t.Skip("ADWORDS_TEST_ACCOUNT and ADWORDS_DEVELOPER_TOKEN are not set")
+	}
+	partialFailure := true
+	authconf, err := LoadConfig(ConfigOptions{
+		NoDefaultFile:    true,
+		ClientCustomerId: os.Getenv("ADWORDS_TEST_ACCOUNT"),
+		PartialFailure:   &partialFailure,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := os.Getenv("ADWORDS_ACCESS_TOKEN"); token != "" && authconf.OAuth2Token != nil {
+		authconf.OAuth2Token.AccessToken = token
+		if err := authconf.SetTokenStore(nil); err != nil {
+			t.Fatal(err)
+		}
 	}
-
-	authconf, _ := NewCredentialsFromParams(creds)
 	return authconf
 }
 
@@ -42,7 +46,7 @@ func createUniqueId() int64 {
 }
 
 func TestConstantDataSvc(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 	svc := NewConstantDataService(&config.Auth)
 	selector := Selector{
 		Fields: []string{
@@ -103,7 +107,7 @@ func TestConstantDataSvc(t *testing.T) {
 }
 
 func TestCampaignQuery(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 	svc := NewAdGroupService(&config.Auth)
 	xs, _, err := svc.Query("select Id, Name, CampaignId, Status, AdGroupType")
 	fmt.Println(xs, err)
@@ -195,7 +199,7 @@ func createBatchOperations(num int) (operations []interface{}) {
 func TestSandboxBatchJobTesting(t *testing.T) {
 	//FIXME: remove dependency on hard coded ids
 	t.Skip()
-	config := getTestConfig()
+	config := getTestConfig(t)
 	srv := NewBatchJobService(&config.Auth)
 
 	// Create batch job
@@ -305,7 +309,7 @@ func TestSandboxBatchJobTesting(t *testing.T) {
 // just stuff like "keyword XXXXXXXX" or "red herring XXXXXXXX"
 // https://groups.google.com/forum/#!msg/adwords-api/PVVYUY421yA/_yZMgEg5PiUJ
 func TestSandboxTargetingIdeaKeywords(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 	srv := NewTargetingIdeaService(&config.Auth)
 
 	selector := TargetingIdeaSelector{
@@ -347,7 +351,7 @@ func TestSandboxTargetingIdeaKeywords(t *testing.T) {
 }
 
 func TestSandboxTargetingIdeaURLs(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 	srv := NewTargetingIdeaService(&config.Auth)
 
 	selector := TargetingIdeaSelector{
@@ -390,7 +394,7 @@ func TestSandboxTargetingIdeaURLs(t *testing.T) {
 }
 
 func TestSandboxTrafficEstimator(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 	estimator := NewTrafficEstimatorService(&config.Auth)
 
 	isEstimateEmpty := func(estimate KeywordEstimate) bool {
@@ -476,7 +480,7 @@ func TestSandboxTrafficEstimator(t *testing.T) {
 }
 
 func TestSandboxCreateSharedSet(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 
 	sets, err := NewSharedSetService(&config.Auth).Mutate([]SharedSetOperation{
 		{Operator: "ADD", Operand: SharedSet{Name: "created-shared-set-1", Type: "NEGATIVE_KEYWORDS"}},
@@ -501,7 +505,7 @@ func TestSandboxCreateSharedSet(t *testing.T) {
 }
 
 func TestOPPBreakout(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 
 	campaigns, _, err := NewCampaignService(&config.Auth).Get(Selector{
 		Fields: []string{"Id", "Name", "CampaignId"},
@@ -637,10 +641,10 @@ func TestOPPBreakout(t *testing.T) {
 	}
 
 	aops := []AdGroupCriterionOperation{
-		{"REMOVE", target},
-		{"ADD", newopp},
-		{"ADD", oppopp},
-		{"ADD", child},
+		{Action: "REMOVE", AdGroupCriterion: target},
+		{Action: "ADD", AdGroupCriterion: newopp},
+		{Action: "ADD", AdGroupCriterion: oppopp},
+		{Action: "ADD", AdGroupCriterion: child},
 	}
 
 	config.Auth.ValidateOnly = true
@@ -698,10 +702,10 @@ func TestOPPBreakout(t *testing.T) {
 		}
 
 		aops := []AdGroupCriterionOperation{
-			{"ADD", root},
-			{"ADD", opp},
-			{"ADD", part1},
-			{"ADD", part},
+			{Action: "ADD", AdGroupCriterion: root},
+			{Action: "ADD", AdGroupCriterion: opp},
+			{Action: "ADD", AdGroupCriterion: part1},
+			{Action: "ADD", AdGroupCriterion: part},
 		}
 	*/
 
@@ -712,7 +716,7 @@ func TestOPPBreakout(t *testing.T) {
 }
 
 func TestBreakOut(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 
 	campaigns, _, err := NewCampaignService(&config.Auth).Get(Selector{
 		Fields: []string{"Id", "Name", "CampaignId"},
@@ -839,10 +843,10 @@ func TestBreakOut(t *testing.T) {
 	}
 
 	aops := []AdGroupCriterionOperation{
-		{"REMOVE", root},
-		{"ADD", newroot},
-		{"ADD", opp},
-		{"ADD", newpart},
+		{Action: "REMOVE", AdGroupCriterion: root},
+		{Action: "ADD", AdGroupCriterion: newroot},
+		{Action: "ADD", AdGroupCriterion: opp},
+		{Action: "ADD", AdGroupCriterion: newpart},
 	}
 
 	res, err := NewAdGroupCriterionService(&config.Auth).MutateOperations(aops)
@@ -854,7 +858,7 @@ func TestBreakOut(t *testing.T) {
 }
 
 func TestSandboxCriteria(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 
 	campaigns, _, err := NewCampaignService(&config.Auth).Get(Selector{
 		Fields: []string{"Id", "Name", "CampaignId"},
@@ -954,7 +958,7 @@ func TestSandboxCriteria(t *testing.T) {
 	if toremove != nil {
 		toremove.BiddingStrategyConfiguration.StrategyType = "NONE"
 		aops := []AdGroupCriterionOperation{
-			{"REMOVE", *toremove},
+			{Action: "REMOVE", AdGroupCriterion: *toremove},
 		}
 
 		res, err := NewAdGroupCriterionService(&config.Auth).MutateOperations(aops)
@@ -973,7 +977,7 @@ func TestSandboxCriteria(t *testing.T) {
 	//toadd.BiddingStrategyConfiguration = nil
 
 	aops := []AdGroupCriterionOperation{
-		{"ADD", toadd},
+		{Action: "ADD", AdGroupCriterion: toadd},
 	}
 
 	res, err := NewAdGroupCriterionService(&config.Auth).MutateOperations(aops)
@@ -984,7 +988,7 @@ func TestSandboxCriteria(t *testing.T) {
 }
 
 func TestSandboxValidateOnly(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 	campaigns, n, err := NewCampaignService(&config.Auth).Get(Selector{
 		Fields: []string{"Id", "Name"},
 	})
@@ -1067,7 +1071,7 @@ func TestSandboxValidateOnly(t *testing.T) {
 }
 
 func TestSandboxCampaignBidModifier(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 
 	campaigns, _, err := NewCampaignService(&config.Auth).Get(Selector{
 		Fields: []string{"Id", "Name"},
@@ -1187,7 +1191,7 @@ func TestSandboxCampaignBidModifier(t *testing.T) {
 }
 
 func TestSandboxSharedEntity(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 
 	campaigns, n, err := NewCampaignService(&config.Auth).Get(Selector{
 		Fields: []string{"Id", "Name"},
@@ -1292,7 +1296,7 @@ func TestRateError(t *testing.T) {
 		t.Skip()
 	}
 
-	config := getTestConfig()
+	config := getTestConfig(t)
 	wg := sync.WaitGroup{}
 
 	for j := 0; j < 40; j++ {
@@ -1327,7 +1331,7 @@ func TestRateError(t *testing.T) {
 }
 
 func TestAddSearchAdGroup(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 
 	campaigns, _, err := NewCampaignService(&config.Auth).Get(Selector{
 		Fields: []string{"Id", "Name"},
@@ -1428,7 +1432,7 @@ func getExistingBudget(budgets []Budget) (Budget, bool) {
 }
 
 func TestCampaignCreate(t *testing.T) {
-	config := getTestConfig()
+	config := getTestConfig(t)
 	svc := NewCampaignService(&config.Auth)
 	campaigns, _, err := svc.Get(Selector{
 		Fields: []string{
@@ -0,0 +1,238 @@
+package v201809
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// A SelectorField is a field of a Selector, e.g. CampaignFieldName.
+type SelectorField string
+
+// A PredicateOperator compares a field with the values of a Predicate.
+type PredicateOperator string
+
+const (
+	OperatorEquals                   PredicateOperator = "EQUALS"
+	OperatorNotEquals                PredicateOperator = "NOT_EQUALS"
+	OperatorIn                       PredicateOperator = "IN"
+	OperatorNotIn                    PredicateOperator = "NOT_IN"
+	OperatorGreaterThan              PredicateOperator = "GREATER_THAN"
+	OperatorGreaterThanEquals        PredicateOperator = "GREATER_THAN_EQUALS"
+	OperatorLessThan                 PredicateOperator = "LESS_THAN"
+	OperatorLessThanEquals           PredicateOperator = "LESS_THAN_EQUALS"
+	OperatorStartsWith               PredicateOperator = "STARTS_WITH"
+	OperatorStartsWithIgnoreCase     PredicateOperator = "STARTS_WITH_IGNORE_CASE"
+	OperatorContains                 PredicateOperator = "CONTAINS"
+	OperatorContainsIgnoreCase       PredicateOperator = "CONTAINS_IGNORE_CASE"
+	OperatorDoesNotContain           PredicateOperator = "DOES_NOT_CONTAIN"
+	OperatorDoesNotContainIgnoreCase PredicateOperator = "DOES_NOT_CONTAIN_IGNORE_CASE"
+	OperatorContainsAny              PredicateOperator = "CONTAINS_ANY"
+	OperatorContainsAll              PredicateOperator = "CONTAINS_ALL"
+	OperatorContainsNone             PredicateOperator = "CONTAINS_NONE"
+)
+
+// multiValued reports whether op takes a list of values rather than a
+// single one.
+func (op PredicateOperator) multiValued() (multi, known bool) {
+	switch op {
+	case OperatorIn, OperatorNotIn, OperatorContainsAny, OperatorContainsAll, OperatorContainsNone:
+		return true, true
+	case OperatorEquals, OperatorNotEquals, OperatorGreaterThan, OperatorGreaterThanEquals,
+		OperatorLessThan, OperatorLessThanEquals, OperatorStartsWith, OperatorStartsWithIgnoreCase,
+		OperatorContains, OperatorContainsIgnoreCase, OperatorDoesNotContain, OperatorDoesNotContainIgnoreCase:
+		return false, true
+	}
+	return false, false
+}
+
+// A SortOrder is the direction of an OrderBy.
+type SortOrder string
+
+const (
+	SortAscending  SortOrder = "ASCENDING"
+	SortDescending SortOrder = "DESCENDING"
+)
+
+type fieldUse uint8
+
+const (
+	selectable fieldUse = 1 << iota
+	filterable
+)
+
+// ServiceFields describe which fields the selectors of a service accept,
+// e.g. CampaignFields.
+type ServiceFields struct {
+	// Service is the name of the service, e.g. "CampaignService".
+	Service string
+	fields  map[SelectorField]fieldUse
+}
+
+// Fields returns the known fields, sorted.
+func (f *ServiceFields) Fields() []SelectorField {
+	fields := make([]SelectorField, 0, len(f.fields))
+	for field := range f.fields {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i] < fields[j] })
+	return fields
+}
+
+// Selectable reports whether field may be requested.  Selectable fields
+// may also be used to order entities.
+func (f *ServiceFields) Selectable(field SelectorField) bool {
+	return f.fields[field]&selectable != 0
+}
+
+// Filterable reports whether field may be used in a predicate.
+func (f *ServiceFields) Filterable(field SelectorField) bool {
+	return f.fields[field]&filterable != 0
+}
+
+// SelectorValidationError lists the problems found in a selector before it
+// was sent.
+type SelectorValidationError struct {
+	Service  string
+	Problems []string
+}
+
+func (e *SelectorValidationError) Error() string {
+	return fmt.Sprintf("invalid %s selector: %s", e.Service, strings.Join(e.Problems, "; "))
+}
+
+// selectorDateFormat is the format of the dates of a DateRange.
+const selectorDateFormat = "20060102"
+
+// Validate checks the fields, predicates, ordering and date range of s
+// against f, and returns a *SelectorValidationError listing the problems
+// found.
+func (f *ServiceFields) Validate(s Selector) error {
+	var problems []string
+	if len(s.Fields) == 0 {
+		problems = append(problems, "no fields selected")
+	}
+	for _, field := range s.Fields {
+		if !f.Selectable(SelectorField(field)) {
+			problems = append(problems, fmt.Sprintf("field %q is not selectable", field))
+		}
+	}
+	for _, p := range s.Predicates {
+		if !f.Filterable(SelectorField(p.Field)) {
+			problems = append(problems, fmt.Sprintf("field %q is not filterable", p.Field))
+		}
+		multi, known := PredicateOperator(p.Operator).multiValued()
+		switch {
+		case !known:
+			problems = append(problems, fmt.Sprintf("unknown operator %q on %q", p.Operator, p.Field))
+		case len(p.Values) == 0:
+			problems = append(problems, fmt.Sprintf("no values for %s on %q", p.Operator, p.Field))
+		case !multi && len(p.Values) > 1:
+			problems = append(problems, fmt.Sprintf("%s on %q takes one value, got %d", p.Operator, p.Field, len(p.Values)))
+		}
+	}
+	for _, o := range s.Ordering {
+		if !f.Selectable(SelectorField(o.Field)) {
+			problems = append(problems, fmt.Sprintf("field %q cannot be ordered on", o.Field))
+		}
+		if o.SortOrder != string(SortAscending) && o.SortOrder != string(SortDescending) {
+			problems = append(problems, fmt.Sprintf("unknown sort order %q on %q", o.SortOrder, o.Field))
+		}
+	}
+	if r := s.DateRange; r != nil {
+		min, minErr := time.Parse(selectorDateFormat, r.Min)
+		max, maxErr := time.Parse(selectorDateFormat, r.Max)
+		switch {
+		case minErr != nil || maxErr != nil:
+			problems = append(problems, fmt.Sprintf("date range %s-%s is not formatted as YYYYMMDD", r.Min, r.Max))
+		case max.Before(min):
+			problems = append(problems, fmt.Sprintf("date range %s-%s ends before it starts", r.Min, r.Max))
+		}
+	}
+	if p := s.Paging; p != nil && (p.Offset < 0 || p.Limit <= 0) {
+		problems = append(problems, fmt.Sprintf("invalid paging %d+%d", p.Offset, p.Limit))
+	}
+	if problems != nil {
+		return &SelectorValidationError{Service: f.Service, Problems: problems}
+	}
+	return nil
+}
+
+// NewDateRange returns the DateRange from min to max, both included.
+func NewDateRange(min, max time.Time) DateRange {
+	return DateRange{Min: min.Format(selectorDateFormat), Max: max.Format(selectorDateFormat)}
+}
+
+// LastDays returns the DateRange of the n days before today, like the
+// LAST_7_DAYS and LAST_30_DAYS ranges of reports.
+func LastDays(n int) DateRange {
+	yesterday := time.Now().AddDate(0, 0, -1)
+	return NewDateRange(yesterday.AddDate(0, 0, 1-n), yesterday)
+}
+
+// A SelectorBuilder builds a Selector checked against the fields of a
+// service:
+//
+//	selector, err := gads.NewSelectorBuilder(gads.CampaignFields).
+//		Select(gads.CampaignFieldId, gads.CampaignFieldName).
+//		Where(gads.CampaignFieldStatus, gads.OperatorIn, "ENABLED", "PAUSED").
+//		OrderBy(gads.CampaignFieldName, gads.SortAscending).
+//		Build()
+type SelectorBuilder struct {
+	fields   *ServiceFields
+	selector Selector
+}
+
+// NewSelectorBuilder returns a builder of selectors for the service of
+// fields.
+func NewSelectorBuilder(fields *ServiceFields) *SelectorBuilder {
+	return &SelectorBuilder{fields: fields}
+}
+
+// Select adds fields to the fields requested.
+func (b *SelectorBuilder) Select(fields ...SelectorField) *SelectorBuilder {
+	for _, f := range fields {
+		b.selector.Fields = append(b.selector.Fields, string(f))
+	}
+	return b
+}
+
+// Where adds a predicate on field.  Values are formatted with fmt.Sprint,
+// so that ids may be given as numbers.
+func (b *SelectorBuilder) Where(field SelectorField, op PredicateOperator, values ...interface{}) *SelectorBuilder {
+	p := Predicate{Field: string(field), Operator: string(op)}
+	for _, v := range values {
+		p.Values = append(p.Values, fmt.Sprint(v))
+	}
+	b.selector.Predicates = append(b.selector.Predicates, p)
+	return b
+}
+
+// During sets the date range of the statistics of the entities.
+func (b *SelectorBuilder) During(r DateRange) *SelectorBuilder {
+	b.selector.DateRange = &r
+	return b
+}
+
+// OrderBy adds field to the ordering of the entities.
+func (b *SelectorBuilder) OrderBy(field SelectorField, order SortOrder) *SelectorBuilder {
+	b.selector.Ordering = append(b.selector.Ordering, OrderBy{Field: string(field), SortOrder: string(order)})
+	return b
+}
+
+// Page sets the paging of the selector.
+func (b *SelectorBuilder) Page(offset, limit int64) *SelectorBuilder {
+	b.selector.Paging = &Paging{Offset: offset, Limit: limit}
+	return b
+}
+
+// Build validates the selector and returns it.  The error is a
+// *SelectorValidationError.
+func (b *SelectorBuilder) Build() (Selector, error) {
+	s := b.selector
+	s.Fields = append([]string(nil), s.Fields...)
+	s.Predicates = append([]Predicate(nil), s.Predicates...)
+	s.Ordering = append([]OrderBy(nil), s.Ordering...)
+	return s, b.fields.Validate(s)
+}
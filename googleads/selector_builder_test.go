@@ -0,0 +1,67 @@
+package v201809
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSelectorBuilder(t *testing.T) {
+	selector, err := NewSelectorBuilder(CampaignFields).
+		Select(CampaignFieldId, CampaignFieldName, CampaignFieldSettings).
+		Where(CampaignFieldId, OperatorIn, 1, int64(2)).
+		Where(CampaignFieldName, OperatorStartsWithIgnoreCase, "brand").
+		During(NewDateRange(time.Date(2018, 9, 1, 0, 0, 0, 0, time.UTC), time.Date(2018, 9, 30, 0, 0, 0, 0, time.UTC))).
+		OrderBy(CampaignFieldName, SortDescending).
+		Page(0, 100).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Selector{
+		Fields: []string{"Id", "Name", "Settings"},
+		Predicates: []Predicate{
+			{"Id", "IN", []string{"1", "2"}},
+			{"Name", "STARTS_WITH_IGNORE_CASE", []string{"brand"}},
+		},
+		DateRange: &DateRange{"20180901", "20180930"},
+		Ordering:  []OrderBy{{"Name", "DESCENDING"}},
+		Paging:    &Paging{0, 100},
+	}
+	if !reflect.DeepEqual(selector, want) {
+		t.Errorf("want %#v, got %#v", want, selector)
+	}
+
+	_, err = NewSelectorBuilder(AdGroupFields).
+		Select(AdGroupFieldId, "Nmae").
+		Where(AdGroupFieldSettings, OperatorEquals, "x").
+		Where(AdGroupFieldStatus, OperatorEquals, "ENABLED", "PAUSED").
+		Where(AdGroupFieldId, "LIKE", 1).
+		Build()
+	var invalid *SelectorValidationError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("want *SelectorValidationError, got %v", err)
+	}
+	want4 := []string{
+		`field "Nmae" is not selectable`,
+		`field "Settings" is not filterable`,
+		`EQUALS on "Status" takes one value, got 2`,
+		`unknown operator "LIKE" on "Id"`,
+	}
+	if !reflect.DeepEqual(invalid.Problems, want4) || invalid.Service != "AdGroupService" {
+		t.Errorf("unexpected problems %q", invalid.Problems)
+	}
+
+	if err := ManagedCustomerFields.Validate(Selector{
+		Fields:     []string{"CustomerId"},
+		Predicates: []Predicate{{"ExcludeHiddenAccounts", "EQUALS", []string{"true"}}},
+		Ordering:   []OrderBy{{"ExcludeHiddenAccounts", "ASCENDING"}},
+	}); err == nil {
+		t.Errorf("want filter-only fields not to be ordered on")
+	}
+
+	if r := LastDays(7); r.Max != time.Now().AddDate(0, 0, -1).Format("20060102") || r.Min != time.Now().AddDate(0, 0, -7).Format("20060102") {
+		t.Errorf("unexpected range %#v", r)
+	}
+}
@@ -0,0 +1,843 @@
+package v201809
+
+// The fields below are those of
+// https://developers.google.com/adwords/api/docs/appendix/selectorfields#v201809
+
+// Fields of CampaignService.
+const (
+	CampaignFieldAdServingOptimizationStatus       SelectorField = "AdServingOptimizationStatus"
+	CampaignFieldAdvertisingChannelSubType         SelectorField = "AdvertisingChannelSubType"
+	CampaignFieldAdvertisingChannelType            SelectorField = "AdvertisingChannelType"
+	CampaignFieldAmount                            SelectorField = "Amount"
+	CampaignFieldAppId                             SelectorField = "AppId"
+	CampaignFieldAppVendor                         SelectorField = "AppVendor"
+	CampaignFieldBaseCampaignId                    SelectorField = "BaseCampaignId"
+	CampaignFieldBiddingStrategyGoalType           SelectorField = "BiddingStrategyGoalType"
+	CampaignFieldBiddingStrategyId                 SelectorField = "BiddingStrategyId"
+	CampaignFieldBiddingStrategyName               SelectorField = "BiddingStrategyName"
+	CampaignFieldBiddingStrategyType               SelectorField = "BiddingStrategyType"
+	CampaignFieldBudgetId                          SelectorField = "BudgetId"
+	CampaignFieldBudgetName                        SelectorField = "BudgetName"
+	CampaignFieldBudgetReferenceCount              SelectorField = "BudgetReferenceCount"
+	CampaignFieldBudgetStatus                      SelectorField = "BudgetStatus"
+	CampaignFieldCampaignGroupId                   SelectorField = "CampaignGroupId"
+	CampaignFieldCampaignTrialType                 SelectorField = "CampaignTrialType"
+	CampaignFieldDeliveryMethod                    SelectorField = "DeliveryMethod"
+	CampaignFieldEligible                          SelectorField = "Eligible"
+	CampaignFieldEndDate                           SelectorField = "EndDate"
+	CampaignFieldEnhancedCpcEnabled                SelectorField = "EnhancedCpcEnabled"
+	CampaignFieldFinalUrlSuffix                    SelectorField = "FinalUrlSuffix"
+	CampaignFieldFrequencyCapMaxImpressions        SelectorField = "FrequencyCapMaxImpressions"
+	CampaignFieldId                                SelectorField = "Id"
+	CampaignFieldIsBudgetExplicitlyShared          SelectorField = "IsBudgetExplicitlyShared"
+	CampaignFieldLabels                            SelectorField = "Labels"
+	CampaignFieldLevel                             SelectorField = "Level"
+	CampaignFieldMaximizeConversionValueTargetRoas SelectorField = "MaximizeConversionValueTargetRoas"
+	CampaignFieldName                              SelectorField = "Name"
+	CampaignFieldRejectionReasons                  SelectorField = "RejectionReasons"
+	CampaignFieldSelectiveOptimization             SelectorField = "SelectiveOptimization"
+	CampaignFieldServingStatus                     SelectorField = "ServingStatus"
+	CampaignFieldSettings                          SelectorField = "Settings"
+	CampaignFieldStartDate                         SelectorField = "StartDate"
+	CampaignFieldStatus                            SelectorField = "Status"
+	CampaignFieldTargetContentNetwork              SelectorField = "TargetContentNetwork"
+	CampaignFieldTargetCpa                         SelectorField = "TargetCpa"
+	CampaignFieldTargetCpaMaxCpcBidCeiling         SelectorField = "TargetCpaMaxCpcBidCeiling"
+	CampaignFieldTargetCpaMaxCpcBidFloor           SelectorField = "TargetCpaMaxCpcBidFloor"
+	CampaignFieldTargetGoogleSearch                SelectorField = "TargetGoogleSearch"
+	CampaignFieldTargetPartnerSearchNetwork        SelectorField = "TargetPartnerSearchNetwork"
+	CampaignFieldTargetRoas                        SelectorField = "TargetRoas"
+	CampaignFieldTargetRoasBidCeiling              SelectorField = "TargetRoasBidCeiling"
+	CampaignFieldTargetRoasBidFloor                SelectorField = "TargetRoasBidFloor"
+	CampaignFieldTargetSearchNetwork               SelectorField = "TargetSearchNetwork"
+	CampaignFieldTargetSpendBidCeiling             SelectorField = "TargetSpendBidCeiling"
+	CampaignFieldTargetSpendSpendTarget            SelectorField = "TargetSpendSpendTarget"
+	CampaignFieldTimeUnit                          SelectorField = "TimeUnit"
+	CampaignFieldTrackingUrlTemplate               SelectorField = "TrackingUrlTemplate"
+	CampaignFieldUrlCustomParameters               SelectorField = "UrlCustomParameters"
+	CampaignFieldVanityPharmaDisplayUrlMode        SelectorField = "VanityPharmaDisplayUrlMode"
+	CampaignFieldVanityPharmaText                  SelectorField = "VanityPharmaText"
+	CampaignFieldViewableCpmEnabled                SelectorField = "ViewableCpmEnabled"
+)
+
+// CampaignFields are the fields of selectors for the get method of CampaignService.
+var CampaignFields = &ServiceFields{
+	Service: "CampaignService",
+	fields: map[SelectorField]fieldUse{
+		CampaignFieldAdServingOptimizationStatus:       selectable | filterable,
+		CampaignFieldAdvertisingChannelSubType:         selectable | filterable,
+		CampaignFieldAdvertisingChannelType:            selectable | filterable,
+		CampaignFieldAmount:                            selectable | filterable,
+		CampaignFieldAppId:                             selectable | filterable,
+		CampaignFieldAppVendor:                         selectable | filterable,
+		CampaignFieldBaseCampaignId:                    selectable | filterable,
+		CampaignFieldBiddingStrategyGoalType:           selectable | filterable,
+		CampaignFieldBiddingStrategyId:                 selectable | filterable,
+		CampaignFieldBiddingStrategyName:               selectable | filterable,
+		CampaignFieldBiddingStrategyType:               selectable | filterable,
+		CampaignFieldBudgetId:                          selectable | filterable,
+		CampaignFieldBudgetName:                        selectable | filterable,
+		CampaignFieldBudgetReferenceCount:              selectable | filterable,
+		CampaignFieldBudgetStatus:                      selectable | filterable,
+		CampaignFieldCampaignGroupId:                   selectable | filterable,
+		CampaignFieldCampaignTrialType:                 selectable | filterable,
+		CampaignFieldDeliveryMethod:                    selectable | filterable,
+		CampaignFieldEligible:                          selectable | filterable,
+		CampaignFieldEndDate:                           selectable | filterable,
+		CampaignFieldEnhancedCpcEnabled:                selectable | filterable,
+		CampaignFieldFinalUrlSuffix:                    selectable | filterable,
+		CampaignFieldFrequencyCapMaxImpressions:        selectable | filterable,
+		CampaignFieldId:                                selectable | filterable,
+		CampaignFieldIsBudgetExplicitlyShared:          selectable | filterable,
+		CampaignFieldLabels:                            selectable | filterable,
+		CampaignFieldLevel:                             selectable | filterable,
+		CampaignFieldMaximizeConversionValueTargetRoas: selectable | filterable,
+		CampaignFieldName:                              selectable | filterable,
+		CampaignFieldRejectionReasons:                  selectable,
+		CampaignFieldSelectiveOptimization:             selectable,
+		CampaignFieldServingStatus:                     selectable | filterable,
+		CampaignFieldSettings:                          selectable,
+		CampaignFieldStartDate:                         selectable | filterable,
+		CampaignFieldStatus:                            selectable | filterable,
+		CampaignFieldTargetContentNetwork:              selectable | filterable,
+		CampaignFieldTargetCpa:                         selectable | filterable,
+		CampaignFieldTargetCpaMaxCpcBidCeiling:         selectable | filterable,
+		CampaignFieldTargetCpaMaxCpcBidFloor:           selectable | filterable,
+		CampaignFieldTargetGoogleSearch:                selectable | filterable,
+		CampaignFieldTargetPartnerSearchNetwork:        selectable | filterable,
+		CampaignFieldTargetRoas:                        selectable | filterable,
+		CampaignFieldTargetRoasBidCeiling:              selectable | filterable,
+		CampaignFieldTargetRoasBidFloor:                selectable | filterable,
+		CampaignFieldTargetSearchNetwork:               selectable | filterable,
+		CampaignFieldTargetSpendBidCeiling:             selectable | filterable,
+		CampaignFieldTargetSpendSpendTarget:            selectable | filterable,
+		CampaignFieldTimeUnit:                          selectable | filterable,
+		CampaignFieldTrackingUrlTemplate:               selectable | filterable,
+		CampaignFieldUrlCustomParameters:               selectable,
+		CampaignFieldVanityPharmaDisplayUrlMode:        selectable | filterable,
+		CampaignFieldVanityPharmaText:                  selectable | filterable,
+		CampaignFieldViewableCpmEnabled:                selectable | filterable,
+	},
+}
+
+// Fields of AdGroupService.
+const (
+	AdGroupFieldAdGroupAdRotationMode        SelectorField = "AdGroupAdRotationMode"
+	AdGroupFieldAdGroupType                  SelectorField = "AdGroupType"
+	AdGroupFieldBaseAdGroupId                SelectorField = "BaseAdGroupId"
+	AdGroupFieldBaseCampaignId               SelectorField = "BaseCampaignId"
+	AdGroupFieldBiddingStrategyId            SelectorField = "BiddingStrategyId"
+	AdGroupFieldBiddingStrategyName          SelectorField = "BiddingStrategyName"
+	AdGroupFieldBiddingStrategySource        SelectorField = "BiddingStrategySource"
+	AdGroupFieldBiddingStrategyType          SelectorField = "BiddingStrategyType"
+	AdGroupFieldCampaignId                   SelectorField = "CampaignId"
+	AdGroupFieldCampaignName                 SelectorField = "CampaignName"
+	AdGroupFieldContentBidCriterionTypeGroup SelectorField = "ContentBidCriterionTypeGroup"
+	AdGroupFieldCpcBid                       SelectorField = "CpcBid"
+	AdGroupFieldCpmBid                       SelectorField = "CpmBid"
+	AdGroupFieldCpvBid                       SelectorField = "CpvBid"
+	AdGroupFieldEnhancedCpcEnabled           SelectorField = "EnhancedCpcEnabled"
+	AdGroupFieldFinalUrlSuffix               SelectorField = "FinalUrlSuffix"
+	AdGroupFieldId                           SelectorField = "Id"
+	AdGroupFieldLabels                       SelectorField = "Labels"
+	AdGroupFieldName                         SelectorField = "Name"
+	AdGroupFieldSettings                     SelectorField = "Settings"
+	AdGroupFieldStatus                       SelectorField = "Status"
+	AdGroupFieldTargetCpa                    SelectorField = "TargetCpa"
+	AdGroupFieldTargetCpaBid                 SelectorField = "TargetCpaBid"
+	AdGroupFieldTargetCpaBidSource           SelectorField = "TargetCpaBidSource"
+	AdGroupFieldTargetRoasOverride           SelectorField = "TargetRoasOverride"
+	AdGroupFieldTrackingUrlTemplate          SelectorField = "TrackingUrlTemplate"
+	AdGroupFieldUrlCustomParameters          SelectorField = "UrlCustomParameters"
+)
+
+// AdGroupFields are the fields of selectors for the get method of AdGroupService.
+var AdGroupFields = &ServiceFields{
+	Service: "AdGroupService",
+	fields: map[SelectorField]fieldUse{
+		AdGroupFieldAdGroupAdRotationMode:        selectable | filterable,
+		AdGroupFieldAdGroupType:                  selectable | filterable,
+		AdGroupFieldBaseAdGroupId:                selectable | filterable,
+		AdGroupFieldBaseCampaignId:               selectable | filterable,
+		AdGroupFieldBiddingStrategyId:            selectable | filterable,
+		AdGroupFieldBiddingStrategyName:          selectable | filterable,
+		AdGroupFieldBiddingStrategySource:        selectable | filterable,
+		AdGroupFieldBiddingStrategyType:          selectable | filterable,
+		AdGroupFieldCampaignId:                   selectable | filterable,
+		AdGroupFieldCampaignName:                 selectable | filterable,
+		AdGroupFieldContentBidCriterionTypeGroup: selectable | filterable,
+		AdGroupFieldCpcBid:                       selectable | filterable,
+		AdGroupFieldCpmBid:                       selectable | filterable,
+		AdGroupFieldCpvBid:                       selectable | filterable,
+		AdGroupFieldEnhancedCpcEnabled:           selectable | filterable,
+		AdGroupFieldFinalUrlSuffix:               selectable | filterable,
+		AdGroupFieldId:                           selectable | filterable,
+		AdGroupFieldLabels:                       selectable | filterable,
+		AdGroupFieldName:                         selectable | filterable,
+		AdGroupFieldSettings:                     selectable,
+		AdGroupFieldStatus:                       selectable | filterable,
+		AdGroupFieldTargetCpa:                    selectable | filterable,
+		AdGroupFieldTargetCpaBid:                 selectable | filterable,
+		AdGroupFieldTargetCpaBidSource:           selectable | filterable,
+		AdGroupFieldTargetRoasOverride:           selectable | filterable,
+		AdGroupFieldTrackingUrlTemplate:          selectable | filterable,
+		AdGroupFieldUrlCustomParameters:          selectable,
+	},
+}
+
+// Fields of AdGroupAdService.
+const (
+	AdGroupAdFieldAdGroupAdDisapprovalReasons               SelectorField = "AdGroupAdDisapprovalReasons"
+	AdGroupAdFieldAdGroupAdTrademarkDisapproved             SelectorField = "AdGroupAdTrademarkDisapproved"
+	AdGroupAdFieldAdGroupCreativeApprovalStatus             SelectorField = "AdGroupCreativeApprovalStatus"
+	AdGroupAdFieldAdGroupId                                 SelectorField = "AdGroupId"
+	AdGroupAdFieldAdStrengthInfo                            SelectorField = "AdStrengthInfo"
+	AdGroupAdFieldAdType                                    SelectorField = "AdType"
+	AdGroupAdFieldAutomatedAd                               SelectorField = "AutomatedAd"
+	AdGroupAdFieldBaseAdGroupId                             SelectorField = "BaseAdGroupId"
+	AdGroupAdFieldBaseCampaignId                            SelectorField = "BaseCampaignId"
+	AdGroupAdFieldBusinessName                              SelectorField = "BusinessName"
+	AdGroupAdFieldCallOnlyAdBusinessName                    SelectorField = "CallOnlyAdBusinessName"
+	AdGroupAdFieldCallOnlyAdCountryCode                     SelectorField = "CallOnlyAdCountryCode"
+	AdGroupAdFieldCallOnlyAdPhoneNumber                     SelectorField = "CallOnlyAdPhoneNumber"
+	AdGroupAdFieldCreativeFinalAppUrls                      SelectorField = "CreativeFinalAppUrls"
+	AdGroupAdFieldCreativeFinalMobileUrls                   SelectorField = "CreativeFinalMobileUrls"
+	AdGroupAdFieldCreativeFinalUrlSuffix                    SelectorField = "CreativeFinalUrlSuffix"
+	AdGroupAdFieldCreativeFinalUrls                         SelectorField = "CreativeFinalUrls"
+	AdGroupAdFieldCreativeTrackingUrlTemplate               SelectorField = "CreativeTrackingUrlTemplate"
+	AdGroupAdFieldCreativeUrlCustomParameters               SelectorField = "CreativeUrlCustomParameters"
+	AdGroupAdFieldDescription                               SelectorField = "Description"
+	AdGroupAdFieldDescription1                              SelectorField = "Description1"
+	AdGroupAdFieldDescription2                              SelectorField = "Description2"
+	AdGroupAdFieldDevicePreference                          SelectorField = "DevicePreference"
+	AdGroupAdFieldDisplayUrl                                SelectorField = "DisplayUrl"
+	AdGroupAdFieldExpandedDynamicSearchCreativeDescription2 SelectorField = "ExpandedDynamicSearchCreativeDescription2"
+	AdGroupAdFieldExpandedTextAdDescription2                SelectorField = "ExpandedTextAdDescription2"
+	AdGroupAdFieldExpandedTextAdHeadlinePart3               SelectorField = "ExpandedTextAdHeadlinePart3"
+	AdGroupAdFieldHeadline                                  SelectorField = "Headline"
+	AdGroupAdFieldHeadlinePart1                             SelectorField = "HeadlinePart1"
+	AdGroupAdFieldHeadlinePart2                             SelectorField = "HeadlinePart2"
+	AdGroupAdFieldId                                        SelectorField = "Id"
+	AdGroupAdFieldImageCreativeName                         SelectorField = "ImageCreativeName"
+	AdGroupAdFieldLabels                                    SelectorField = "Labels"
+	AdGroupAdFieldLongHeadline                              SelectorField = "LongHeadline"
+	AdGroupAdFieldMarketingImage                            SelectorField = "MarketingImage"
+	AdGroupAdFieldPath1                                     SelectorField = "Path1"
+	AdGroupAdFieldPath2                                     SelectorField = "Path2"
+	AdGroupAdFieldPolicySummary                             SelectorField = "PolicySummary"
+	AdGroupAdFieldResponsiveSearchAdDescriptions            SelectorField = "ResponsiveSearchAdDescriptions"
+	AdGroupAdFieldResponsiveSearchAdHeadlines               SelectorField = "ResponsiveSearchAdHeadlines"
+	AdGroupAdFieldResponsiveSearchAdPath1                   SelectorField = "ResponsiveSearchAdPath1"
+	AdGroupAdFieldResponsiveSearchAdPath2                   SelectorField = "ResponsiveSearchAdPath2"
+	AdGroupAdFieldShortHeadline                             SelectorField = "ShortHeadline"
+	AdGroupAdFieldStatus                                    SelectorField = "Status"
+	AdGroupAdFieldSystemManagedEntitySource                 SelectorField = "SystemManagedEntitySource"
+	AdGroupAdFieldUrl                                       SelectorField = "Url"
+	AdGroupAdFieldUrlData                                   SelectorField = "UrlData"
+)
+
+// AdGroupAdFields are the fields of selectors for the get method of AdGroupAdService.
+var AdGroupAdFields = &ServiceFields{
+	Service: "AdGroupAdService",
+	fields: map[SelectorField]fieldUse{
+		AdGroupAdFieldAdGroupAdDisapprovalReasons:               selectable | filterable,
+		AdGroupAdFieldAdGroupAdTrademarkDisapproved:             selectable,
+		AdGroupAdFieldAdGroupCreativeApprovalStatus:             selectable | filterable,
+		AdGroupAdFieldAdGroupId:                                 selectable | filterable,
+		AdGroupAdFieldAdStrengthInfo:                            selectable,
+		AdGroupAdFieldAdType:                                    selectable | filterable,
+		AdGroupAdFieldAutomatedAd:                               selectable | filterable,
+		AdGroupAdFieldBaseAdGroupId:                             selectable | filterable,
+		AdGroupAdFieldBaseCampaignId:                            selectable | filterable,
+		AdGroupAdFieldBusinessName:                              selectable | filterable,
+		AdGroupAdFieldCallOnlyAdBusinessName:                    selectable | filterable,
+		AdGroupAdFieldCallOnlyAdCountryCode:                     selectable | filterable,
+		AdGroupAdFieldCallOnlyAdPhoneNumber:                     selectable | filterable,
+		AdGroupAdFieldCreativeFinalAppUrls:                      selectable | filterable,
+		AdGroupAdFieldCreativeFinalMobileUrls:                   selectable | filterable,
+		AdGroupAdFieldCreativeFinalUrlSuffix:                    selectable | filterable,
+		AdGroupAdFieldCreativeFinalUrls:                         selectable | filterable,
+		AdGroupAdFieldCreativeTrackingUrlTemplate:               selectable | filterable,
+		AdGroupAdFieldCreativeUrlCustomParameters:               selectable,
+		AdGroupAdFieldDescription:                               selectable | filterable,
+		AdGroupAdFieldDescription1:                              selectable | filterable,
+		AdGroupAdFieldDescription2:                              selectable | filterable,
+		AdGroupAdFieldDevicePreference:                          selectable | filterable,
+		AdGroupAdFieldDisplayUrl:                                selectable | filterable,
+		AdGroupAdFieldExpandedDynamicSearchCreativeDescription2: selectable | filterable,
+		AdGroupAdFieldExpandedTextAdDescription2:                selectable | filterable,
+		AdGroupAdFieldExpandedTextAdHeadlinePart3:               selectable | filterable,
+		AdGroupAdFieldHeadline:                                  selectable | filterable,
+		AdGroupAdFieldHeadlinePart1:                             selectable | filterable,
+		AdGroupAdFieldHeadlinePart2:                             selectable | filterable,
+		AdGroupAdFieldId:                                        selectable | filterable,
+		AdGroupAdFieldImageCreativeName:                         selectable | filterable,
+		AdGroupAdFieldLabels:                                    selectable | filterable,
+		AdGroupAdFieldLongHeadline:                              selectable | filterable,
+		AdGroupAdFieldMarketingImage:                            selectable,
+		AdGroupAdFieldPath1:                                     selectable | filterable,
+		AdGroupAdFieldPath2:                                     selectable | filterable,
+		AdGroupAdFieldPolicySummary:                             selectable,
+		AdGroupAdFieldResponsiveSearchAdDescriptions:            selectable,
+		AdGroupAdFieldResponsiveSearchAdHeadlines:               selectable,
+		AdGroupAdFieldResponsiveSearchAdPath1:                   selectable | filterable,
+		AdGroupAdFieldResponsiveSearchAdPath2:                   selectable | filterable,
+		AdGroupAdFieldShortHeadline:                             selectable | filterable,
+		AdGroupAdFieldStatus:                                    selectable | filterable,
+		AdGroupAdFieldSystemManagedEntitySource:                 selectable | filterable,
+		AdGroupAdFieldUrl:                                       selectable | filterable,
+		AdGroupAdFieldUrlData:                                   selectable,
+	},
+}
+
+// Fields of AdGroupCriterionService.
+const (
+	AdGroupCriterionFieldAdGroupId                  SelectorField = "AdGroupId"
+	AdGroupCriterionFieldAgeRangeType               SelectorField = "AgeRangeType"
+	AdGroupCriterionFieldAppPaymentModelType        SelectorField = "AppPaymentModelType"
+	AdGroupCriterionFieldApprovalStatus             SelectorField = "ApprovalStatus"
+	AdGroupCriterionFieldBaseAdGroupId              SelectorField = "BaseAdGroupId"
+	AdGroupCriterionFieldBaseCampaignId             SelectorField = "BaseCampaignId"
+	AdGroupCriterionFieldBidModifier                SelectorField = "BidModifier"
+	AdGroupCriterionFieldBiddingStrategyId          SelectorField = "BiddingStrategyId"
+	AdGroupCriterionFieldBiddingStrategyName        SelectorField = "BiddingStrategyName"
+	AdGroupCriterionFieldBiddingStrategySource      SelectorField = "BiddingStrategySource"
+	AdGroupCriterionFieldBiddingStrategyType        SelectorField = "BiddingStrategyType"
+	AdGroupCriterionFieldCaseValue                  SelectorField = "CaseValue"
+	AdGroupCriterionFieldCpcBid                     SelectorField = "CpcBid"
+	AdGroupCriterionFieldCpcBidSource               SelectorField = "CpcBidSource"
+	AdGroupCriterionFieldCpmBid                     SelectorField = "CpmBid"
+	AdGroupCriterionFieldCpmBidSource               SelectorField = "CpmBidSource"
+	AdGroupCriterionFieldCpvBid                     SelectorField = "CpvBid"
+	AdGroupCriterionFieldCpvBidSource               SelectorField = "CpvBidSource"
+	AdGroupCriterionFieldCriteriaCoverage           SelectorField = "CriteriaCoverage"
+	AdGroupCriterionFieldCriteriaSamples            SelectorField = "CriteriaSamples"
+	AdGroupCriterionFieldCriteriaType               SelectorField = "CriteriaType"
+	AdGroupCriterionFieldCriterionUse               SelectorField = "CriterionUse"
+	AdGroupCriterionFieldCustomAffinityId           SelectorField = "CustomAffinityId"
+	AdGroupCriterionFieldCustomIntentId             SelectorField = "CustomIntentId"
+	AdGroupCriterionFieldDisapprovalReasons         SelectorField = "DisapprovalReasons"
+	AdGroupCriterionFieldDisplayName                SelectorField = "DisplayName"
+	AdGroupCriterionFieldEnhancedCpcEnabled         SelectorField = "EnhancedCpcEnabled"
+	AdGroupCriterionFieldFinalAppUrls               SelectorField = "FinalAppUrls"
+	AdGroupCriterionFieldFinalMobileUrls            SelectorField = "FinalMobileUrls"
+	AdGroupCriterionFieldFinalUrlSuffix             SelectorField = "FinalUrlSuffix"
+	AdGroupCriterionFieldFinalUrls                  SelectorField = "FinalUrls"
+	AdGroupCriterionFieldFirstPageCpc               SelectorField = "FirstPageCpc"
+	AdGroupCriterionFieldFirstPositionCpc           SelectorField = "FirstPositionCpc"
+	AdGroupCriterionFieldGenderType                 SelectorField = "GenderType"
+	AdGroupCriterionFieldHasQualityScore            SelectorField = "HasQualityScore"
+	AdGroupCriterionFieldId                         SelectorField = "Id"
+	AdGroupCriterionFieldIncomeRangeType            SelectorField = "IncomeRangeType"
+	AdGroupCriterionFieldKeywordMatchType           SelectorField = "KeywordMatchType"
+	AdGroupCriterionFieldKeywordText                SelectorField = "KeywordText"
+	AdGroupCriterionFieldLabels                     SelectorField = "Labels"
+	AdGroupCriterionFieldMobileAppCategoryId        SelectorField = "MobileAppCategoryId"
+	AdGroupCriterionFieldParameter                  SelectorField = "Parameter"
+	AdGroupCriterionFieldParentCriterionId          SelectorField = "ParentCriterionId"
+	AdGroupCriterionFieldParentType                 SelectorField = "ParentType"
+	AdGroupCriterionFieldPartitionType              SelectorField = "PartitionType"
+	AdGroupCriterionFieldPath                       SelectorField = "Path"
+	AdGroupCriterionFieldPlacementUrl               SelectorField = "PlacementUrl"
+	AdGroupCriterionFieldQualityScore               SelectorField = "QualityScore"
+	AdGroupCriterionFieldStatus                     SelectorField = "Status"
+	AdGroupCriterionFieldSystemServingStatus        SelectorField = "SystemServingStatus"
+	AdGroupCriterionFieldText                       SelectorField = "Text"
+	AdGroupCriterionFieldTopOfPageCpc               SelectorField = "TopOfPageCpc"
+	AdGroupCriterionFieldTrackingUrlTemplate        SelectorField = "TrackingUrlTemplate"
+	AdGroupCriterionFieldUrlCustomParameters        SelectorField = "UrlCustomParameters"
+	AdGroupCriterionFieldUserInterestId             SelectorField = "UserInterestId"
+	AdGroupCriterionFieldUserInterestName           SelectorField = "UserInterestName"
+	AdGroupCriterionFieldUserListEligibleForDisplay SelectorField = "UserListEligibleForDisplay"
+	AdGroupCriterionFieldUserListEligibleForSearch  SelectorField = "UserListEligibleForSearch"
+	AdGroupCriterionFieldUserListId                 SelectorField = "UserListId"
+	AdGroupCriterionFieldUserListMembershipStatus   SelectorField = "UserListMembershipStatus"
+	AdGroupCriterionFieldUserListName               SelectorField = "UserListName"
+	AdGroupCriterionFieldVerticalId                 SelectorField = "VerticalId"
+	AdGroupCriterionFieldVerticalParentId           SelectorField = "VerticalParentId"
+	AdGroupCriterionFieldYouTubeChannelId           SelectorField = "YouTubeChannelId"
+	AdGroupCriterionFieldYouTubeVideoId             SelectorField = "YouTubeVideoId"
+)
+
+// AdGroupCriterionFields are the fields of selectors for the get method of AdGroupCriterionService.
+var AdGroupCriterionFields = &ServiceFields{
+	Service: "AdGroupCriterionService",
+	fields: map[SelectorField]fieldUse{
+		AdGroupCriterionFieldAdGroupId:                  selectable | filterable,
+		AdGroupCriterionFieldAgeRangeType:               selectable | filterable,
+		AdGroupCriterionFieldAppPaymentModelType:        selectable | filterable,
+		AdGroupCriterionFieldApprovalStatus:             selectable | filterable,
+		AdGroupCriterionFieldBaseAdGroupId:              selectable | filterable,
+		AdGroupCriterionFieldBaseCampaignId:             selectable | filterable,
+		AdGroupCriterionFieldBidModifier:                selectable | filterable,
+		AdGroupCriterionFieldBiddingStrategyId:          selectable | filterable,
+		AdGroupCriterionFieldBiddingStrategyName:        selectable | filterable,
+		AdGroupCriterionFieldBiddingStrategySource:      selectable | filterable,
+		AdGroupCriterionFieldBiddingStrategyType:        selectable | filterable,
+		AdGroupCriterionFieldCaseValue:                  selectable,
+		AdGroupCriterionFieldCpcBid:                     selectable | filterable,
+		AdGroupCriterionFieldCpcBidSource:               selectable | filterable,
+		AdGroupCriterionFieldCpmBid:                     selectable | filterable,
+		AdGroupCriterionFieldCpmBidSource:               selectable | filterable,
+		AdGroupCriterionFieldCpvBid:                     selectable | filterable,
+		AdGroupCriterionFieldCpvBidSource:               selectable | filterable,
+		AdGroupCriterionFieldCriteriaCoverage:           selectable,
+		AdGroupCriterionFieldCriteriaSamples:            selectable,
+		AdGroupCriterionFieldCriteriaType:               selectable | filterable,
+		AdGroupCriterionFieldCriterionUse:               selectable | filterable,
+		AdGroupCriterionFieldCustomAffinityId:           selectable | filterable,
+		AdGroupCriterionFieldCustomIntentId:             selectable | filterable,
+		AdGroupCriterionFieldDisapprovalReasons:         selectable | filterable,
+		AdGroupCriterionFieldDisplayName:                selectable | filterable,
+		AdGroupCriterionFieldEnhancedCpcEnabled:         selectable | filterable,
+		AdGroupCriterionFieldFinalAppUrls:               selectable | filterable,
+		AdGroupCriterionFieldFinalMobileUrls:            selectable | filterable,
+		AdGroupCriterionFieldFinalUrlSuffix:             selectable | filterable,
+		AdGroupCriterionFieldFinalUrls:                  selectable | filterable,
+		AdGroupCriterionFieldFirstPageCpc:               selectable | filterable,
+		AdGroupCriterionFieldFirstPositionCpc:           selectable | filterable,
+		AdGroupCriterionFieldGenderType:                 selectable | filterable,
+		AdGroupCriterionFieldHasQualityScore:            selectable | filterable,
+		AdGroupCriterionFieldId:                         selectable | filterable,
+		AdGroupCriterionFieldIncomeRangeType:            selectable | filterable,
+		AdGroupCriterionFieldKeywordMatchType:           selectable | filterable,
+		AdGroupCriterionFieldKeywordText:                selectable | filterable,
+		AdGroupCriterionFieldLabels:                     selectable | filterable,
+		AdGroupCriterionFieldMobileAppCategoryId:        selectable | filterable,
+		AdGroupCriterionFieldParameter:                  selectable,
+		AdGroupCriterionFieldParentCriterionId:          selectable | filterable,
+		AdGroupCriterionFieldParentType:                 selectable | filterable,
+		AdGroupCriterionFieldPartitionType:              selectable | filterable,
+		AdGroupCriterionFieldPath:                       selectable,
+		AdGroupCriterionFieldPlacementUrl:               selectable | filterable,
+		AdGroupCriterionFieldQualityScore:               selectable | filterable,
+		AdGroupCriterionFieldStatus:                     selectable | filterable,
+		AdGroupCriterionFieldSystemServingStatus:        selectable | filterable,
+		AdGroupCriterionFieldText:                       selectable,
+		AdGroupCriterionFieldTopOfPageCpc:               selectable | filterable,
+		AdGroupCriterionFieldTrackingUrlTemplate:        selectable | filterable,
+		AdGroupCriterionFieldUrlCustomParameters:        selectable,
+		AdGroupCriterionFieldUserInterestId:             selectable | filterable,
+		AdGroupCriterionFieldUserInterestName:           selectable | filterable,
+		AdGroupCriterionFieldUserListEligibleForDisplay: selectable | filterable,
+		AdGroupCriterionFieldUserListEligibleForSearch:  selectable | filterable,
+		AdGroupCriterionFieldUserListId:                 selectable | filterable,
+		AdGroupCriterionFieldUserListMembershipStatus:   selectable | filterable,
+		AdGroupCriterionFieldUserListName:               selectable | filterable,
+		AdGroupCriterionFieldVerticalId:                 selectable | filterable,
+		AdGroupCriterionFieldVerticalParentId:           selectable | filterable,
+		AdGroupCriterionFieldYouTubeChannelId:           selectable | filterable,
+		AdGroupCriterionFieldYouTubeVideoId:             selectable | filterable,
+	},
+}
+
+// Fields of CampaignCriterionService.
+const (
+	CampaignCriterionFieldAddress                    SelectorField = "Address"
+	CampaignCriterionFieldAgeRangeType               SelectorField = "AgeRangeType"
+	CampaignCriterionFieldAppPaymentModelType        SelectorField = "AppPaymentModelType"
+	CampaignCriterionFieldBaseCampaignId             SelectorField = "BaseCampaignId"
+	CampaignCriterionFieldBidModifier                SelectorField = "BidModifier"
+	CampaignCriterionFieldCampaignCriterionStatus    SelectorField = "CampaignCriterionStatus"
+	CampaignCriterionFieldCampaignId                 SelectorField = "CampaignId"
+	CampaignCriterionFieldCarrierCountryCode         SelectorField = "CarrierCountryCode"
+	CampaignCriterionFieldCarrierName                SelectorField = "CarrierName"
+	CampaignCriterionFieldContentLabelType           SelectorField = "ContentLabelType"
+	CampaignCriterionFieldCriteriaType               SelectorField = "CriteriaType"
+	CampaignCriterionFieldCustomAffinityId           SelectorField = "CustomAffinityId"
+	CampaignCriterionFieldCustomIntentId             SelectorField = "CustomIntentId"
+	CampaignCriterionFieldDayOfWeek                  SelectorField = "DayOfWeek"
+	CampaignCriterionFieldDeviceName                 SelectorField = "DeviceName"
+	CampaignCriterionFieldDeviceType                 SelectorField = "DeviceType"
+	CampaignCriterionFieldDisplayName                SelectorField = "DisplayName"
+	CampaignCriterionFieldDisplayType                SelectorField = "DisplayType"
+	CampaignCriterionFieldEndHour                    SelectorField = "EndHour"
+	CampaignCriterionFieldEndMinute                  SelectorField = "EndMinute"
+	CampaignCriterionFieldFeedId                     SelectorField = "FeedId"
+	CampaignCriterionFieldGenderType                 SelectorField = "GenderType"
+	CampaignCriterionFieldGeoPoint                   SelectorField = "GeoPoint"
+	CampaignCriterionFieldId                         SelectorField = "Id"
+	CampaignCriterionFieldIncomeRangeType            SelectorField = "IncomeRangeType"
+	CampaignCriterionFieldIpAddress                  SelectorField = "IpAddress"
+	CampaignCriterionFieldIsNegative                 SelectorField = "IsNegative"
+	CampaignCriterionFieldKeywordMatchType           SelectorField = "KeywordMatchType"
+	CampaignCriterionFieldKeywordText                SelectorField = "KeywordText"
+	CampaignCriterionFieldLanguageCode               SelectorField = "LanguageCode"
+	CampaignCriterionFieldLanguageName               SelectorField = "LanguageName"
+	CampaignCriterionFieldLocationName               SelectorField = "LocationName"
+	CampaignCriterionFieldLocationType               SelectorField = "LocationType"
+	CampaignCriterionFieldManufacturerName           SelectorField = "ManufacturerName"
+	CampaignCriterionFieldMatchingFunction           SelectorField = "MatchingFunction"
+	CampaignCriterionFieldMobileAppCategoryId        SelectorField = "MobileAppCategoryId"
+	CampaignCriterionFieldOperatingSystemName        SelectorField = "OperatingSystemName"
+	CampaignCriterionFieldOperatorType               SelectorField = "OperatorType"
+	CampaignCriterionFieldOsMajorVersion             SelectorField = "OsMajorVersion"
+	CampaignCriterionFieldOsMinorVersion             SelectorField = "OsMinorVersion"
+	CampaignCriterionFieldParameter                  SelectorField = "Parameter"
+	CampaignCriterionFieldParentLocations            SelectorField = "ParentLocations"
+	CampaignCriterionFieldParentType                 SelectorField = "ParentType"
+	CampaignCriterionFieldPath                       SelectorField = "Path"
+	CampaignCriterionFieldPlacementUrl               SelectorField = "PlacementUrl"
+	CampaignCriterionFieldPlatformName               SelectorField = "PlatformName"
+	CampaignCriterionFieldRadiusDistanceUnits        SelectorField = "RadiusDistanceUnits"
+	CampaignCriterionFieldRadiusInUnits              SelectorField = "RadiusInUnits"
+	CampaignCriterionFieldStartHour                  SelectorField = "StartHour"
+	CampaignCriterionFieldStartMinute                SelectorField = "StartMinute"
+	CampaignCriterionFieldTargetingStatus            SelectorField = "TargetingStatus"
+	CampaignCriterionFieldUserInterestId             SelectorField = "UserInterestId"
+	CampaignCriterionFieldUserInterestName           SelectorField = "UserInterestName"
+	CampaignCriterionFieldUserListEligibleForDisplay SelectorField = "UserListEligibleForDisplay"
+	CampaignCriterionFieldUserListEligibleForSearch  SelectorField = "UserListEligibleForSearch"
+	CampaignCriterionFieldUserListId                 SelectorField = "UserListId"
+	CampaignCriterionFieldUserListMembershipStatus   SelectorField = "UserListMembershipStatus"
+	CampaignCriterionFieldUserListName               SelectorField = "UserListName"
+	CampaignCriterionFieldVerticalId                 SelectorField = "VerticalId"
+	CampaignCriterionFieldVerticalParentId           SelectorField = "VerticalParentId"
+	CampaignCriterionFieldYouTubeChannelId           SelectorField = "YouTubeChannelId"
+	CampaignCriterionFieldYouTubeVideoId             SelectorField = "YouTubeVideoId"
+)
+
+// CampaignCriterionFields are the fields of selectors for the get method of CampaignCriterionService.
+var CampaignCriterionFields = &ServiceFields{
+	Service: "CampaignCriterionService",
+	fields: map[SelectorField]fieldUse{
+		CampaignCriterionFieldAddress:                    selectable,
+		CampaignCriterionFieldAgeRangeType:               selectable | filterable,
+		CampaignCriterionFieldAppPaymentModelType:        selectable | filterable,
+		CampaignCriterionFieldBaseCampaignId:             selectable | filterable,
+		CampaignCriterionFieldBidModifier:                selectable | filterable,
+		CampaignCriterionFieldCampaignCriterionStatus:    selectable | filterable,
+		CampaignCriterionFieldCampaignId:                 selectable | filterable,
+		CampaignCriterionFieldCarrierCountryCode:         selectable | filterable,
+		CampaignCriterionFieldCarrierName:                selectable | filterable,
+		CampaignCriterionFieldContentLabelType:           selectable | filterable,
+		CampaignCriterionFieldCriteriaType:               selectable | filterable,
+		CampaignCriterionFieldCustomAffinityId:           selectable | filterable,
+		CampaignCriterionFieldCustomIntentId:             selectable | filterable,
+		CampaignCriterionFieldDayOfWeek:                  selectable | filterable,
+		CampaignCriterionFieldDeviceName:                 selectable | filterable,
+		CampaignCriterionFieldDeviceType:                 selectable | filterable,
+		CampaignCriterionFieldDisplayName:                selectable | filterable,
+		CampaignCriterionFieldDisplayType:                selectable | filterable,
+		CampaignCriterionFieldEndHour:                    selectable | filterable,
+		CampaignCriterionFieldEndMinute:                  selectable | filterable,
+		CampaignCriterionFieldFeedId:                     selectable | filterable,
+		CampaignCriterionFieldGenderType:                 selectable | filterable,
+		CampaignCriterionFieldGeoPoint:                   selectable,
+		CampaignCriterionFieldId:                         selectable | filterable,
+		CampaignCriterionFieldIncomeRangeType:            selectable | filterable,
+		CampaignCriterionFieldIpAddress:                  selectable | filterable,
+		CampaignCriterionFieldIsNegative:                 selectable | filterable,
+		CampaignCriterionFieldKeywordMatchType:           selectable | filterable,
+		CampaignCriterionFieldKeywordText:                selectable | filterable,
+		CampaignCriterionFieldLanguageCode:               selectable | filterable,
+		CampaignCriterionFieldLanguageName:               selectable | filterable,
+		CampaignCriterionFieldLocationName:               selectable | filterable,
+		CampaignCriterionFieldLocationType:               selectable | filterable,
+		CampaignCriterionFieldManufacturerName:           selectable | filterable,
+		CampaignCriterionFieldMatchingFunction:           selectable,
+		CampaignCriterionFieldMobileAppCategoryId:        selectable | filterable,
+		CampaignCriterionFieldOperatingSystemName:        selectable | filterable,
+		CampaignCriterionFieldOperatorType:               selectable | filterable,
+		CampaignCriterionFieldOsMajorVersion:             selectable | filterable,
+		CampaignCriterionFieldOsMinorVersion:             selectable | filterable,
+		CampaignCriterionFieldParameter:                  selectable,
+		CampaignCriterionFieldParentLocations:            selectable,
+		CampaignCriterionFieldParentType:                 selectable | filterable,
+		CampaignCriterionFieldPath:                       selectable,
+		CampaignCriterionFieldPlacementUrl:               selectable | filterable,
+		CampaignCriterionFieldPlatformName:               selectable | filterable,
+		CampaignCriterionFieldRadiusDistanceUnits:        selectable | filterable,
+		CampaignCriterionFieldRadiusInUnits:              selectable | filterable,
+		CampaignCriterionFieldStartHour:                  selectable | filterable,
+		CampaignCriterionFieldStartMinute:                selectable | filterable,
+		CampaignCriterionFieldTargetingStatus:            selectable | filterable,
+		CampaignCriterionFieldUserInterestId:             selectable | filterable,
+		CampaignCriterionFieldUserInterestName:           selectable | filterable,
+		CampaignCriterionFieldUserListEligibleForDisplay: selectable | filterable,
+		CampaignCriterionFieldUserListEligibleForSearch:  selectable | filterable,
+		CampaignCriterionFieldUserListId:                 selectable | filterable,
+		CampaignCriterionFieldUserListMembershipStatus:   selectable | filterable,
+		CampaignCriterionFieldUserListName:               selectable | filterable,
+		CampaignCriterionFieldVerticalId:                 selectable | filterable,
+		CampaignCriterionFieldVerticalParentId:           selectable | filterable,
+		CampaignCriterionFieldYouTubeChannelId:           selectable | filterable,
+		CampaignCriterionFieldYouTubeVideoId:             selectable | filterable,
+	},
+}
+
+// Fields of BudgetService.
+const (
+	BudgetFieldAmount                   SelectorField = "Amount"
+	BudgetFieldBudgetId                 SelectorField = "BudgetId"
+	BudgetFieldBudgetName               SelectorField = "BudgetName"
+	BudgetFieldBudgetReferenceCount     SelectorField = "BudgetReferenceCount"
+	BudgetFieldBudgetStatus             SelectorField = "BudgetStatus"
+	BudgetFieldDeliveryMethod           SelectorField = "DeliveryMethod"
+	BudgetFieldIsBudgetExplicitlyShared SelectorField = "IsBudgetExplicitlyShared"
+	BudgetFieldPeriod                   SelectorField = "Period"
+)
+
+// BudgetFields are the fields of selectors for the get method of BudgetService.
+var BudgetFields = &ServiceFields{
+	Service: "BudgetService",
+	fields: map[SelectorField]fieldUse{
+		BudgetFieldAmount:                   selectable | filterable,
+		BudgetFieldBudgetId:                 selectable | filterable,
+		BudgetFieldBudgetName:               selectable | filterable,
+		BudgetFieldBudgetReferenceCount:     selectable | filterable,
+		BudgetFieldBudgetStatus:             selectable | filterable,
+		BudgetFieldDeliveryMethod:           selectable | filterable,
+		BudgetFieldIsBudgetExplicitlyShared: selectable | filterable,
+		BudgetFieldPeriod:                   selectable | filterable,
+	},
+}
+
+// Fields of LabelService.
+const (
+	LabelFieldLabelAttribute SelectorField = "LabelAttribute"
+	LabelFieldLabelId        SelectorField = "LabelId"
+	LabelFieldLabelName      SelectorField = "LabelName"
+	LabelFieldLabelStatus    SelectorField = "LabelStatus"
+)
+
+// LabelFields are the fields of selectors for the get method of LabelService.
+var LabelFields = &ServiceFields{
+	Service: "LabelService",
+	fields: map[SelectorField]fieldUse{
+		LabelFieldLabelAttribute: selectable,
+		LabelFieldLabelId:        selectable | filterable,
+		LabelFieldLabelName:      selectable | filterable,
+		LabelFieldLabelStatus:    selectable | filterable,
+	},
+}
+
+// Fields of MediaService.
+const (
+	MediaFieldAdvertisingId                        SelectorField = "AdvertisingId"
+	MediaFieldCreationTime                         SelectorField = "CreationTime"
+	MediaFieldDimensions                           SelectorField = "Dimensions"
+	MediaFieldDurationMillis                       SelectorField = "DurationMillis"
+	MediaFieldFileSize                             SelectorField = "FileSize"
+	MediaFieldIndustryStandardCommercialIdentifier SelectorField = "IndustryStandardCommercialIdentifier"
+	MediaFieldMediaId                              SelectorField = "MediaId"
+	MediaFieldMimeType                             SelectorField = "MimeType"
+	MediaFieldName                                 SelectorField = "Name"
+	MediaFieldReadyToPlayOnTheWeb                  SelectorField = "ReadyToPlayOnTheWeb"
+	MediaFieldReferenceId                          SelectorField = "ReferenceId"
+	MediaFieldSourceUrl                            SelectorField = "SourceUrl"
+	MediaFieldStreamingUrl                         SelectorField = "StreamingUrl"
+	MediaFieldType                                 SelectorField = "Type"
+	MediaFieldUrls                                 SelectorField = "Urls"
+	MediaFieldYouTubeVideoIdString                 SelectorField = "YouTubeVideoIdString"
+)
+
+// MediaFields are the fields of selectors for the get method of MediaService.
+var MediaFields = &ServiceFields{
+	Service: "MediaService",
+	fields: map[SelectorField]fieldUse{
+		MediaFieldAdvertisingId:                        selectable | filterable,
+		MediaFieldCreationTime:                         selectable | filterable,
+		MediaFieldDimensions:                           selectable,
+		MediaFieldDurationMillis:                       selectable | filterable,
+		MediaFieldFileSize:                             selectable | filterable,
+		MediaFieldIndustryStandardCommercialIdentifier: selectable | filterable,
+		MediaFieldMediaId:                              selectable | filterable,
+		MediaFieldMimeType:                             selectable | filterable,
+		MediaFieldName:                                 selectable | filterable,
+		MediaFieldReadyToPlayOnTheWeb:                  selectable | filterable,
+		MediaFieldReferenceId:                          selectable | filterable,
+		MediaFieldSourceUrl:                            selectable | filterable,
+		MediaFieldStreamingUrl:                         selectable | filterable,
+		MediaFieldType:                                 selectable | filterable,
+		MediaFieldUrls:                                 selectable,
+		MediaFieldYouTubeVideoIdString:                 selectable | filterable,
+	},
+}
+
+// Fields of SharedSetService.
+const (
+	SharedSetFieldMemberCount    SelectorField = "MemberCount"
+	SharedSetFieldName           SelectorField = "Name"
+	SharedSetFieldReferenceCount SelectorField = "ReferenceCount"
+	SharedSetFieldSharedSetId    SelectorField = "SharedSetId"
+	SharedSetFieldStatus         SelectorField = "Status"
+	SharedSetFieldType           SelectorField = "Type"
+)
+
+// SharedSetFields are the fields of selectors for the get method of SharedSetService.
+var SharedSetFields = &ServiceFields{
+	Service: "SharedSetService",
+	fields: map[SelectorField]fieldUse{
+		SharedSetFieldMemberCount:    selectable | filterable,
+		SharedSetFieldName:           selectable | filterable,
+		SharedSetFieldReferenceCount: selectable | filterable,
+		SharedSetFieldSharedSetId:    selectable | filterable,
+		SharedSetFieldStatus:         selectable | filterable,
+		SharedSetFieldType:           selectable | filterable,
+	},
+}
+
+// Fields of SharedCriterionService.
+const (
+	SharedCriterionFieldCriteriaType        SelectorField = "CriteriaType"
+	SharedCriterionFieldDisplayName         SelectorField = "DisplayName"
+	SharedCriterionFieldId                  SelectorField = "Id"
+	SharedCriterionFieldKeywordMatchType    SelectorField = "KeywordMatchType"
+	SharedCriterionFieldKeywordText         SelectorField = "KeywordText"
+	SharedCriterionFieldMobileAppCategoryId SelectorField = "MobileAppCategoryId"
+	SharedCriterionFieldNegative            SelectorField = "Negative"
+	SharedCriterionFieldPlacementUrl        SelectorField = "PlacementUrl"
+	SharedCriterionFieldSharedSetId         SelectorField = "SharedSetId"
+	SharedCriterionFieldYouTubeChannelId    SelectorField = "YouTubeChannelId"
+	SharedCriterionFieldYouTubeVideoId      SelectorField = "YouTubeVideoId"
+)
+
+// SharedCriterionFields are the fields of selectors for the get method of SharedCriterionService.
+var SharedCriterionFields = &ServiceFields{
+	Service: "SharedCriterionService",
+	fields: map[SelectorField]fieldUse{
+		SharedCriterionFieldCriteriaType:        selectable | filterable,
+		SharedCriterionFieldDisplayName:         selectable | filterable,
+		SharedCriterionFieldId:                  selectable | filterable,
+		SharedCriterionFieldKeywordMatchType:    selectable | filterable,
+		SharedCriterionFieldKeywordText:         selectable | filterable,
+		SharedCriterionFieldMobileAppCategoryId: selectable | filterable,
+		SharedCriterionFieldNegative:            selectable | filterable,
+		SharedCriterionFieldPlacementUrl:        selectable | filterable,
+		SharedCriterionFieldSharedSetId:         selectable | filterable,
+		SharedCriterionFieldYouTubeChannelId:    selectable | filterable,
+		SharedCriterionFieldYouTubeVideoId:      selectable | filterable,
+	},
+}
+
+// Fields of ManagedCustomerService.
+const (
+	ManagedCustomerFieldAccountLabels         SelectorField = "AccountLabels"
+	ManagedCustomerFieldCanManageClients      SelectorField = "CanManageClients"
+	ManagedCustomerFieldCurrencyCode          SelectorField = "CurrencyCode"
+	ManagedCustomerFieldCustomerId            SelectorField = "CustomerId"
+	ManagedCustomerFieldDateTimeZone          SelectorField = "DateTimeZone"
+	ManagedCustomerFieldExcludeHiddenAccounts SelectorField = "ExcludeHiddenAccounts"
+	ManagedCustomerFieldName                  SelectorField = "Name"
+	ManagedCustomerFieldTestAccount           SelectorField = "TestAccount"
+)
+
+// ManagedCustomerFields are the fields of selectors for the get method of ManagedCustomerService.
+var ManagedCustomerFields = &ServiceFields{
+	Service: "ManagedCustomerService",
+	fields: map[SelectorField]fieldUse{
+		ManagedCustomerFieldAccountLabels:         selectable,
+		ManagedCustomerFieldCanManageClients:      selectable | filterable,
+		ManagedCustomerFieldCurrencyCode:          selectable | filterable,
+		ManagedCustomerFieldCustomerId:            selectable | filterable,
+		ManagedCustomerFieldDateTimeZone:          selectable | filterable,
+		ManagedCustomerFieldExcludeHiddenAccounts: filterable,
+		ManagedCustomerFieldName:                  selectable | filterable,
+		ManagedCustomerFieldTestAccount:           selectable | filterable,
+	},
+}
+
+// Fields of the bid landscapes of DataService.
+const (
+	LandscapeFieldAdGroupId                     SelectorField = "AdGroupId"
+	LandscapeFieldBid                           SelectorField = "Bid"
+	LandscapeFieldBiddableConversions           SelectorField = "BiddableConversions"
+	LandscapeFieldBiddableConversionsValue      SelectorField = "BiddableConversionsValue"
+	LandscapeFieldCampaignId                    SelectorField = "CampaignId"
+	LandscapeFieldEndDate                       SelectorField = "EndDate"
+	LandscapeFieldLandscapeCurrent              SelectorField = "LandscapeCurrent"
+	LandscapeFieldLandscapeType                 SelectorField = "LandscapeType"
+	LandscapeFieldLocalClicks                   SelectorField = "LocalClicks"
+	LandscapeFieldLocalCost                     SelectorField = "LocalCost"
+	LandscapeFieldLocalImpressions              SelectorField = "LocalImpressions"
+	LandscapeFieldPromotedImpressions           SelectorField = "PromotedImpressions"
+	LandscapeFieldRequiredBudget                SelectorField = "RequiredBudget"
+	LandscapeFieldStartDate                     SelectorField = "StartDate"
+	LandscapeFieldTotalLocalClicks              SelectorField = "TotalLocalClicks"
+	LandscapeFieldTotalLocalCost                SelectorField = "TotalLocalCost"
+	LandscapeFieldTotalLocalImpressions         SelectorField = "TotalLocalImpressions"
+	LandscapeFieldTotalLocalPromotedImpressions SelectorField = "TotalLocalPromotedImpressions"
+)
+
+// AdGroupBidLandscapeFields are the fields of selectors for the getAdGroupBidLandscape method of DataService.
+var AdGroupBidLandscapeFields = &ServiceFields{
+	Service: "DataService",
+	fields: map[SelectorField]fieldUse{
+		LandscapeFieldAdGroupId:                     selectable | filterable,
+		LandscapeFieldBid:                           selectable | filterable,
+		LandscapeFieldBiddableConversions:           selectable | filterable,
+		LandscapeFieldBiddableConversionsValue:      selectable | filterable,
+		LandscapeFieldCampaignId:                    selectable | filterable,
+		LandscapeFieldEndDate:                       selectable,
+		LandscapeFieldLandscapeCurrent:              selectable | filterable,
+		LandscapeFieldLandscapeType:                 selectable | filterable,
+		LandscapeFieldLocalClicks:                   selectable | filterable,
+		LandscapeFieldLocalCost:                     selectable | filterable,
+		LandscapeFieldLocalImpressions:              selectable | filterable,
+		LandscapeFieldPromotedImpressions:           selectable | filterable,
+		LandscapeFieldRequiredBudget:                selectable | filterable,
+		LandscapeFieldStartDate:                     selectable,
+		LandscapeFieldTotalLocalClicks:              selectable | filterable,
+		LandscapeFieldTotalLocalCost:                selectable | filterable,
+		LandscapeFieldTotalLocalImpressions:         selectable | filterable,
+		LandscapeFieldTotalLocalPromotedImpressions: selectable | filterable,
+	},
+}
+
+// Fields of the bid landscapes of DataService.
+const (
+	LandscapeFieldCriterionId SelectorField = "CriterionId"
+)
+
+// CriterionBidLandscapeFields are the fields of selectors for the getCriterionBidLandscape method of DataService.
+var CriterionBidLandscapeFields = &ServiceFields{
+	Service: "DataService",
+	fields: map[SelectorField]fieldUse{
+		LandscapeFieldAdGroupId:                     selectable | filterable,
+		LandscapeFieldBid:                           selectable | filterable,
+		LandscapeFieldBiddableConversions:           selectable | filterable,
+		LandscapeFieldBiddableConversionsValue:      selectable | filterable,
+		LandscapeFieldCampaignId:                    selectable | filterable,
+		LandscapeFieldCriterionId:                   selectable | filterable,
+		LandscapeFieldEndDate:                       selectable,
+		LandscapeFieldLocalClicks:                   selectable | filterable,
+		LandscapeFieldLocalCost:                     selectable | filterable,
+		LandscapeFieldLocalImpressions:              selectable | filterable,
+		LandscapeFieldPromotedImpressions:           selectable | filterable,
+		LandscapeFieldRequiredBudget:                selectable | filterable,
+		LandscapeFieldStartDate:                     selectable,
+		LandscapeFieldTotalLocalClicks:              selectable | filterable,
+		LandscapeFieldTotalLocalCost:                selectable | filterable,
+		LandscapeFieldTotalLocalImpressions:         selectable | filterable,
+		LandscapeFieldTotalLocalPromotedImpressions: selectable | filterable,
+	},
+}
+
+// Fields of the bid landscapes of DataService.
+const (
+	LandscapeFieldBidModifier SelectorField = "BidModifier"
+)
+
+// CampaignCriterionBidLandscapeFields are the fields of selectors for the getCampaignCriterionBidLandscape method of DataService.
+var CampaignCriterionBidLandscapeFields = &ServiceFields{
+	Service: "DataService",
+	fields: map[SelectorField]fieldUse{
+		LandscapeFieldBidModifier:                   selectable | filterable,
+		LandscapeFieldBiddableConversions:           selectable | filterable,
+		LandscapeFieldBiddableConversionsValue:      selectable | filterable,
+		LandscapeFieldCampaignId:                    selectable | filterable,
+		LandscapeFieldCriterionId:                   selectable | filterable,
+		LandscapeFieldEndDate:                       selectable,
+		LandscapeFieldLocalClicks:                   selectable | filterable,
+		LandscapeFieldLocalCost:                     selectable | filterable,
+		LandscapeFieldLocalImpressions:              selectable | filterable,
+		LandscapeFieldPromotedImpressions:           selectable | filterable,
+		LandscapeFieldRequiredBudget:                selectable | filterable,
+		LandscapeFieldStartDate:                     selectable,
+		LandscapeFieldTotalLocalClicks:              selectable | filterable,
+		LandscapeFieldTotalLocalCost:                selectable | filterable,
+		LandscapeFieldTotalLocalImpressions:         selectable | filterable,
+		LandscapeFieldTotalLocalPromotedImpressions: selectable | filterable,
+	},
+}
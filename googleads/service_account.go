@@ -0,0 +1,51 @@
+package v201809
+
+import (
+	"context"
+	"io/ioutil"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/jwt"
+)
+
+// adwordsScope is the OAuth2 scope of the AdWords API.
+const adwordsScope = "https://adwords.google.com/api/adwords"
+
+// NewCredentialsFromServiceAccount returns an AuthConfig authenticating
+// with the JSON key of a service account, as created in the Google API
+// Console.  When subject is not empty the service account impersonates that
+// user, which requires domain-wide delegation of the AdWords scope.
+//
+// auth holds the rest of the settings, e.g. CustomerId and DeveloperToken,
+// its Client is replaced.  Tokens are requested from the token_uri of the
+// key and kept in memory, see SetTokenStore to persist them.
+func NewCredentialsFromServiceAccount(key []byte, subject string, auth Auth) (ac AuthConfig, err error) {
+	config, err := google.JWTConfigFromJSON(key, adwordsScope)
+	if err != nil {
+		return ac, err
+	}
+	config.Subject = subject
+	ac.jwtConfig = config
+	ac.Auth = auth
+	return ac, ac.SetTokenStore(nil)
+}
+
+// NewCredentialsFromServiceAccountFile is like
+// NewCredentialsFromServiceAccount but reads the key from the file
+// pathToKey.
+func NewCredentialsFromServiceAccountFile(pathToKey, subject string, auth Auth) (ac AuthConfig, err error) {
+	key, err := ioutil.ReadFile(pathToKey)
+	if err != nil {
+		return ac, err
+	}
+	return NewCredentialsFromServiceAccount(key, subject, auth)
+}
+
+// jwtRefresh requests a new token with a JWT assertion signed by the key of
+// config, service account tokens having no refresh token.
+func jwtRefresh(config *jwt.Config) refreshFunc {
+	return func(ctx context.Context, _ *oauth2.Token) (*oauth2.Token, error) {
+		return config.TokenSource(ctx).Token()
+	}
+}
@@ -0,0 +1,84 @@
+package v201809
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewCredentialsFromServiceAccount(t *testing.T) {
+	tokens := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if grant := r.FormValue("grant_type"); grant != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+			t.Errorf("unexpected grant_type %q", grant)
+		}
+		parts := strings.Split(r.FormValue("assertion"), ".")
+		if len(parts) != 3 {
+			t.Fatalf("unexpected assertion %q", r.FormValue("assertion"))
+		}
+		var claims struct {
+			Iss, Sub, Scope string
+		}
+		payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+		json.Unmarshal(payload, &claims)
+		if claims.Iss != "robot@example.iam.gserviceaccount.com" || claims.Sub != "user@example.com" || claims.Scope != adwordsScope {
+			t.Errorf("unexpected claims %+v", claims)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"delegated","token_type":"Bearer","expires_in":3600}`)
+	}))
+	defer tokens.Close()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKCS8PrivateKey(rsaKey)
+	key, _ := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "robot@example.iam.gserviceaccount.com",
+		"private_key_id": "1",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":      tokens.URL,
+	})
+
+	config, err := NewCredentialsFromServiceAccount(key, "user@example.com", Auth{CustomerId: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer delegated" {
+			t.Errorf("unexpected Authorization %q", got)
+		}
+	}))
+	defer api.Close()
+	req, _ := http.NewRequest("GET", api.URL, nil)
+	resp, err := config.Auth.Client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if config.Auth.CustomerId != "1" {
+		t.Errorf("unexpected Auth %+v", config.Auth)
+	}
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := config.SaveAs(path); err != ErrServiceAccountConfig {
+		t.Errorf("want ErrServiceAccountConfig, got %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("want no file written, got %v", err)
+	}
+	if err := config.Save(); err != ErrServiceAccountConfig {
+		t.Errorf("want ErrServiceAccountConfig, got %v", err)
+	}
+}
@@ -0,0 +1,345 @@
+package v201809
+
+import (
+	"context"
+	"io"
+)
+
+// The Servicer interfaces hold the method sets of the services, so that
+// code using a service can be tested with a fake, such as those of package
+// gadsmock:
+//
+//	type budgetSync struct {
+//		budgets gads.BudgetServicer
+//	}
+//
+//	sync := budgetSync{budgets: gads.NewBudgetService(&auth)}
+//
+// The Pages methods are left out, as their pagers are bound to a service.
+// gadsmock is generated from this file: run go generate in it after
+// changing an interface.
+
+// AdGroupAdServicer is the method set of AdGroupAdService.
+type AdGroupAdServicer interface {
+	Get(selector Selector) (adGroupAds AdGroupAds, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (adGroupAds AdGroupAds, totalCount int64, err error)
+	Mutate(adGroupAdOperations AdGroupAdOperations) (adGroupAds AdGroupAds, err error)
+	MutateContext(ctx context.Context, adGroupAdOperations AdGroupAdOperations) (adGroupAds AdGroupAds, err error)
+	MutateOperations(operations []AdGroupAdOperation) (adGroupAds AdGroupAds, err error)
+	MutateOperationsContext(ctx context.Context, operations []AdGroupAdOperation) (adGroupAds AdGroupAds, err error)
+	MutateLabel(adGroupAdLabelOperations AdGroupAdLabelOperations) (adGroupAdLabels []AdGroupAdLabel, err error)
+	MutateLabelContext(ctx context.Context, adGroupAdLabelOperations AdGroupAdLabelOperations) (adGroupAdLabels []AdGroupAdLabel, err error)
+	MutateLabelOperations(operations []AdGroupAdLabelOperation) (adGroupAdLabels []AdGroupAdLabel, err error)
+	MutateLabelOperationsContext(ctx context.Context, operations []AdGroupAdLabelOperation) (adGroupAdLabels []AdGroupAdLabel, err error)
+	Query(query string) (adGroupAds AdGroupAds, totalCount int64, err error)
+	QueryContext(ctx context.Context, query string) (adGroupAds AdGroupAds, totalCount int64, err error)
+	UpgradeUrl(adUrlUpgrades []AdUrlUpgrade) (adGroupAds AdGroupAds, err error)
+	MutateOperationsChunked(ctx context.Context, operations []AdGroupAdOperation, opts *ChunkOptions) (AdGroupAds, error)
+}
+
+// AdGroupCriterionServicer is the method set of AdGroupCriterionService.
+type AdGroupCriterionServicer interface {
+	Get(selector Selector) (adGroupCriterions AdGroupCriterions, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (adGroupCriterions AdGroupCriterions, totalCount int64, err error)
+	MutateOperations(operations []AdGroupCriterionOperation) (adGroupCriterions AdGroupCriterions, err error)
+	MutateOperationsContext(ctx context.Context, operations []AdGroupCriterionOperation) (adGroupCriterions AdGroupCriterions, err error)
+	Mutate(adGroupCriterionOperations AdGroupCriterionOperations) (adGroupCriterions AdGroupCriterions, err error)
+	MutateContext(ctx context.Context, adGroupCriterionOperations AdGroupCriterionOperations) (adGroupCriterions AdGroupCriterions, err error)
+	MutateLabel(adGroupCriterionLabelOperations AdGroupCriterionLabelOperations) (adGroupCriterionLabels []AdGroupCriterionLabel, err error)
+	MutateLabelContext(ctx context.Context, adGroupCriterionLabelOperations AdGroupCriterionLabelOperations) (adGroupCriterionLabels []AdGroupCriterionLabel, err error)
+	MutateLabelOperations(operations []AdGroupCriterionLabelOperation) (adGroupCriterionLabels []AdGroupCriterionLabel, err error)
+	MutateLabelOperationsContext(ctx context.Context, operations []AdGroupCriterionLabelOperation) (adGroupCriterionLabels []AdGroupCriterionLabel, err error)
+	Query(query string) (adGroupCriterions AdGroupCriterions, totalCount int64, err error)
+	QueryContext(ctx context.Context, query string) (adGroupCriterions AdGroupCriterions, totalCount int64, err error)
+	MutateOperationsChunked(ctx context.Context, operations []AdGroupCriterionOperation, opts *ChunkOptions) (AdGroupCriterions, error)
+}
+
+// AdGroupExtensionSettingServicer is the method set of AdGroupExtensionSettingService.
+type AdGroupExtensionSettingServicer interface {
+	Query(query string) (settings []AdGroupExtensionSetting, totalCount int64, err error)
+	QueryContext(ctx context.Context, query string) (settings []AdGroupExtensionSetting, totalCount int64, err error)
+	Mutate(settingsOperations AdGroupExtensionSettingOperations) (settings []AdGroupExtensionSetting, err error)
+	MutateContext(ctx context.Context, settingsOperations AdGroupExtensionSettingOperations) (settings []AdGroupExtensionSetting, err error)
+	MutateOperations(operations []AdGroupExtensionSettingOperation) (settings []AdGroupExtensionSetting, err error)
+	MutateOperationsContext(ctx context.Context, operations []AdGroupExtensionSettingOperation) (settings []AdGroupExtensionSetting, err error)
+}
+
+// AdGroupFeedServicer is the method set of AdGroupFeedService.
+type AdGroupFeedServicer interface {
+	Get(selector Selector) (AdGroupFeedPage, error)
+	GetContext(ctx context.Context, selector Selector) (rval AdGroupFeedPage, err error)
+	Mutate(operations []AdGroupFeedOperation) (AdGroupFeedReturnValue, error)
+	MutateContext(ctx context.Context, operations []AdGroupFeedOperation) (rval AdGroupFeedReturnValue, err error)
+	Query(query string) (AdGroupFeedPage, error)
+	QueryContext(ctx context.Context, query string) (rval AdGroupFeedPage, err error)
+}
+
+// AdGroupServicer is the method set of AdGroupService.
+type AdGroupServicer interface {
+	Get(selector Selector) (adGroups []AdGroup, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (adGroups []AdGroup, totalCount int64, err error)
+	Mutate(adGroupOperations AdGroupOperations) (adGroups []AdGroup, err error)
+	MutateContext(ctx context.Context, adGroupOperations AdGroupOperations) (adGroups []AdGroup, err error)
+	MutateOperations(operations []AdGroupOperation) (adGroups []AdGroup, err error)
+	MutateOperationsContext(ctx context.Context, operations []AdGroupOperation) (adGroups []AdGroup, err error)
+	MutateLabel(adGroupLabelOperations AdGroupLabelOperations) (adGroupLabels []AdGroupLabel, err error)
+	MutateLabelContext(ctx context.Context, adGroupLabelOperations AdGroupLabelOperations) (adGroupLabels []AdGroupLabel, err error)
+	MutateLabelOperations(operations []AdGroupLabelOperation) (adGroupLabels []AdGroupLabel, err error)
+	MutateLabelOperationsContext(ctx context.Context, operations []AdGroupLabelOperation) (adGroupLabels []AdGroupLabel, err error)
+	Query(query string) (adGroups []AdGroup, totalCount int64, err error)
+	QueryContext(ctx context.Context, query string) (adGroups []AdGroup, totalCount int64, err error)
+}
+
+// AdParamServicer is the method set of AdParamService.
+type AdParamServicer interface {
+	Get(serviceSelector Selector) (AdParamPage, error)
+	GetContext(ctx context.Context, serviceSelector Selector) (rval AdParamPage, err error)
+	Mutate(operations []AdParamOperation) ([]AdParam, error)
+	MutateContext(ctx context.Context, operations []AdParamOperation) (rval []AdParam, err error)
+}
+
+// AdwordsUserListServicer is the method set of AdwordsUserListService.
+type AdwordsUserListServicer interface {
+	Get(selector Selector) (userLists []UserList, err error)
+	GetContext(ctx context.Context, selector Selector) (userLists []UserList, err error)
+	Mutate(userListOperations UserListOperations) (adwordsUserLists []UserList, err error)
+	MutateContext(ctx context.Context, userListOperations UserListOperations) (adwordsUserLists []UserList, err error)
+	MutateMembers(mutateMembersOperations MutateMembersOperations) (adwordsUserLists []UserList, err error)
+	MutateMembersContext(ctx context.Context, mutateMembersOperations MutateMembersOperations) (adwordsUserLists []UserList, err error)
+	MutateMembersChunked(ctx context.Context, mutateMembersOperations MutateMembersOperations, opts *ChunkOptions) (adwordsUserLists []UserList, err error)
+}
+
+// BatchJobServicer is the method set of BatchJobService.
+type BatchJobServicer interface {
+	Get(selector Selector) (batchJobPage BatchJobPage, err error)
+	GetContext(ctx context.Context, selector Selector) (batchJobPage BatchJobPage, err error)
+	Mutate(batchJobOperations BatchJobOperations) (batchJobs []BatchJob, err error)
+	MutateContext(ctx context.Context, batchJobOperations BatchJobOperations) (batchJobs []BatchJob, err error)
+}
+
+// BudgetServicer is the method set of BudgetService.
+type BudgetServicer interface {
+	Get(selector Selector) (budgets []Budget, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (budgets []Budget, totalCount int64, err error)
+	Mutate(budgetOperations BudgetOperations) (budgets []Budget, err error)
+	MutateContext(ctx context.Context, budgetOperations BudgetOperations) (budgets []Budget, err error)
+	MutateOperations(operations []BudgetOperation) (budgets []Budget, err error)
+	MutateOperationsContext(ctx context.Context, operations []BudgetOperation) (budgets []Budget, err error)
+}
+
+// CampaignCriterionServicer is the method set of CampaignCriterionService.
+type CampaignCriterionServicer interface {
+	Get(selector Selector) (campaignCriterions CampaignCriterions, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (campaignCriterions CampaignCriterions, totalCount int64, err error)
+	MutateOperations(operations []CampaignCriterionOperation) (CampaignCriterions, error)
+	MutateOperationsContext(ctx context.Context, operations []CampaignCriterionOperation) (CampaignCriterions, error)
+	Mutate(campaignCriterionOperations CampaignCriterionOperations) (campaignCriterions CampaignCriterions, err error)
+	MutateContext(ctx context.Context, campaignCriterionOperations CampaignCriterionOperations) (campaignCriterions CampaignCriterions, err error)
+	Query(query string) (campaignCriterions CampaignCriterions, totalCount int64, err error)
+	QueryContext(ctx context.Context, query string) (campaignCriterions CampaignCriterions, totalCount int64, err error)
+	MutateOperationsChunked(ctx context.Context, operations []CampaignCriterionOperation, opts *ChunkOptions) (CampaignCriterions, error)
+}
+
+// CampaignExtensionSettingServicer is the method set of CampaignExtensionSettingService.
+type CampaignExtensionSettingServicer interface {
+	Query(query string) (settings []CampaignExtensionSetting, totalCount int64, err error)
+	QueryContext(ctx context.Context, query string) (settings []CampaignExtensionSetting, totalCount int64, err error)
+	Mutate(settingsOperations CampaignExtensionSettingOperations) (settings []CampaignExtensionSetting, err error)
+	MutateContext(ctx context.Context, settingsOperations CampaignExtensionSettingOperations) (settings []CampaignExtensionSetting, err error)
+	MutateOperations(operations []CampaignExtensionSettingOperation) (settings []CampaignExtensionSetting, err error)
+	MutateOperationsContext(ctx context.Context, operations []CampaignExtensionSettingOperation) (settings []CampaignExtensionSetting, err error)
+}
+
+// CampaignServicer is the method set of CampaignService.
+type CampaignServicer interface {
+	Get(selector Selector) (campaigns []Campaign, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (campaigns []Campaign, totalCount int64, err error)
+	MutateOperations(ops []CampaignOperation) (campaigns []Campaign, err error)
+	MutateOperationsContext(ctx context.Context, ops []CampaignOperation) (campaigns []Campaign, err error)
+	Mutate(campaignOperations CampaignOperations) (campaigns []Campaign, err error)
+	MutateContext(ctx context.Context, campaignOperations CampaignOperations) (campaigns []Campaign, err error)
+	MutateLabel(campaignLabelOperations CampaignLabelOperations) (campaignLabels []CampaignLabel, err error)
+	MutateLabelContext(ctx context.Context, campaignLabelOperations CampaignLabelOperations) (campaignLabels []CampaignLabel, err error)
+	MutateLabelOperations(operations []CampaignLabelOperation) (campaignLabels []CampaignLabel, err error)
+	MutateLabelOperationsContext(ctx context.Context, operations []CampaignLabelOperation) (campaignLabels []CampaignLabel, err error)
+	Query(query string) (campaigns []Campaign, totalCount int64, err error)
+	QueryContext(ctx context.Context, query string) (campaigns []Campaign, totalCount int64, err error)
+}
+
+// CampaignSharedSetServicer is the method set of CampaignSharedSetService.
+type CampaignSharedSetServicer interface {
+	Get(selector Selector) (sharedSets []CampaignSharedSet, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (sharedSets []CampaignSharedSet, totalCount int64, err error)
+	Mutate(operations []CampaignSharedSetOperation) error
+	MutateContext(ctx context.Context, operations []CampaignSharedSetOperation) error
+}
+
+// ConstantDataServicer is the method set of ConstantDataService.
+type ConstantDataServicer interface {
+	GetAgeRangeCriterion() (ageRanges []AgeRangeCriterion, err error)
+	GetAgeRangeCriterionContext(ctx context.Context) (ageRanges []AgeRangeCriterion, err error)
+	GetCarrierCriterion() (carriers []CarrierCriterion, err error)
+	GetCarrierCriterionContext(ctx context.Context) (carriers []CarrierCriterion, err error)
+	GetGenderCriterion() (genders []GenderCriterion, err error)
+	GetGenderCriterionContext(ctx context.Context) (genders []GenderCriterion, err error)
+	GetLanguageCriterion() (languages []LanguageCriterion, err error)
+	GetLanguageCriterionContext(ctx context.Context) (languages []LanguageCriterion, err error)
+	GetMobileDeviceCriterion() (mobileDevices []MobileDeviceCriterion, err error)
+	GetMobileDeviceCriterionContext(ctx context.Context) (mobileDevices []MobileDeviceCriterion, err error)
+	GetOperatingSystemVersionCriterion() (operatingSystemVersions []OperatingSystemVersionCriterion, err error)
+	GetOperatingSystemVersionCriterionContext(ctx context.Context) (operatingSystemVersions []OperatingSystemVersionCriterion, err error)
+	GetProductBiddingCategoryCriterion(selector Selector) (categoryData []ProductBiddingCategoryData, err error)
+	GetProductBiddingCategoryCriterionContext(ctx context.Context, selector Selector) (categoryData []ProductBiddingCategoryData, err error)
+	GetUserInterestCriterion() (userInterests []UserInterestCriterion, err error)
+	GetUserInterestCriterionContext(ctx context.Context) (userInterests []UserInterestCriterion, err error)
+	GetVerticalCriterion() (verticals []VerticalCriterion, err error)
+	GetVerticalCriterionContext(ctx context.Context) (verticals []VerticalCriterion, err error)
+}
+
+// CustomerServicer is the method set of CustomerService.
+type CustomerServicer interface {
+	GetCustomers() (customers []Customer, err error)
+	GetCustomersContext(ctx context.Context) (customers []Customer, err error)
+}
+
+// CustomerSyncServicer is the method set of CustomerSyncService.
+type CustomerSyncServicer interface {
+	Get(selector CustomerSyncSelector) (changeData CustomerChangeData, err error)
+	GetContext(ctx context.Context, selector CustomerSyncSelector) (changeData CustomerChangeData, err error)
+}
+
+// DataServicer is the method set of DataService.
+type DataServicer interface {
+	GetAdGroupBidLandscape(selector Selector) (adGroupBidLandscapes []AdGroupBidLandscape, totalCount int64, err error)
+	GetAdGroupBidLandscapeContext(ctx context.Context, selector Selector) (adGroupBidLandscapes []AdGroupBidLandscape, totalCount int64, err error)
+	GetCampaignCriterionBidLandscape(selector Selector) (ret []CriterionBidLandscape, totalCount int64, err error)
+	GetCampaignCriterionBidLandscapeContext(ctx context.Context, selector Selector) (ret []CriterionBidLandscape, totalCount int64, err error)
+	GetCriterionBidLandscape(selector Selector) (criterionBidLandscapes []CriterionBidLandscape, totalCount int64, err error)
+	GetCriterionBidLandscapeContext(ctx context.Context, selector Selector) (criterionBidLandscapes []CriterionBidLandscape, totalCount int64, err error)
+	QueryAdGroupBidLandscape(query string) (adGroupBidLandscapes []AdGroupBidLandscape, totalCount int64, err error)
+	QueryAdGroupBidLandscapeContext(ctx context.Context, query string) (adGroupBidLandscapes []AdGroupBidLandscape, totalCount int64, err error)
+	QueryCriterionBidLandscape(query string) (criterionBidLandscapes []CriterionBidLandscape, totalCount int64, err error)
+	QueryCriterionBidLandscapeContext(ctx context.Context, query string) (criterionBidLandscapes []CriterionBidLandscape, totalCount int64, err error)
+}
+
+// FeedServicer is the method set of FeedService.
+type FeedServicer interface {
+	Query(query string) (page []Feed, totalCount int64, err error)
+	QueryContext(ctx context.Context, query string) (page []Feed, totalCount int64, err error)
+}
+
+// LabelServicer is the method set of LabelService.
+type LabelServicer interface {
+	Get(selector Selector) (labels []Label, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (labels []Label, totalCount int64, err error)
+	Mutate(labelOperations LabelOperations) (labels []Label, err error)
+	MutateContext(ctx context.Context, labelOperations LabelOperations) (labels []Label, err error)
+	MutateOperations(operations []LabelOperation) (labels []Label, err error)
+	MutateOperationsContext(ctx context.Context, operations []LabelOperation) (labels []Label, err error)
+	Query(query string) (labels []Label, totalCount int64, err error)
+	QueryContext(ctx context.Context, query string) (labels []Label, totalCount int64, err error)
+}
+
+// LocationCriterionServicer is the method set of LocationCriterionService.
+type LocationCriterionServicer interface {
+	Get(selector Selector) (locationCriterions LocationCriterions, err error)
+	GetContext(ctx context.Context, selector Selector) (locationCriterions LocationCriterions, err error)
+}
+
+// ManagedCustomerServicer is the method set of ManagedCustomerService.
+type ManagedCustomerServicer interface {
+	Get(selector Selector) (managedCustomerPage ManagedCustomerPage, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (managedCustomerPage ManagedCustomerPage, totalCount int64, err error)
+	Mutate(managedCustomerOperations ManagedCustomerOperations) (managedCustomers []ManagedCustomer, err error)
+	MutateContext(ctx context.Context, managedCustomerOperations ManagedCustomerOperations) (managedCustomers []ManagedCustomer, err error)
+	MutateOperations(operations []ManagedCustomerOperation) (managedCustomers []ManagedCustomer, err error)
+	MutateOperationsContext(ctx context.Context, operations []ManagedCustomerOperation) (managedCustomers []ManagedCustomer, err error)
+	MutateLink(managedCustomerLinkOperations ManagedCustomerLinkOperations) (managedCustomerLinks []ManagedCustomerLink, err error)
+	MutateLinkContext(ctx context.Context, managedCustomerLinkOperations ManagedCustomerLinkOperations) (managedCustomerLinks []ManagedCustomerLink, err error)
+	MutateLinkOperations(operations []ManagedCustomerLinkOperation) (managedCustomerLinks []ManagedCustomerLink, err error)
+	MutateLinkOperationsContext(ctx context.Context, operations []ManagedCustomerLinkOperation) (managedCustomerLinks []ManagedCustomerLink, err error)
+}
+
+// MediaServicer is the method set of MediaService.
+type MediaServicer interface {
+	Get(selector Selector) (medias []Media, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (medias []Media, totalCount int64, err error)
+	Query(query string) (medias []Media, totalCount int64, err error)
+	Upload(medias []Media) (uploadedMedias []Media, err error)
+	UploadContext(ctx context.Context, medias []Media) (uploadedMedias []Media, err error)
+}
+
+// ReportDefinitionServicer is the method set of ReportDefinitionService.
+type ReportDefinitionServicer interface {
+	GetReportFields(report string) (fields []ReportDefinitionField, err error)
+	GetReportFieldsContext(ctx context.Context, report string) (fields []ReportDefinitionField, err error)
+}
+
+// ReportDownloadServicer is the method set of ReportDownloadService.
+type ReportDownloadServicer interface {
+	Get(reportDefinition ReportDefinition) (res interface{}, err error)
+	GetContext(ctx context.Context, reportDefinition ReportDefinition) (res interface{}, err error)
+	StreamAWQL(awql string, fmt string) (io.ReadCloser, error)
+	StreamAWQLContext(ctx context.Context, awql string, fmt string) (io.ReadCloser, error)
+	AWQL(awql string, fmt string) (interface{}, error)
+	AWQLContext(ctx context.Context, awql string, fmt string) (interface{}, error)
+}
+
+// SharedCriterionServicer is the method set of SharedCriterionService.
+type SharedCriterionServicer interface {
+	Get(selector Selector) (sharedCriteria []SharedCriterion, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (sharedCriteria []SharedCriterion, totalCount int64, err error)
+	Mutate(operations []SharedCriterionOperation) error
+	MutateContext(ctx context.Context, operations []SharedCriterionOperation) error
+}
+
+// SharedSetServicer is the method set of SharedSetService.
+type SharedSetServicer interface {
+	Get(selector Selector) (sharedSets []SharedSet, totalCount int64, err error)
+	GetContext(ctx context.Context, selector Selector) (sharedSets []SharedSet, totalCount int64, err error)
+	Mutate(operations []SharedSetOperation) ([]SharedSet, error)
+	MutateContext(ctx context.Context, operations []SharedSetOperation) ([]SharedSet, error)
+}
+
+// TargetingIdeaServicer is the method set of TargetingIdeaService.
+type TargetingIdeaServicer interface {
+	Get(selector TargetingIdeaSelector) (targetingIdeas []TargetingIdeas, totalCount int64, err error)
+	GetContext(ctx context.Context, selector TargetingIdeaSelector) (targetingIdeas []TargetingIdeas, totalCount int64, err error)
+}
+
+// TrafficEstimatorServicer is the method set of TrafficEstimatorService.
+type TrafficEstimatorServicer interface {
+	Get(selector TrafficEstimatorSelector) (res []CampaignEstimate, err error)
+	GetContext(ctx context.Context, selector TrafficEstimatorSelector) (res []CampaignEstimate, err error)
+}
+
+var (
+	_ AdGroupAdServicer                = (*AdGroupAdService)(nil)
+	_ AdGroupCriterionServicer         = (*AdGroupCriterionService)(nil)
+	_ AdGroupExtensionSettingServicer  = (*AdGroupExtensionSettingService)(nil)
+	_ AdGroupFeedServicer              = (*AdGroupFeedService)(nil)
+	_ AdGroupServicer                  = (*AdGroupService)(nil)
+	_ AdParamServicer                  = (*AdParamService)(nil)
+	_ AdwordsUserListServicer          = (*AdwordsUserListService)(nil)
+	_ BatchJobServicer                 = (*BatchJobService)(nil)
+	_ BudgetServicer                   = (*BudgetService)(nil)
+	_ CampaignCriterionServicer        = (*CampaignCriterionService)(nil)
+	_ CampaignExtensionSettingServicer = (*CampaignExtensionSettingService)(nil)
+	_ CampaignServicer                 = (*CampaignService)(nil)
+	_ CampaignSharedSetServicer        = (*CampaignSharedSetService)(nil)
+	_ ConstantDataServicer             = (*ConstantDataService)(nil)
+	_ CustomerServicer                 = (*CustomerService)(nil)
+	_ CustomerSyncServicer             = (*CustomerSyncService)(nil)
+	_ DataServicer                     = (*DataService)(nil)
+	_ FeedServicer                     = (*FeedService)(nil)
+	_ LabelServicer                    = (*LabelService)(nil)
+	_ LocationCriterionServicer        = (*LocationCriterionService)(nil)
+	_ ManagedCustomerServicer          = (*ManagedCustomerService)(nil)
+	_ MediaServicer                    = (*MediaService)(nil)
+	_ ReportDefinitionServicer         = (*ReportDefinitionService)(nil)
+	_ ReportDownloadServicer           = (*ReportDownloadService)(nil)
+	_ SharedCriterionServicer          = (*SharedCriterionService)(nil)
+	_ SharedSetServicer                = (*SharedSetService)(nil)
+	_ TargetingIdeaServicer            = (*TargetingIdeaService)(nil)
+	_ TrafficEstimatorServicer         = (*TrafficEstimatorService)(nil)
+)
@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -25,8 +26,15 @@ type SharedCriterionOperation struct {
 }
 
 func (s SharedCriterionService) Get(selector Selector) (sharedCriteria []SharedCriterion, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s SharedCriterionService) GetContext(ctx context.Context, selector Selector) (sharedCriteria []SharedCriterion, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "selector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		sharedCriterionServiceUrl,
 		"get",
 		struct {
@@ -55,6 +63,12 @@ func (s SharedCriterionService) Get(selector Selector) (sharedCriteria []SharedC
 }
 
 func (s SharedCriterionService) Mutate(operations []SharedCriterionOperation) error {
+	return s.MutateContext(context.Background(), operations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s SharedCriterionService) MutateContext(ctx context.Context, operations []SharedCriterionOperation) error {
 	mutateRequest := struct {
 		XMLName xml.Name
 		Ops     []SharedCriterionOperation `xml:"operations"`
@@ -64,8 +78,17 @@ func (s SharedCriterionService) Mutate(operations []SharedCriterionOperation) er
 			Local: "mutate",
 		},
 		Ops: operations}
-	_, err := s.Auth.request(sharedCriterionServiceUrl, "mutate", mutateRequest)
-	return err
+	respBody, meta, err := s.Auth.requestMeta(ctx, sharedCriterionServiceUrl, "mutate", mutateRequest)
+	if err != nil {
+		return err
+	}
+	mutateResp := struct {
+		PartialFailureErrors apiErrors `xml:"rval>partialFailureErrors"`
+	}{}
+	if err := xml.Unmarshal(respBody, &mutateResp); err != nil {
+		return err
+	}
+	return partialFailure(meta.RequestId, operations, nil, mutateResp.PartialFailureErrors)
 }
 
 func (s *SharedCriterion) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
@@ -114,3 +137,22 @@ func (s SharedCriterion) MarshalXML(e *xml.Encoder, start xml.StartElement) erro
 	e.EncodeToken(start.End())
 	return nil
 }
+
+// SharedCriterionPages iterates over pages of shared criteria, see Pager.
+type SharedCriterionPages struct {
+	*Pager
+}
+
+// SharedCriteria returns the current page.
+func (p SharedCriterionPages) SharedCriteria() []SharedCriterion {
+	return p.Page().([]SharedCriterion)
+}
+
+// Pages returns an iterator over the shared criteria matching selector.  Criterion ids are not
+// unique, so paging stops with ErrOffsetLimit at MaxOffset.
+func (s SharedCriterionService) Pages(ctx context.Context, selector Selector, opts *PageOptions) SharedCriterionPages {
+	return SharedCriterionPages{newPager(ctx, selector, opts, "", "", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
+}
@@ -1,6 +1,9 @@
 package v201809
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 type SharedSetService struct {
 	Auth
@@ -25,8 +28,15 @@ type SharedSetOperation struct {
 }
 
 func (s SharedSetService) Get(selector Selector) (sharedSets []SharedSet, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s SharedSetService) GetContext(ctx context.Context, selector Selector) (sharedSets []SharedSet, totalCount int64, err error) {
 	selector.XMLName = xml.Name{baseUrl, "selector"}
 	respBody, err := s.Auth.request(
+		ctx,
 		sharedSetServiceUrl,
 		"get",
 		struct {
@@ -55,6 +65,12 @@ func (s SharedSetService) Get(selector Selector) (sharedSets []SharedSet, totalC
 }
 
 func (s SharedSetService) Mutate(operations []SharedSetOperation) ([]SharedSet, error) {
+	return s.MutateContext(context.Background(), operations)
+}
+
+// MutateContext is like Mutate but takes a context that controls the
+// request lifetime.
+func (s SharedSetService) MutateContext(ctx context.Context, operations []SharedSetOperation) ([]SharedSet, error) {
 	mutateRequest := struct {
 		XMLName xml.Name
 		Ops     []SharedSetOperation `xml:"operations"`
@@ -65,19 +81,38 @@ func (s SharedSetService) Mutate(operations []SharedSetOperation) ([]SharedSet,
 		},
 		Ops: operations}
 
-	respBody, err := s.Auth.request(sharedSetServiceUrl, "mutate", mutateRequest)
+	respBody, meta, err := s.Auth.requestMeta(ctx, sharedSetServiceUrl, "mutate", mutateRequest)
 
 	if err != nil {
 		return nil, err
 	}
 
 	getResp := struct {
-		ListReturnValueType string      `xml:"rval>ListReturnValue.Type"`
-		SharedSets          []SharedSet `xml:"rval>value"`
+		ListReturnValueType  string      `xml:"rval>ListReturnValue.Type"`
+		SharedSets           []SharedSet `xml:"rval>value"`
+		PartialFailureErrors apiErrors   `xml:"rval>partialFailureErrors"`
 	}{}
 	err = xml.Unmarshal([]byte(respBody), &getResp)
 	if err != nil {
 		return nil, err
 	}
-	return getResp.SharedSets, nil
+	return getResp.SharedSets, partialFailure(meta.RequestId, operations, getResp.SharedSets, getResp.PartialFailureErrors)
+}
+
+// SharedSetPages iterates over pages of shared sets, see Pager.
+type SharedSetPages struct {
+	*Pager
+}
+
+// SharedSets returns the current page.
+func (p SharedSetPages) SharedSets() []SharedSet {
+	return p.Page().([]SharedSet)
+}
+
+// Pages returns an iterator over the shared sets matching selector.
+func (s SharedSetService) Pages(ctx context.Context, selector Selector, opts *PageOptions) SharedSetPages {
+	return SharedSetPages{newPager(ctx, selector, opts, "SharedSetId", "Id", func(ctx context.Context, selector Selector) (interface{}, interface{}, int64, error) {
+		page, totalCount, err := s.GetContext(ctx, selector)
+		return page, page, totalCount, err
+	})}
 }
@@ -1,6 +1,14 @@
 package v201809
 
-import "time"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
 
 type CallStatItem struct {
 	Requests  int
@@ -9,54 +17,246 @@ type CallStatItem struct {
 	TotalTime time.Duration
 	ReqTime   time.Duration
 	CacheTime time.Duration
+	// Operations is the number of operations the API charged for.
+	Operations int64
+	// LastRequestId is the id of the latest response.
+	LastRequestId string
 }
 
+// CallStat sums the MethodStats of DefaultStats, in total and by service.
 type CallStat struct {
 	CallStatItem
 	ServiceStat map[string]*CallStatItem
 }
 
-var (
-	stat = CallStat{
-		ServiceStat: map[string]*CallStatItem{},
+func (i *CallStatItem) add(m MethodStats) {
+	i.Requests += m.Requests
+	i.Cached += m.Cached
+	i.MemCached += m.MemCached
+	i.TotalTime += m.ReqTime + m.CacheTime
+	i.ReqTime += m.ReqTime
+	i.CacheTime += m.CacheTime
+	i.Operations += m.Operations
+	if m.LastRequestId != "" {
+		i.LastRequestId = m.LastRequestId
 	}
-)
+}
+
+// GetStat returns a snapshot of the calls recorded in DefaultStats.
+func GetStat() *CallStat {
+	s := &CallStat{ServiceStat: map[string]*CallStatItem{}}
+	for _, m := range DefaultStats.Snapshot() {
+		s.add(m)
+		if _, ok := s.ServiceStat[m.Service]; !ok {
+			s.ServiceStat[m.Service] = &CallStatItem{}
+		}
+		s.ServiceStat[m.Service].add(m)
+	}
+	return s
+}
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the latency
+// histograms of Stats.
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Stats records the calls made with the Auths using it.  It is safe for
+// concurrent use, and serves its counters in the Prometheus text format as
+// an http.Handler.
+type Stats struct {
+	// Buckets are the upper bounds of the latency histograms,
+	// DefaultLatencyBuckets when nil.  They must not change once calls are
+	// recorded.
+	Buckets []float64
+
+	mu    sync.Mutex
+	calls map[statKey]*MethodStats
+}
+
+// DefaultStats records the calls of every Auth without Stats.
+var DefaultStats = &Stats{}
+
+type statKey struct {
+	customerId, service, method string
+}
+
+// MethodStats are the counters of the calls of one method of a service for
+// one customer.
+type MethodStats struct {
+	CustomerId string `json:"customerId"`
+	Service    string `json:"service"`
+	Method     string `json:"method"`
+	Requests   int    `json:"requests"`
+	// Cached counts the calls answered from the cache, MemCached those
+	// answered from memory.
+	Cached    int `json:"cached"`
+	MemCached int `json:"memCached"`
+	// Errors counts the failed calls by type of error, e.g.
+	// "RateExceededError" or "HTTPError".
+	Errors map[string]int `json:"errors,omitempty"`
+	// Retries counts the attempts made after the first one.
+	Retries       int   `json:"retries"`
+	BytesSent     int64 `json:"bytesSent"`
+	BytesReceived int64 `json:"bytesReceived"`
+	// Operations is the number of operations the API charged for.
+	Operations    int64  `json:"operations"`
+	LastRequestId string `json:"lastRequestId,omitempty"`
+	// ReqTime and CacheTime are the time spent on calls sent to the API and
+	// answered from the cache.
+	ReqTime   time.Duration `json:"reqTime"`
+	CacheTime time.Duration `json:"cacheTime"`
+	// Latency is the histogram of the calls sent to the API.
+	Latency Histogram `json:"latency"`
+}
+
+// Histogram counts observations in buckets of upper bounds.
+type Histogram struct {
+	Bounds []float64 `json:"bounds"`
+	// Counts holds the number of observations in each bucket, plus those
+	// above the last bound.  They are not cumulative.
+	Counts []uint64 `json:"counts"`
+	Sum    float64  `json:"sum"`
+	Count  uint64   `json:"count"`
+}
+
+func (h *Histogram) observe(v float64) {
+	i := sort.SearchFloat64s(h.Bounds, v)
+	h.Counts[i]++
+	h.Sum += v
+	h.Count++
+}
+
+func (s *Stats) method(customerId, service, method string) *MethodStats {
+	if s.calls == nil {
+		s.calls = map[statKey]*MethodStats{}
+	}
+	key := statKey{customerId, service, method}
+	m, ok := s.calls[key]
+	if !ok {
+		bounds := s.Buckets
+		if bounds == nil {
+			bounds = DefaultLatencyBuckets
+		}
+		m = &MethodStats{
+			CustomerId: customerId,
+			Service:    service,
+			Method:     method,
+			Latency:    Histogram{Bounds: bounds, Counts: make([]uint64, len(bounds)+1)},
+		}
+		s.calls[key] = m
+	}
+	return m
+}
 
-func (s *CallStat) count(service string, cached, mem bool, t time.Duration) {
-	// calc values
-	reqDuration := time.Second * 0
-	cacheDuration := time.Second * 0
-	cachedcnt := 0
-	memcachedcnt := 0
-	if cached {
-		cacheDuration += t
-		cachedcnt++
-		if mem {
-			memcachedcnt++
+// record counts a call that took t.
+func (s *Stats) record(customerId string, call *Call, resp *Response, err error, t time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	m := s.method(customerId, call.Service.Name, call.Action)
+	m.Requests++
+	if resp != nil && resp.Cached {
+		m.Cached++
+		if resp.memCached {
+			m.MemCached++
 		}
+		m.CacheTime += t
 	} else {
-		reqDuration += t
-	}
-	// update total stat
-	s.Requests++
-	s.Cached += cachedcnt
-	s.MemCached += memcachedcnt
-	s.TotalTime += reqDuration + cacheDuration
-	s.ReqTime += reqDuration
-	s.CacheTime += cacheDuration
-	// init service stat
-	if _, ok := s.ServiceStat[service]; !ok {
-		s.ServiceStat[service] = &CallStatItem{}
-	}
-	// update service stat
-	s.ServiceStat[service].Requests++
-	s.ServiceStat[service].Cached += cachedcnt
-	s.ServiceStat[service].MemCached += memcachedcnt
-	s.ServiceStat[service].TotalTime += reqDuration + cacheDuration
-	s.ServiceStat[service].ReqTime += reqDuration
-	s.ServiceStat[service].CacheTime += cacheDuration
+		m.BytesSent += int64(len(call.Request))
+		m.ReqTime += t
+		m.Latency.observe(t.Seconds())
+		if resp != nil {
+			m.BytesReceived += int64(len(resp.Body))
+			m.Operations += resp.Meta.Operations
+			if resp.Meta.RequestId != "" {
+				m.LastRequestId = resp.Meta.RequestId
+			}
+		}
+	}
+	if err != nil {
+		if m.Errors == nil {
+			m.Errors = map[string]int{}
+		}
+		for _, typ := range errorTypes(err) {
+			m.Errors[typ]++
+		}
+	}
 }
 
-func GetStat() *CallStat {
-	return &stat
+func (s *Stats) retry(customerId, service, method string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.method(customerId, service, method).Retries++
+}
+
+// errorTypes returns the types of the ApiErrors of err, or the type of err
+// itself.
+func errorTypes(err error) []string {
+	var types []string
+	for _, e := range apiErrorsOf(err) {
+		if e, ok := e.(TypedApiError); ok {
+			types = append(types, e.Entity().Type)
+		}
+	}
+	if types != nil {
+		return types
+	}
+	var httpErr *HTTPError
+	switch {
+	case errors.As(err, &httpErr):
+		return []string{"HTTPError"}
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return []string{"ContextError"}
+	}
+	return []string{strings.TrimPrefix(fmt.Sprintf("%T", err), "*")}
+}
+
+// Snapshot returns a copy of the counters, sorted by customer, service and
+// method.
+func (s *Stats) Snapshot() []MethodStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := make([]MethodStats, 0, len(s.calls))
+	for _, m := range s.calls {
+		c := *m
+		if m.Errors != nil {
+			c.Errors = make(map[string]int, len(m.Errors))
+			for typ, n := range m.Errors {
+				c.Errors[typ] = n
+			}
+		}
+		c.Latency.Counts = append([]uint64(nil), m.Latency.Counts...)
+		snapshot = append(snapshot, c)
+	}
+	sort.Slice(snapshot, func(i, j int) bool {
+		a, b := snapshot[i], snapshot[j]
+		if a.CustomerId != b.CustomerId {
+			return a.CustomerId < b.CustomerId
+		}
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+		return a.Method < b.Method
+	})
+	return snapshot
+}
+
+// Reset clears the counters.
+func (s *Stats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = nil
+}
+
+func (a *Auth) stats() *Stats {
+	if a.Stats != nil {
+		return a.Stats
+	}
+	return DefaultStats
+}
+
+// statInterceptor records the calls of a in its Stats.
+func (a *Auth) statInterceptor(ctx context.Context, call *Call, next Invoker) (*Response, error) {
+	startTime := time.Now()
+	resp, err := next(ctx, call)
+	a.stats().record(a.CustomerId, call, resp, err, time.Since(startTime))
+	return resp, err
 }
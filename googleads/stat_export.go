@@ -0,0 +1,100 @@
+package v201809
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WriteJSON writes the Snapshot of s as JSON.
+func (s *Stats) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(s.Snapshot())
+}
+
+// ServeHTTP serves the counters of s in the Prometheus text exposition
+// format, or as JSON when the format query parameter is "json":
+//
+//	http.Handle("/metrics", gads.DefaultStats)
+func (s *Stats) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		s.WriteJSON(w)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	s.WritePrometheus(w)
+}
+
+type promCounter struct {
+	name, help string
+	value      func(m *MethodStats) float64
+}
+
+var promCounters = []promCounter{
+	{"gads_requests_total", "Calls made, including those answered from the cache.", func(m *MethodStats) float64 { return float64(m.Requests) }},
+	{"gads_cached_requests_total", "Calls answered from the cache.", func(m *MethodStats) float64 { return float64(m.Cached) }},
+	{"gads_retries_total", "Attempts made after the first one.", func(m *MethodStats) float64 { return float64(m.Retries) }},
+	{"gads_sent_bytes_total", "Bytes of the requests sent.", func(m *MethodStats) float64 { return float64(m.BytesSent) }},
+	{"gads_received_bytes_total", "Bytes of the responses received.", func(m *MethodStats) float64 { return float64(m.BytesReceived) }},
+	{"gads_operations_total", "Operations charged by the API.", func(m *MethodStats) float64 { return float64(m.Operations) }},
+}
+
+// WritePrometheus writes the counters of s in the Prometheus text
+// exposition format.
+func (s *Stats) WritePrometheus(w io.Writer) error {
+	snapshot := s.Snapshot()
+	b := bufio.NewWriter(w)
+	for _, c := range promCounters {
+		fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+		for i := range snapshot {
+			fmt.Fprintf(b, "%s{%s} %s\n", c.name, promLabels(&snapshot[i]), promValue(c.value(&snapshot[i])))
+		}
+	}
+
+	fmt.Fprintf(b, "# HELP gads_errors_total Failed calls by type of error.\n# TYPE gads_errors_total counter\n")
+	for i := range snapshot {
+		m := &snapshot[i]
+		types := make([]string, 0, len(m.Errors))
+		for typ := range m.Errors {
+			types = append(types, typ)
+		}
+		sort.Strings(types)
+		for _, typ := range types {
+			fmt.Fprintf(b, "gads_errors_total{%s,type=%s} %d\n", promLabels(m), promQuote(typ), m.Errors[typ])
+		}
+	}
+
+	fmt.Fprintf(b, "# HELP gads_request_duration_seconds Latency of the calls sent to the API.\n# TYPE gads_request_duration_seconds histogram\n")
+	for i := range snapshot {
+		m := &snapshot[i]
+		labels := promLabels(m)
+		var cumulative uint64
+		for j, bound := range m.Latency.Bounds {
+			cumulative += m.Latency.Counts[j]
+			fmt.Fprintf(b, "gads_request_duration_seconds_bucket{%s,le=%s} %d\n", labels, promQuote(promValue(bound)), cumulative)
+		}
+		fmt.Fprintf(b, "gads_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, m.Latency.Count)
+		fmt.Fprintf(b, "gads_request_duration_seconds_sum{%s} %s\n", labels, promValue(m.Latency.Sum))
+		fmt.Fprintf(b, "gads_request_duration_seconds_count{%s} %d\n", labels, m.Latency.Count)
+	}
+	return b.Flush()
+}
+
+func promLabels(m *MethodStats) string {
+	return "customer_id=" + promQuote(m.CustomerId) + ",service=" + promQuote(m.Service) + ",method=" + promQuote(m.Method)
+}
+
+var promEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func promQuote(s string) string {
+	return `"` + promEscaper.Replace(s) + `"`
+}
+
+func promValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,87 @@
+package v201809
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/denton/gads/googleads/gadstest"
+)
+
+func TestStatsConcurrentCalls(t *testing.T) {
+	srv := gadstest.NewServer()
+	defer srv.Close()
+	gadstest.NewSimulator(srv)
+	stats := &Stats{}
+
+	var wg sync.WaitGroup
+	for _, customerId := range []string{"111-111-1111", "222-222-2222"} {
+		auth := simulatorAuth(srv)
+		auth.CustomerId = customerId
+		auth.PartialFailure = false
+		auth.Stats = stats
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(name string) {
+				defer wg.Done()
+				NewBudgetService(auth).Mutate(BudgetOperations{"ADD": {{Name: name, Amount: 1}}})
+				NewCampaignService(auth).Mutate(CampaignOperations{"ADD": {{Name: "no budget"}}})
+			}(fmt.Sprintf("%s %d", customerId, i))
+		}
+	}
+	wg.Wait()
+
+	snapshot := stats.Snapshot()
+	if len(snapshot) != 4 {
+		t.Fatalf("want 2 customers by 2 services, got %#v", snapshot)
+	}
+	budgets, campaigns := snapshot[0], snapshot[1]
+	if budgets.CustomerId != "111-111-1111" || budgets.Service != "BudgetService" || budgets.Method != "mutate" {
+		t.Errorf("unexpected order %#v", snapshot)
+	}
+	if budgets.Requests != 5 || budgets.Operations != 5 || budgets.BytesSent == 0 || budgets.BytesReceived == 0 || budgets.Latency.Count != 5 {
+		t.Errorf("unexpected budget stats %#v", budgets)
+	}
+	if campaigns.Errors["RequiredError"] != 5 {
+		t.Errorf("unexpected campaign errors %#v", campaigns.Errors)
+	}
+}
+
+func TestStatsRetriesAndExport(t *testing.T) {
+	auth, _ := cannedAuth(cannedResponse{500, rateExceededFault}, cannedResponse{500, internalApiErrorFault})
+	auth.CustomerId = "123"
+	auth.RetryPolicy = &recordingPolicy{}
+	auth.Stats = &Stats{}
+	NewBudgetService(auth).Get(Selector{Fields: []string{"BudgetId"}})
+
+	m := auth.Stats.Snapshot()[0]
+	if m.Requests != 3 || m.Retries != 2 || m.Errors["RateExceededError"] != 1 || m.Errors["InternalApiError"] != 2 {
+		t.Errorf("unexpected stats %#v", m)
+	}
+
+	rec := httptest.NewRecorder()
+	auth.Stats.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	text := rec.Body.String()
+	for _, line := range []string{
+		"# TYPE gads_requests_total counter",
+		`gads_requests_total{customer_id="123",service="BudgetService",method="get"} 3`,
+		`gads_retries_total{customer_id="123",service="BudgetService",method="get"} 2`,
+		`gads_errors_total{customer_id="123",service="BudgetService",method="get",type="RateExceededError"} 1`,
+		`gads_request_duration_seconds_bucket{customer_id="123",service="BudgetService",method="get",le="+Inf"} 3`,
+		`gads_request_duration_seconds_count{customer_id="123",service="BudgetService",method="get"} 3`,
+	} {
+		if !strings.Contains(text, line+"\n") {
+			t.Errorf("missing %s in\n%s", line, text)
+		}
+	}
+
+	rec = httptest.NewRecorder()
+	auth.Stats.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics?format=json", nil))
+	var snapshot []MethodStats
+	if err := json.Unmarshal(rec.Body.Bytes(), &snapshot); err != nil || len(snapshot) != 1 || snapshot[0].Retries != 2 {
+		t.Errorf("unexpected JSON snapshot %s: %v", rec.Body, err)
+	}
+}
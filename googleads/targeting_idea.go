@@ -1,6 +1,7 @@
 package v201809
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 )
@@ -281,8 +282,15 @@ func searchParameterMarshalXML(sp SearchParameter, e *xml.Encoder) error {
 // Get Returns a page of ideas that match the query described by the specified TargetingIdeaSelector.
 // https://developers.google.com/adwords/api/docs/reference/v201809/TargetingIdeaService
 func (s *TargetingIdeaService) Get(selector TargetingIdeaSelector) (targetingIdeas []TargetingIdeas, totalCount int64, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *TargetingIdeaService) GetContext(ctx context.Context, selector TargetingIdeaSelector) (targetingIdeas []TargetingIdeas, totalCount int64, err error) {
 
 	respBody, err := s.Auth.request(
+		ctx,
 		targetingIdeaServiceUrl,
 		"get",
 		struct {
@@ -0,0 +1,218 @@
+package v201809
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// A TokenStore persists the OAuth2 tokens of a TokenSource, so that a
+// refreshed token, and the refresh token rotated with it, outlive the
+// process.
+type TokenStore interface {
+	// LoadToken returns the stored token, or nil if there is none.
+	LoadToken() (*oauth2.Token, error)
+	// SaveToken stores token, replacing the previous one.
+	SaveToken(token *oauth2.Token) error
+}
+
+// FileTokenStore stores the token as JSON in the file Path, which it
+// replaces atomically.
+type FileTokenStore struct {
+	Path string
+}
+
+func (s FileTokenStore) LoadToken() (*oauth2.Token, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var token oauth2.Token
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("gads: %s: %v", s.Path, err)
+	}
+	return &token, nil
+}
+
+func (s FileTokenStore) SaveToken(token *oauth2.Token) error {
+	data, err := json.MarshalIndent(token, "", "    ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(s.Path, data)
+}
+
+// MemoryTokenStore keeps the token in memory, e.g. to share it between the
+// AuthConfigs of a process.  The zero value is an empty store.
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+func (s *MemoryTokenStore) LoadToken() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token, nil
+}
+
+func (s *MemoryTokenStore) SaveToken(token *oauth2.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+	return nil
+}
+
+// TokenStoreFunc is a TokenStore calling the function with each token
+// saved, e.g. to write it to a database.  It loads no token.
+type TokenStoreFunc func(token *oauth2.Token) error
+
+func (f TokenStoreFunc) LoadToken() (*oauth2.Token, error) {
+	return nil, nil
+}
+
+func (f TokenStoreFunc) SaveToken(token *oauth2.Token) error {
+	return f(token)
+}
+
+// configFileTokenStore saves tokens to the AuthConfig JSON file it names,
+// keeping the rest of the file as it is.
+type configFileTokenStore string
+
+func (s configFileTokenStore) LoadToken() (*oauth2.Token, error) {
+	return nil, nil
+}
+
+func (s configFileTokenStore) SaveToken(token *oauth2.Token) error {
+	data, err := ioutil.ReadFile(string(s))
+	if err != nil {
+		return err
+	}
+	var config map[string]json.RawMessage
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("gads: %s: %v", s, err)
+	}
+	if config["oauth2.Token"], err = json.Marshal(token); err != nil {
+		return err
+	}
+	if data, err = json.MarshalIndent(config, "", "    "); err != nil {
+		return err
+	}
+	return writeFileAtomic(string(s), data)
+}
+
+// writeFileAtomic replaces the file path with data, readable by its owner
+// only.  Readers see either the old or the new contents.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), 0600)
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// TokenSource is an oauth2.TokenSource refreshing its token when it expires
+// and saving the new one to its TokenStore.  Concurrent callers wait for a
+// single refresh.  It is the source of the clients of AuthConfig.
+type TokenSource struct {
+	refresh refreshFunc
+	store   TokenStore
+
+	mu      sync.Mutex
+	token   *oauth2.Token
+	saveErr error
+}
+
+// NewTokenSource returns a TokenSource refreshing token with config.  The
+// token in store, if any, is used instead of token, and the tokens refreshed
+// are saved to it.  store may be nil.
+func NewTokenSource(config *oauth2.Config, token *oauth2.Token, store TokenStore) (*TokenSource, error) {
+	if config == nil {
+		return nil, errors.New("gads: missing oauth2.Config")
+	}
+	return newTokenSource(func(ctx context.Context, token *oauth2.Token) (*oauth2.Token, error) {
+		return config.TokenSource(ctx, token).Token()
+	}, token, store)
+}
+
+// A refreshFunc returns a new token to replace token.
+type refreshFunc func(ctx context.Context, token *oauth2.Token) (*oauth2.Token, error)
+
+func newTokenSource(refresh refreshFunc, token *oauth2.Token, store TokenStore) (*TokenSource, error) {
+	if store != nil {
+		stored, err := store.LoadToken()
+		if err != nil {
+			return nil, err
+		}
+		if stored != nil {
+			token = stored
+		}
+	}
+	return &TokenSource{refresh: refresh, store: store, token: token}, nil
+}
+
+// Token returns a valid token, refreshing it if needed.
+func (s *TokenSource) Token() (*oauth2.Token, error) {
+	return s.TokenContext(context.Background())
+}
+
+// TokenContext is like Token but refreshes the token with ctx.  A token
+// that cannot be saved is still returned, the error of the store being
+// reported by LastSaveError.
+func (s *TokenSource) TokenContext(ctx context.Context) (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	token, err := s.refresh(ctx, s.token)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	if s.store != nil {
+		s.saveErr = nil
+		if err := s.store.SaveToken(token); err != nil {
+			s.saveErr = fmt.Errorf("gads: saving token: %v", err)
+		}
+	}
+	return token, nil
+}
+
+// LastSaveError returns the error of the store saving the last token
+// refreshed, or nil if it was saved.  The token is used all the same, but
+// is lost with the process, along with its refresh token when rotated.
+func (s *TokenSource) LastSaveError() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.saveErr
+}
+
+// current returns the latest token, valid or not.
+func (s *TokenSource) current() *oauth2.Token {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
@@ -1,6 +1,9 @@
 package v201809
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 type TrafficEstimatorService struct {
 	Auth
@@ -111,8 +114,15 @@ type CampaignEstimate struct {
 // 		https://developers.google.com/adwords/api/docs/reference/v201809/TrafficEstimatorService#get
 //
 func (s *TrafficEstimatorService) Get(selector TrafficEstimatorSelector) (res []CampaignEstimate, err error) {
+	return s.GetContext(context.Background(), selector)
+}
+
+// GetContext is like Get but takes a context that controls the
+// request lifetime.
+func (s *TrafficEstimatorService) GetContext(ctx context.Context, selector TrafficEstimatorSelector) (res []CampaignEstimate, err error) {
 
 	respBody, err := s.Auth.request(
+		ctx,
 		trafficEstimatorServiceUrl,
 		"get",
 		struct {
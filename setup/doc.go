@@ -1,8 +1,15 @@
 // setup_oauth2 is a tool for creating a gads configuration file config.json from
 // the installed application credential stored in credentials.json.  The utility will
 // open the Google consent page asking you to grant permission to the application.  Login
-// as your MCC account user. Once you have granted permission you will be provided with an
-// authorization code to copy back to the tool.
+// as your MCC account user. Once you have granted permission the consent page redirects
+// to a listener the tool starts on a random loopback port, which checks the state of the
+// request and exchanges the code with its PKCE verifier.
+//
+//     go run ./setup -customer_id 123-456-7890 -developer_token XXXXXXXX
+//
+// On a machine without a browser, -headless prints the consent page URL instead.
+// Open it anywhere, then paste the URL the browser was redirected to back to the
+// tool; that page fails to load when the browser runs on another machine.
 //
 // The generated config.json will look something like this
 //
@@ -14,7 +21,7 @@
 //                 "AuthURL": "https://accounts.google.com/o/oauth2/auth",
 //                 "TokenURL": "https://accounts.google.com/o/oauth2/token"
 //             },
-//             "RedirectURL": "oob",
+//             "RedirectURL": "http://127.0.0.1:51234/",
 //             "Scopes": [
 //                 "https://adwords.google.com/api/adwords"
 //             ]
@@ -26,15 +33,14 @@
 //             "expiry": "2015-03-05T00:13:23.382907238+09:00"
 //         },
 //         "gads.Auth": {
-//             "CustomerId": "INSERT_YOUR_CLIENT_CUSTOMER_ID_HERE",
-//             "DeveloperToken": "INSERT_YOUR_DEVELOPER_TOKEN_HERE",
-//             "UserAgent": "tests (Golang 1.4 github.com/emiddleton/gads)"
+//             "CustomerId": "123-456-7890",
+//             "DeveloperToken": "XXXXXXXX",
+//             "UserAgent": "gads (github.com/denton/gads)"
 //         }
 //     }
 //
-// You will need to add you customer id (which you can find by logging into the
-// "My Client Center" http://www.google.com/adwords/myclientcenter/) and your
-// developer token.
+// You can find your customer id by logging into the "My Client Center"
+// http://www.google.com/adwords/myclientcenter/.
 //
 // You can find details on how to create the credentials here.
 // https://developers.google.com/adwords/api/docs/guides/authentication
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/oauth2"
+)
+
+// randomString returns n random bytes encoded as URL-safe base64, long
+// enough for a PKCE verifier at n >= 32.
+func randomString(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// pkceChallenge returns the auth code options sending the S256 challenge of
+// verifier.
+func pkceChallenge(verifier string) []oauth2.AuthCodeOption {
+	sum := sha256.Sum256([]byte(verifier))
+	return []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", base64.RawURLEncoding.EncodeToString(sum[:])),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	}
+}
+
+// codeFromRedirect returns the authorization code of the query of a
+// redirect, after checking its state.
+func codeFromRedirect(query url.Values, state string) (string, error) {
+	if e := query.Get("error"); e != "" {
+		return "", fmt.Errorf("authorization failed: %s", e)
+	}
+	if query.Get("state") != state {
+		return "", errors.New("authorization failed: state mismatch")
+	}
+	code := query.Get("code")
+	if code == "" {
+		return "", errors.New("authorization failed: no code")
+	}
+	return code, nil
+}
+
+// loopback receives the redirect of the consent page on a random port of
+// the loopback interface.
+type loopback struct {
+	listener net.Listener
+	state    string
+	codes    chan string
+	errs     chan error
+}
+
+func newLoopback(state string) (*loopback, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	l := &loopback{listener: ln, state: state, codes: make(chan string, 1), errs: make(chan error, 1)}
+	go http.Serve(ln, l)
+	return l, nil
+}
+
+// redirectURL is the URL to register as the redirect URI of the request.
+func (l *loopback) redirectURL() string {
+	return "http://" + l.listener.Addr().String() + "/"
+}
+
+func (l *loopback) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	// Redirects of other requests are refused without ending the wait.
+	if r.URL.Query().Get("state") != l.state {
+		http.Error(w, "unexpected state", http.StatusBadRequest)
+		return
+	}
+	code, err := codeFromRedirect(r.URL.Query(), l.state)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	} else {
+		fmt.Fprintln(w, "Authorization received, you can close this window.")
+	}
+	l.deliver(code, err)
+}
+
+// deliver passes the result of a redirect to wait, dropping it when one is
+// already pending.
+func (l *loopback) deliver(code string, err error) {
+	if err != nil {
+		select {
+		case l.errs <- err:
+		default:
+		}
+		return
+	}
+	select {
+	case l.codes <- code:
+	default:
+	}
+}
+
+// wait returns the first authorization code received.
+func (l *loopback) wait(ctx context.Context) (string, error) {
+	select {
+	case code := <-l.codes:
+		return code, nil
+	case err := <-l.errs:
+		return "", err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (l *loopback) Close() error {
+	return l.listener.Close()
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoopback(t *testing.T) {
+	l, err := newLoopback("state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	// A redirect of another request is refused and does not end the wait.
+	resp, err := http.Get(l.redirectURL() + "?state=other&code=forged")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("want 400 for a forged state, got %d", resp.StatusCode)
+	}
+
+	resp, err = http.Get(l.redirectURL() + "?state=state&code=good")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if code, err := l.wait(ctx); code != "good" || err != nil {
+		t.Errorf("want code good, got %q %v", code, err)
+	}
+}
+
+func TestReadRedirect(t *testing.T) {
+	l := &loopback{state: "state", codes: make(chan string, 1), errs: make(chan error, 1)}
+	readRedirect(strings.NewReader("http://127.0.0.1:1/?error=access_denied&state=state\n"), l)
+	if _, err := l.wait(context.Background()); err == nil || !strings.Contains(err.Error(), "access_denied") {
+		t.Errorf("want access_denied, got %v", err)
+	}
+	readRedirect(strings.NewReader("http://127.0.0.1:1/?code=c&state=state\n"), l)
+	if code, err := l.wait(context.Background()); code != "c" || err != nil {
+		t.Errorf("want code c, got %q %v", code, err)
+	}
+}
@@ -1,12 +1,18 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	neturl "net/url"
+	"os"
+	"strings"
+	"time"
 
 	gads "github.com/denton/gads/googleads"
 
@@ -18,9 +24,21 @@ import (
 var (
 	googleConfigJSON = flag.String("credentials_json", "./credentials.json", "API credentials from Google in JSON")
 	newConfigJSON    = flag.String("new_config_json", "./config.json", "API credentials & tokens for gads in JSON")
+	customerId       = flag.String("customer_id", "", "AdWords client customer ID, e.g. 123-456-7890")
+	developerToken   = flag.String("developer_token", "", "AdWords API developer token")
+	userAgent        = flag.String("user_agent", "gads (github.com/denton/gads)", "user agent sent with requests")
+	headless         = flag.Bool("headless", false, "print the consent page URL instead of opening a browser, and accept the redirected URL pasted back")
+	timeout          = flag.Duration("timeout", 5*time.Minute, "how long to wait for the authorization")
 )
 
 func main() {
+	flag.Parse()
+	if *customerId == "" || *developerToken == "" {
+		fmt.Fprintln(os.Stderr, "setup_oauth2: -customer_id and -developer_token are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
 	data, err := ioutil.ReadFile(*googleConfigJSON)
 	if err != nil {
 		log.Panic(err)
@@ -30,21 +48,33 @@ func main() {
 		log.Panic(err)
 	}
 
-	// Redirect user to consent page to ask for permission
-	// for the scopes specified above.
-	url := conf.AuthCodeURL("state", oauth2.AccessTypeOffline)
-	fmt.Printf("Enter the code returned after authorising access: ")
-	webbrowser.Open(url)
+	// Receive the authorization code on a loopback redirect, the request
+	// being tied to this run by its state and PKCE verifier.
+	state, verifier := randomString(16), randomString(32)
+	l, err := newLoopback(state)
+	if err != nil {
+		log.Panic(err)
+	}
+	defer l.Close()
+	conf.RedirectURL = l.redirectURL()
+	url := conf.AuthCodeURL(state, append(pkceChallenge(verifier), oauth2.AccessTypeOffline, oauth2.ApprovalForce)...)
+
+	if *headless {
+		fmt.Printf("Open this URL in a browser and authorise access:\n\n%s\n\n", url)
+		fmt.Printf("Then paste the URL of the page you were redirected to: ")
+		go readRedirect(os.Stdin, l)
+	} else {
+		fmt.Printf("Authorise access in your browser, or open:\n\n%s\n\n", url)
+		webbrowser.Open(url)
+	}
 
-	// Use the authorization code that is pushed to the redirect URL.
-	// NewTransportWithCode will do the handshake to retrieve
-	// an access token and initiate a Transport that is
-	// authorized and authenticated by the retrieved token.
-	var code string
-	if _, err := fmt.Scan(&code); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	code, err := l.wait(ctx)
+	if err != nil {
 		log.Panic(err)
 	}
-	tok, err := conf.Exchange(oauth2.NoContext, code)
+	tok, err := conf.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", verifier))
 	if err != nil {
 		log.Panic(err)
 	}
@@ -53,19 +83,31 @@ func main() {
 		OAuth2Config: conf,
 		OAuth2Token:  tok,
 		Auth: gads.Auth{
-			CustomerId:     "INSERT_YOUR_CLIENT_CUSTOMER_ID_HERE",
-			DeveloperToken: "INSERT_YOUR_DEVELOPER_TOKEN_HERE",
-			UserAgent:      "tests (Golang 1.4 github.com/emiddleton/gads)",
+			CustomerId:     *customerId,
+			DeveloperToken: *developerToken,
+			UserAgent:      *userAgent,
 		},
 	}
-	configData, err := json.MarshalIndent(&ac, "", "    ")
-	if err != nil {
+	if err := ac.SaveAs(*newConfigJSON); err != nil {
 		log.Panic(err)
 	}
-	err = ioutil.WriteFile(*newConfigJSON, configData, 0600)
+	fmt.Printf("Wrote %s\n", *newConfigJSON)
+}
+
+// readRedirect passes to l the authorization code of the redirected URL
+// pasted to r.
+func readRedirect(r io.Reader, l *loopback) {
+	var redirect string
+	if _, err := fmt.Fscanln(r, &redirect); err != nil {
+		l.deliver("", err)
+		return
+	}
+	u, err := neturl.Parse(strings.TrimSpace(redirect))
 	if err != nil {
-		log.Panic(err)
+		l.deliver("", err)
+		return
 	}
+	l.deliver(codeFromRedirect(u.Query(), l.state))
 }
 
 // Oauth2ConfigFromJSON returns an oauth2.Config setup for adwords api access from a
@@ -104,6 +146,5 @@ func Oauth2ConfigFromJSON(jsonKey []byte) (oac *oauth2.Config, err error) {
 			AuthURL:  c.Installed.AuthURI,
 			TokenURL: c.Installed.TokenURI,
 		},
-		RedirectURL: "oob",
 	}, nil
 }
@@ -0,0 +1,432 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// builtins maps the XSD types to Go.
+var builtins = map[string]string{
+	"string":       "string",
+	"long":         "int64",
+	"int":          "int",
+	"short":        "int16",
+	"boolean":      "bool",
+	"double":       "float64",
+	"float":        "float32",
+	"base64Binary": "[]byte",
+	"dateTime":     "string",
+	"date":         "string",
+	"anyURI":       "string",
+}
+
+type generator struct {
+	schema *schema
+	pkg    string
+	// known holds the types declared by hand, which are referred to but
+	// not generated.
+	known map[string]bool
+	// namespaces maps XML namespaces to the Go constants holding them.
+	namespaces map[string]string
+	// types holds the types generated.
+	types    map[string]bool
+	imports  map[string]bool
+	decoders map[string]bool
+}
+
+// goName returns the exported Go name of an XML name, e.g. OperationType
+// for Operation.Type.
+func goName(name string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '.' || r == '-' || r == '_' }) {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// enumName returns the name of the constant of value of the enum typ,
+// e.g. KeywordMatchTypeExact.
+func enumName(typ, value string) string {
+	var b strings.Builder
+	b.WriteString(goName(typ))
+	for _, word := range strings.Split(strings.ToLower(value), "_") {
+		if word != "" {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
+
+// javadoc matches the inline tags of the API documentation, e.g.
+// {@code SET}.
+var javadoc = regexp.MustCompile(`\{@\w+\s+([^}]*)\}`)
+
+// comment returns text as a Go comment wrapped at 72 columns.
+func comment(text string) string {
+	text = javadoc.ReplaceAllString(text, "$1")
+	var b strings.Builder
+	line := "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 72 && line != "//" {
+			b.WriteString(line + "\n")
+			line = "//"
+		}
+		line += " " + word
+	}
+	if line != "//" {
+		b.WriteString(line + "\n")
+	}
+	return b.String()
+}
+
+// docComment returns doc as the comment of the declaration name, starting
+// with name whether the API documentation starts with a verb, e.g.
+// "Represents an ad parameter.", or an article.
+func docComment(name, doc string) string {
+	words := strings.Fields(doc)
+	switch {
+	case len(words) == 0:
+		return ""
+	case strings.HasSuffix(words[0], "s") && words[0] != strings.ToUpper(words[0]):
+		words[0] = strings.ToLower(words[0][:1]) + words[0][1:]
+	case words[0] == "The" || words[0] == "A" || words[0] == "An":
+		words[0] = "is " + strings.ToLower(words[0])
+	default:
+		name += ":"
+	}
+	return comment(name + " " + strings.Join(words, " "))
+}
+
+// reach marks name and the types it depends on to be generated.
+func (g *generator) reach(name string) {
+	if g.known[name] || g.types[name] || builtins[name] != "" {
+		return
+	}
+	if st, ok := g.schema.simpleTypes[name]; ok {
+		if len(st.values) > 0 {
+			g.types[name] = true
+		} else {
+			g.reach(st.base)
+		}
+		return
+	}
+	ct, ok := g.schema.complexTypes[name]
+	if !ok {
+		return
+	}
+	g.types[name] = true
+	for _, f := range g.schema.allFields(ct) {
+		g.reach(f.typ)
+	}
+	for _, d := range g.schema.descendants(name) {
+		g.reach(d)
+	}
+}
+
+// goType returns the Go type of the XSD type name.  inStruct tells whether
+// the type is that of a struct field, where optional structs are pointers.
+func (g *generator) goType(f field, inStruct bool) (string, error) {
+	typ, err := g.baseType(f.typ)
+	if err != nil {
+		return "", err
+	}
+	if _, complex := g.schema.complexTypes[f.typ]; complex && typ != "interface{}" && inStruct && f.optional && !f.repeated {
+		typ = "*" + typ
+	}
+	if f.repeated {
+		typ = "[]" + typ
+	}
+	return typ, nil
+}
+
+func (g *generator) baseType(name string) (string, error) {
+	if t := builtins[name]; t != "" {
+		return t, nil
+	}
+	if g.known[name] {
+		return goName(name), nil
+	}
+	if st, ok := g.schema.simpleTypes[name]; ok {
+		if len(st.values) > 0 {
+			return goName(name), nil
+		}
+		return g.baseType(st.base)
+	}
+	if _, ok := g.schema.complexTypes[name]; ok {
+		if g.schema.polymorphic(name) {
+			return "interface{}", nil
+		}
+		return goName(name), nil
+	}
+	return "", fmt.Errorf("unknown type %q", name)
+}
+
+func (g *generator) namespace(ns string) string {
+	if id, ok := g.namespaces[ns]; ok {
+		return id
+	}
+	return strconv.Quote(ns)
+}
+
+// generate returns the Go source of the types and services of the
+// schema, or of roots and their dependencies when roots is not empty.
+func (g *generator) generate(roots []string) ([]byte, error) {
+	g.types = map[string]bool{}
+	g.imports = map[string]bool{}
+	g.decoders = map[string]bool{}
+	for _, svc := range g.schema.services {
+		for _, op := range svc.operations {
+			for _, f := range append(op.params, op.results...) {
+				g.reach(f.typ)
+			}
+		}
+	}
+	for _, name := range roots {
+		g.reach(name)
+	}
+	if len(roots) == 0 && len(g.schema.services) == 0 {
+		for name := range g.schema.complexTypes {
+			g.reach(name)
+		}
+		for name := range g.schema.simpleTypes {
+			g.reach(name)
+		}
+	}
+
+	var body bytes.Buffer
+	for _, st := range sortedSimpleTypes(g.schema.simpleTypes) {
+		if g.types[st.name] {
+			g.writeEnum(&body, st)
+		}
+	}
+	for _, ct := range sortedTypes(g.schema.complexTypes) {
+		if g.types[ct.name] && !ct.abstract {
+			if err := g.writeStruct(&body, ct); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var decoders []string
+	for name := range g.decoders {
+		decoders = append(decoders, name)
+	}
+	sort.Strings(decoders)
+	for _, name := range decoders {
+		g.writeDecoder(&body, name)
+	}
+	for _, svc := range g.schema.services {
+		if err := g.writeService(&body, svc); err != nil {
+			return nil, err
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by wsdlgen; DO NOT EDIT.\n\npackage %s\n\n", g.pkg)
+	var imports []string
+	for path := range g.imports {
+		imports = append(imports, strconv.Quote(path))
+	}
+	sort.Strings(imports)
+	if len(imports) > 0 {
+		fmt.Fprintf(&out, "import (\n%s\n)\n\n", strings.Join(imports, "\n"))
+	}
+	out.Write(body.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%v\n%s", err, out.Bytes())
+	}
+	return src, nil
+}
+
+func (g *generator) writeEnum(b *bytes.Buffer, st *simpleType) {
+	name := goName(st.name)
+	b.WriteString(docComment(name, st.doc))
+	fmt.Fprintf(b, "type %s string\n\nconst (\n", name)
+	for _, v := range st.values {
+		fmt.Fprintf(b, "%s %s = %q\n", enumName(st.name, v), name, v)
+	}
+	b.WriteString(")\n\n")
+}
+
+func (g *generator) writeStruct(b *bytes.Buffer, ct *complexType) error {
+	name := goName(ct.name)
+	fields := g.schema.allFields(ct)
+	b.WriteString(docComment(name, ct.doc))
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	polymorphic := false
+	for _, f := range fields {
+		typ, err := g.goType(f, true)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %v", ct.name, f.name, err)
+		}
+		if typ == "interface{}" || typ == "[]interface{}" {
+			polymorphic = true
+			g.decoders[f.typ] = true
+			fmt.Fprintf(b, "// %s is one of %s.\n", goName(f.name), strings.Join(g.concrete(f.typ), ", "))
+		} else if f.doc != "" {
+			b.WriteString(comment(f.doc))
+		}
+		tag := f.name
+		if f.namespace != "" {
+			tag = f.namespace + " " + f.name
+		}
+		if f.optional || f.repeated {
+			tag += ",omitempty"
+		}
+		fmt.Fprintf(b, "%s %s `xml:%q`\n", goName(f.name), typ, tag)
+	}
+	b.WriteString("}\n\n")
+
+	if ct.base != "" {
+		g.imports["encoding/xml"] = true
+		plain := strings.ToLower(name[:1]) + name[1:]
+		fmt.Fprintf(b, "// MarshalXML encodes v with its xsi:type.\n")
+		fmt.Fprintf(b, "func (v %s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", name)
+		fmt.Fprintf(b, "start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Space: %q, Local: \"type\"}, Value: %q})\n", xsiNamespace, ct.name)
+		fmt.Fprintf(b, "type %s %s\nreturn e.EncodeElement(%s(v), start)\n}\n\n", plain, name, plain)
+	}
+	if polymorphic {
+		g.imports["encoding/xml"] = true
+		fmt.Fprintf(b, "// UnmarshalXML decodes v, with the types of its polymorphic fields taken\n// from their xsi:type.\n")
+		fmt.Fprintf(b, "func (v *%s) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {\n", name)
+		b.WriteString("for {\ntok, err := dec.Token()\nif err != nil {\nreturn err\n}\nswitch t := tok.(type) {\ncase xml.StartElement:\nswitch t.Name.Local {\n")
+		for _, f := range fields {
+			typ, _ := g.goType(f, true)
+			fmt.Fprintf(b, "case %q:\n", f.name)
+			switch typ {
+			case "interface{}", "[]interface{}":
+				fmt.Fprintf(b, "var value interface{}\nif value, err = unmarshal%s(dec, t); err == nil {\n", goName(f.typ))
+				if f.repeated {
+					fmt.Fprintf(b, "v.%s = append(v.%s, value)\n}\n", goName(f.name), goName(f.name))
+				} else {
+					fmt.Fprintf(b, "v.%s = value\n}\n", goName(f.name))
+				}
+			default:
+				fmt.Fprintf(b, "err = dec.DecodeElement(&v.%s, &t)\n", goName(f.name))
+			}
+		}
+		b.WriteString("default:\nerr = dec.Skip()\n}\nif err != nil {\nreturn err\n}\ncase xml.EndElement:\nreturn nil\n}\n}\n}\n\n")
+	}
+	return nil
+}
+
+// concrete returns the types a field of type name may hold.
+func (g *generator) concrete(name string) []string {
+	var names []string
+	for _, n := range append([]string{name}, g.schema.descendants(name)...) {
+		if ct := g.schema.complexTypes[n]; !ct.abstract {
+			names = append(names, goName(n))
+		}
+	}
+	return names
+}
+
+// writeDecoder writes the function decoding an element of the polymorphic
+// type name into the type named by its xsi:type.
+func (g *generator) writeDecoder(b *bytes.Buffer, name string) {
+	g.imports["strings"] = true
+	fmt.Fprintf(b, "// unmarshal%s decodes start into the type named by its xsi:type.\n", goName(name))
+	fmt.Fprintf(b, "func unmarshal%s(dec *xml.Decoder, start xml.StartElement) (interface{}, error) {\n", goName(name))
+	fmt.Fprintf(b, "var typ string\nfor _, a := range start.Attr {\nif a.Name.Space == %q && a.Name.Local == \"type\" {\ntyp = a.Value[strings.LastIndex(a.Value, \":\")+1:]\n}\n}\nswitch typ {\n", xsiNamespace)
+	for _, n := range g.schema.descendants(name) {
+		if !g.schema.complexTypes[n].abstract {
+			fmt.Fprintf(b, "case %q:\nvar value %s\nerr := dec.DecodeElement(&value, &start)\nreturn value, err\n", n, goName(n))
+		}
+	}
+	if g.schema.complexTypes[name].abstract {
+		g.imports["fmt"] = true
+		fmt.Fprintf(b, "}\nreturn nil, fmt.Errorf(\"unknown %s type %%q\", typ)\n}\n\n", name)
+		return
+	}
+	fmt.Fprintf(b, "}\nvar value %s\nerr := dec.DecodeElement(&value, &start)\nreturn value, err\n}\n\n", goName(name))
+}
+
+func (g *generator) writeService(b *bytes.Buffer, svc service) error {
+	g.imports["context"] = true
+	g.imports["encoding/xml"] = true
+	name := goName(svc.name)
+	url := strings.ToLower(name[:1]) + name[1:] + "Url"
+	fmt.Fprintf(b, "// %s is a client of %s.\ntype %s struct {\nAuth\n}\n\n", name, svc.name, name)
+	fmt.Fprintf(b, "// New%s returns a client of %s.\nfunc New%s(auth *Auth) *%s {\nreturn &%s{Auth: *auth}\n}\n\n", name, svc.name, name, name, name)
+
+	for _, op := range svc.operations {
+		method := goName(op.name)
+		var params, args, fields, values []string
+		for _, f := range op.params {
+			typ, err := g.goType(f, false)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %v", svc.name, op.name, err)
+			}
+			params = append(params, f.name+" "+typ)
+			args = append(args, f.name)
+			fields = append(fields, fmt.Sprintf("%s %s `xml:%q`", goName(f.name), typ, f.namespace+" "+f.name))
+			values = append(values, fmt.Sprintf("%s: %s,", goName(f.name), f.name))
+		}
+		var rval string
+		switch len(op.results) {
+		case 0:
+		case 1:
+			typ, err := g.goType(op.results[0], false)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %v", svc.name, op.name, err)
+			}
+			if strings.Contains(typ, "interface{}") {
+				return fmt.Errorf("%s.%s: polymorphic results are not supported", svc.name, op.name)
+			}
+			rval = typ
+		default:
+			return fmt.Errorf("%s.%s: several results are not supported", svc.name, op.name)
+		}
+
+		results, zero := "error", "err"
+		if rval != "" {
+			results, zero = fmt.Sprintf("(%s, error)", rval), "rval, err"
+		}
+		fmt.Fprintf(b, "// %s calls %s of %s.\n", method, op.name, svc.name)
+		if op.doc != "" {
+			b.WriteString("//\n" + comment(op.doc))
+		}
+		fmt.Fprintf(b, "func (s *%s) %s(%s) %s {\nreturn s.%sContext(%s)\n}\n\n",
+			name, method, strings.Join(params, ", "), results, method, strings.Join(append([]string{"context.Background()"}, args...), ", "))
+
+		named := "(err error)"
+		if rval != "" {
+			named = fmt.Sprintf("(rval %s, err error)", rval)
+		}
+		fmt.Fprintf(b, "// %sContext is like %s but takes a context that controls the\n// request lifetime.\n", method, method)
+		fmt.Fprintf(b, "func (s *%s) %sContext(%s) %s {\n", name, method, strings.Join(append([]string{"ctx context.Context"}, params...), ", "), named)
+		fmt.Fprintf(b, "respBody, err := s.Auth.request(ctx, %s, %q, struct {\nXMLName xml.Name\n%s\n}{\nXMLName: xml.Name{Space: %s, Local: %q},\n%s\n})\n",
+			url, op.name, strings.Join(fields, "\n"), g.namespace(op.namespace), op.name, strings.Join(values, "\n"))
+		if rval == "" {
+			b.WriteString("_ = respBody\nreturn err\n}\n\n")
+			continue
+		}
+		fmt.Fprintf(b, "if err != nil {\nreturn %s\n}\nresp := struct {\nRval %s `xml:\"rval\"`\n}{}\nerr = xml.Unmarshal(respBody, &resp)\nreturn resp.Rval, err\n}\n\n", zero, rval)
+	}
+	return nil
+}
+
+func sortedTypes(types map[string]*complexType) []*complexType {
+	var sorted []*complexType
+	for _, t := range types {
+		sorted = append(sorted, t)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+	return sorted
+}
+
+func sortedSimpleTypes(types map[string]*simpleType) []*simpleType {
+	var sorted []*simpleType
+	for _, t := range types {
+		sorted = append(sorted, t)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+	return sorted
+}
@@ -0,0 +1,84 @@
+// wsdlgen generates the Go types and service clients of an AdWords API
+// service from its WSDL and XSD files.
+//
+// Usage:
+//
+//	wsdlgen [flags] file.wsdl [file.xsd ...]
+//
+// It writes, in a single gofmt'ed file:
+//
+//   - a string type and constants for each enum,
+//   - a struct for each complex type, with the fields of its bases and tags
+//     qualified by their namespace,
+//   - a MarshalXML adding the xsi:type of each type extending another, and
+//     for each struct holding a polymorphic field, an UnmarshalXML decoding
+//     it into the type named by its xsi:type,
+//   - a client of each service, with a method and its Context variant for
+//     each operation.
+//
+// Only the types reached from the operations, or from -types, are written.
+// Those named by -known are declared by hand in the package and are used
+// as they are.
+//
+// The v201809 files are checked in under wsdl/v201809.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	pkg := flag.String("pkg", "v201809", "package `name` of the generated file")
+	out := flag.String("o", "", "write to `file` instead of stdout")
+	known := flag.String("known", "", "comma separated `types` declared by hand")
+	types := flag.String("types", "", "comma separated `types` to generate besides those of the operations")
+	ns := flag.String("ns", "", "comma separated namespace=`const` pairs naming the namespaces in requests")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: wsdlgen [flags] file.wsdl [file.xsd ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	s, err := loadSchema(flag.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &generator{schema: s, pkg: *pkg, known: map[string]bool{}, namespaces: map[string]string{}}
+	for _, name := range split(*known) {
+		g.known[name] = true
+	}
+	for _, pair := range split(*ns) {
+		i := strings.LastIndex(pair, "=")
+		if i < 0 {
+			log.Fatalf("bad -ns pair %q", pair)
+		}
+		g.namespaces[pair[:i]] = pair[i+1:]
+	}
+	src, err := g.generate(split(*types))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func split(list string) []string {
+	if list == "" {
+		return nil
+	}
+	return strings.Split(list, ",")
+}
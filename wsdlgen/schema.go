@@ -0,0 +1,278 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// The XML of WSDL and XSD files, matched by local name.
+
+type wsdlDefinitions struct {
+	TargetNamespace string        `xml:"targetNamespace,attr"`
+	Schemas         []xsdSchema   `xml:"types>schema"`
+	Messages        []wsdlMessage `xml:"message"`
+	PortTypes       []wsdlPort    `xml:"portType"`
+	Bindings        []wsdlBinding `xml:"binding"`
+	Services        []wsdlService `xml:"service"`
+}
+
+type xsdSchema struct {
+	TargetNamespace string           `xml:"targetNamespace,attr"`
+	ComplexTypes    []xsdComplexType `xml:"complexType"`
+	SimpleTypes     []xsdSimpleType  `xml:"simpleType"`
+	Elements        []xsdElement     `xml:"element"`
+}
+
+type xsdComplexType struct {
+	Name          string       `xml:"name,attr"`
+	Abstract      bool         `xml:"abstract,attr"`
+	Documentation string       `xml:"annotation>documentation"`
+	Sequence      []xsdElement `xml:"sequence>element"`
+	Extension     *struct {
+		Base     string       `xml:"base,attr"`
+		Sequence []xsdElement `xml:"sequence>element"`
+	} `xml:"complexContent>extension"`
+}
+
+type xsdSimpleType struct {
+	Name          string `xml:"name,attr"`
+	Documentation string `xml:"annotation>documentation"`
+	Restriction   struct {
+		Base         string `xml:"base,attr"`
+		Enumerations []struct {
+			Value string `xml:"value,attr"`
+		} `xml:"enumeration"`
+	} `xml:"restriction"`
+}
+
+type xsdElement struct {
+	Name          string          `xml:"name,attr"`
+	Type          string          `xml:"type,attr"`
+	MinOccurs     string          `xml:"minOccurs,attr"`
+	MaxOccurs     string          `xml:"maxOccurs,attr"`
+	Documentation string          `xml:"annotation>documentation"`
+	ComplexType   *xsdComplexType `xml:"complexType"`
+}
+
+type wsdlMessage struct {
+	Name  string `xml:"name,attr"`
+	Parts []struct {
+		Element string `xml:"element,attr"`
+	} `xml:"part"`
+}
+
+type wsdlPort struct {
+	Name       string `xml:"name,attr"`
+	Operations []struct {
+		Name          string `xml:"name,attr"`
+		Documentation string `xml:"documentation"`
+		Input         struct {
+			Message string `xml:"message,attr"`
+		} `xml:"input"`
+		Output struct {
+			Message string `xml:"message,attr"`
+		} `xml:"output"`
+	} `xml:"operation"`
+}
+
+type wsdlBinding struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type wsdlService struct {
+	Name  string `xml:"name,attr"`
+	Ports []struct {
+		Binding string `xml:"binding,attr"`
+	} `xml:"port"`
+}
+
+// The model the code is generated from.
+
+// A field is an element of a complex type.
+type field struct {
+	name, typ, namespace, doc string
+	optional, repeated        bool
+}
+
+type complexType struct {
+	name, base, namespace, doc string
+	abstract                   bool
+	fields                     []field
+}
+
+type simpleType struct {
+	name, base, doc string
+	values          []string
+}
+
+// An operation is a call of a service, with the fields of its request and
+// response elements.
+type operation struct {
+	name, doc, namespace string
+	params, results      []field
+}
+
+type service struct {
+	name, namespace string
+	operations      []operation
+}
+
+type schema struct {
+	complexTypes map[string]*complexType
+	simpleTypes  map[string]*simpleType
+	elements     map[string]*complexType
+	services     []service
+}
+
+// localName strips the namespace prefix of a QName.
+func localName(qname string) string {
+	return qname[strings.LastIndex(qname, ":")+1:]
+}
+
+func newFields(elements []xsdElement, namespace string) []field {
+	var fields []field
+	for _, e := range elements {
+		fields = append(fields, field{
+			name:      e.Name,
+			typ:       localName(e.Type),
+			namespace: namespace,
+			doc:       e.Documentation,
+			optional:  e.MinOccurs == "0",
+			repeated:  e.MaxOccurs == "unbounded",
+		})
+	}
+	return fields
+}
+
+// loadSchema reads the types, elements and services of WSDL and XSD files.
+// A bare XSD file holds its schema as the root element.
+func loadSchema(paths []string) (*schema, error) {
+	s := &schema{
+		complexTypes: map[string]*complexType{},
+		simpleTypes:  map[string]*simpleType{},
+		elements:     map[string]*complexType{},
+	}
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var root struct{ XMLName xml.Name }
+		if err := xml.Unmarshal(b, &root); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		var defs wsdlDefinitions
+		if root.XMLName.Local == "schema" {
+			var x xsdSchema
+			err = xml.Unmarshal(b, &x)
+			defs.Schemas = []xsdSchema{x}
+		} else {
+			err = xml.Unmarshal(b, &defs)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		s.add(&defs)
+	}
+	return s, nil
+}
+
+func (s *schema) add(defs *wsdlDefinitions) {
+	for _, x := range defs.Schemas {
+		ns := x.TargetNamespace
+		for _, ct := range x.ComplexTypes {
+			t := &complexType{
+				name:      ct.Name,
+				namespace: ns,
+				doc:       ct.Documentation,
+				abstract:  ct.Abstract,
+				fields:    newFields(ct.Sequence, ns),
+			}
+			if ext := ct.Extension; ext != nil {
+				t.base = localName(ext.Base)
+				t.fields = append(t.fields, newFields(ext.Sequence, ns)...)
+			}
+			s.complexTypes[t.name] = t
+		}
+		for _, st := range x.SimpleTypes {
+			t := &simpleType{name: st.Name, base: localName(st.Restriction.Base), doc: st.Documentation}
+			for _, e := range st.Restriction.Enumerations {
+				t.values = append(t.values, e.Value)
+			}
+			s.simpleTypes[t.name] = t
+		}
+		for _, e := range x.Elements {
+			if e.ComplexType != nil {
+				s.elements[e.Name] = &complexType{name: e.Name, namespace: ns, fields: newFields(e.ComplexType.Sequence, ns)}
+			}
+		}
+	}
+
+	messages := map[string]string{}
+	for _, m := range defs.Messages {
+		if len(m.Parts) > 0 {
+			messages[m.Name] = localName(m.Parts[0].Element)
+		}
+	}
+	ports := map[string]wsdlPort{}
+	for _, p := range defs.PortTypes {
+		ports[p.Name] = p
+	}
+	bindings := map[string]string{}
+	for _, b := range defs.Bindings {
+		bindings[b.Name] = localName(b.Type)
+	}
+	for _, ws := range defs.Services {
+		if len(ws.Ports) == 0 {
+			continue
+		}
+		svc := service{name: ws.Name, namespace: defs.TargetNamespace}
+		for _, op := range ports[bindings[localName(ws.Ports[0].Binding)]].Operations {
+			o := operation{name: op.Name, doc: op.Documentation, namespace: defs.TargetNamespace}
+			if in := s.elements[messages[localName(op.Input.Message)]]; in != nil {
+				o.params = in.fields
+			}
+			if out := s.elements[messages[localName(op.Output.Message)]]; out != nil {
+				o.results = out.fields
+			}
+			svc.operations = append(svc.operations, o)
+		}
+		s.services = append(s.services, svc)
+	}
+}
+
+// allFields returns the fields of t, those of its bases first.
+func (s *schema) allFields(t *complexType) []field {
+	if base, ok := s.complexTypes[t.base]; ok {
+		return append(s.allFields(base), t.fields...)
+	}
+	return t.fields
+}
+
+// descendants returns the types extending name, directly or not, sorted.
+func (s *schema) descendants(name string) []string {
+	var names []string
+	for _, t := range sortedTypes(s.complexTypes) {
+		for base := t.base; base != ""; {
+			if base == name {
+				names = append(names, t.name)
+				break
+			}
+			b, ok := s.complexTypes[base]
+			if !ok {
+				break
+			}
+			base = b.base
+		}
+	}
+	return names
+}
+
+// polymorphic reports whether fields of type name may hold other types,
+// told apart by xsi:type.
+func (s *schema) polymorphic(name string) bool {
+	return len(s.descendants(name)) > 0
+}
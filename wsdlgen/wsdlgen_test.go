@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the generated files")
+
+func TestGenerate(t *testing.T) {
+	for _, test := range []struct {
+		files      []string
+		known      []string
+		namespaces map[string]string
+		out        string
+	}{
+		{
+			files:      []string{"wsdl/v201809/AdParamService.wsdl"},
+			known:      []string{"Selector"},
+			namespaces: map[string]string{"https://adwords.google.com/api/adwords/cm/v201809": "baseUrl"},
+			out:        "../googleads/ad_param.go",
+		},
+		{
+			files:      []string{"wsdl/v201809/AdGroupFeedService.wsdl"},
+			known:      []string{"Selector", "Operator"},
+			namespaces: map[string]string{"https://adwords.google.com/api/adwords/cm/v201809": "baseUrl"},
+			out:        "../googleads/ad_group_feed.go",
+		},
+		{
+			files: []string{"testdata/CampaignCriterionService.wsdl"},
+			known: []string{"Selector"},
+			out:   "testdata/CampaignCriterionService.golden",
+		},
+	} {
+		s, err := loadSchema(test.files)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g := &generator{schema: s, pkg: "v201809", known: map[string]bool{}, namespaces: test.namespaces}
+		for _, name := range test.known {
+			g.known[name] = true
+		}
+		src, err := g.generate(nil)
+		if err != nil {
+			t.Fatalf("%s: %v", test.files, err)
+		}
+		if *update {
+			if err := ioutil.WriteFile(test.out, src, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := ioutil.ReadFile(test.out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(src, want) {
+			t.Errorf("%s is out of date, run go test -update", test.out)
+		}
+	}
+}